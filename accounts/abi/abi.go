@@ -19,8 +19,12 @@ package abi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
+
+	"github.com/klaytn/klaytn/crypto"
 )
 
 // The ABI holds information about a contract's context and available
@@ -144,3 +148,38 @@ func (abi *ABI) MethodById(sigdata []byte) (*Method, error) {
 	}
 	return nil, fmt.Errorf("no method with id: %#x", sigdata[:4])
 }
+
+var (
+	// revertSelector is the selector of Error(string) used by revert(string) and require.
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// panicSelector is the selector of Panic(uint256) used by assert and internal errors.
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	errBadRevertData = errors.New("abi: invalid data for unpacking revert reason")
+)
+
+// UnpackRevert resolves the message of a revert payload encoded as Error(string).
+func UnpackRevert(data []byte) (string, error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], revertSelector) {
+		return "", errBadRevertData
+	}
+	typ, _ := NewType("string")
+	unpacked, err := (Arguments{{Type: typ}}).UnpackValues(data[4:])
+	if err != nil {
+		return "", err
+	}
+	return unpacked[0].(string), nil
+}
+
+// UnpackPanic resolves the code of a revert payload encoded as Panic(uint256).
+func UnpackPanic(data []byte) (*big.Int, error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], panicSelector) {
+		return nil, errBadRevertData
+	}
+	typ, _ := NewType("uint256")
+	unpacked, err := (Arguments{{Type: typ}}).UnpackValues(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	return unpacked[0].(*big.Int), nil
+}
@@ -729,3 +729,45 @@ func TestABI_MethodById(t *testing.T) {
 		}
 	*/
 }
+
+func TestUnpackRevert(t *testing.T) {
+	var cases = []struct {
+		input     string
+		expect    string
+		expectErr bool
+	}{
+		{"", "", true},
+		{"08c379a1", "", true},
+		{"08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000d72657665727420726561736f6e00000000000000000000000000000000000000", "revert reason", false},
+		{"4e487b710000000000000000000000000000000000000000000000000000000000000001", "", true},
+	}
+	for index, c := range cases {
+		got, err := UnpackRevert(common.Hex2Bytes(c.input))
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("case %d: expected error, got nil", index)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", index, err)
+			continue
+		}
+		if got != c.expect {
+			t.Errorf("case %d: expected %q, got %q", index, c.expect, got)
+		}
+	}
+}
+
+func TestUnpackPanic(t *testing.T) {
+	code, err := UnpackPanic(common.Hex2Bytes("4e487b710000000000000000000000000000000000000000000000000000000000000011"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if code.Cmp(big.NewInt(0x11)) != 0 {
+		t.Errorf("expected panic code 0x11, got %v", code)
+	}
+	if _, err := UnpackPanic(common.Hex2Bytes("08c379a0")); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
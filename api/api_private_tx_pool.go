@@ -0,0 +1,66 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/ser/rlp"
+)
+
+// PrivateTxPoolAPI offers an API for the node operator to manage the transactions in the transaction pool.
+type PrivateTxPoolAPI struct {
+	b Backend
+}
+
+// NewPrivateTxPoolAPI creates a new tx pool service for the node operator.
+func NewPrivateTxPoolAPI(b Backend) *PrivateTxPoolAPI {
+	return &PrivateTxPoolAPI{b}
+}
+
+// Replace replaces the transaction in the pool having the same sender and nonce
+// with the given signed transaction, and returns the hash of the new transaction.
+func (s *PrivateTxPoolAPI) Replace(ctx context.Context, encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.b.ReplaceTx(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// Remove removes the transaction of the given hash from the pool.
+// It returns false if the transaction is not in the pool.
+func (s *PrivateTxPoolAPI) Remove(hash common.Hash) bool {
+	return s.b.RemovePoolTransaction(hash) != nil
+}
+
+// RemoveSender removes all transactions of the given sender from the pool,
+// and returns the hashes of the removed transactions.
+func (s *PrivateTxPoolAPI) RemoveSender(addr common.Address) []common.Hash {
+	removed := s.b.RemovePoolTransactionsBySender(addr)
+
+	hashes := make([]common.Hash, len(removed))
+	for i, tx := range removed {
+		hashes[i] = tx.Hash()
+	}
+	return hashes
+}
@@ -303,10 +303,38 @@ func (s *PublicBlockChainAPI) doCall(ctx context.Context, args CallArgs, blockNr
 	if err == nil {
 		err = blockchain.GetVMerrFromReceiptStatus(kerr.Status)
 	}
+	// Return the decoded revert reason as JSON RPC error data
+	if kerr.Status == types.ReceiptStatusErrExecutionReverted && len(res) > 0 {
+		err = newRevertError(res)
+	}
 
 	return res, gas, evm.GetOpCodeComputationCost(), kerr.Status != types.ReceiptStatusSuccessful, err
 }
 
+// revertError is an error returned when the execution is reverted with a revert payload.
+// The decoded revert reason is returned in the data field of the JSON-RPC error.
+type revertError struct {
+	reason *blockchain.RevertReason
+}
+
+func newRevertError(data []byte) *revertError {
+	return &revertError{reason: blockchain.DecodeRevertReason(data)}
+}
+
+func (e *revertError) Error() string {
+	return vm.ErrExecutionReverted.Error() + ": " + e.reason.String()
+}
+
+// ErrorCode returns the JSON-RPC error code for a reverted execution.
+func (e *revertError) ErrorCode() int {
+	return 3
+}
+
+// ErrorData returns the decoded revert reason.
+func (e *revertError) ErrorData() interface{} {
+	return e.reason
+}
+
 // Call executes the given transaction on the state for the given block number.
 // It doesn't make and changes in the state/blockchain and is useful to execute and retrieve values.
 func (s *PublicBlockChainAPI) Call(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber) (hexutil.Bytes, error) {
@@ -337,10 +365,12 @@ func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (h
 	cap = hi
 
 	// Create a helper to check if a gas allowance results in an executable transaction
+	var lastErr error
 	executable := func(gas uint64) bool {
 		args.Gas = hexutil.Uint64(gas)
 
 		_, _, _, failed, err := s.doCall(ctx, args, rpc.PendingBlockNumber, vm.Config{UseOpcodeComputationCost: true}, localTxExecutionTime)
+		lastErr = err
 		if err != nil || failed {
 			return false
 		}
@@ -358,6 +388,9 @@ func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (h
 	// Reject the transaction as invalid if it still fails at the highest allowance
 	if hi == cap {
 		if !executable(hi) {
+			if revertErr, ok := lastErr.(*revertError); ok {
+				return 0, revertErr
+			}
 			return 0, fmt.Errorf("gas required exceeds allowance or always failing transaction")
 		}
 	}
@@ -447,6 +480,10 @@ func RpcOutputBlock(b *types.Block, td *big.Int, inclTx bool, fullTx bool) (map[
 		"receiptsRoot":     head.ReceiptHash,
 	}
 
+	if head.BaseFee != nil {
+		fields["baseFeePerGas"] = (*hexutil.Big)(head.BaseFee)
+	}
+
 	if inclTx {
 		formatTx := func(tx *types.Transaction) (interface{}, error) {
 			return tx.Hash(), nil
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
@@ -222,6 +223,10 @@ func RpcOutputReceipt(tx *types.Transaction, blockHash common.Hash, blockNumber
 		fields["status"] = hexutil.Uint(receipt.Status)
 	}
 
+	if len(receipt.RevertReason) > 0 {
+		fields["revertReason"] = blockchain.DecodeRevertReason(receipt.RevertReason)
+	}
+
 	fields["logsBloom"] = receipt.Bloom
 	fields["gasUsed"] = hexutil.Uint64(receipt.GasUsed)
 
@@ -255,7 +260,18 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceiptBySenderTxHash(ctx conte
 
 // GetTransactionReceipt returns the transaction receipt for the given transaction hash.
 func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
-	return RpcOutputReceipt(s.b.GetTxLookupInfoAndReceipt(ctx, hash)), nil
+	tx, blockHash, blockNumber, index, receipt := s.b.GetTxLookupInfoAndReceipt(ctx, hash)
+	fields := RpcOutputReceipt(tx, blockHash, blockNumber, index, receipt)
+	if fields == nil {
+		return nil, nil
+	}
+	// Receipts read from the database do not hold the revert payload, which is stored separately.
+	if _, ok := fields["revertReason"]; !ok && receipt.Status == types.ReceiptStatusErrExecutionReverted {
+		if data := s.b.ChainDB().ReadRevertReason(hash); len(data) > 0 {
+			fields["revertReason"] = blockchain.DecodeRevertReason(data)
+		}
+	}
+	return fields, nil
 }
 
 // GetTransactionReceiptInCache returns the transaction receipt for the given transaction hash.
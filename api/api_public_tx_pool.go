@@ -21,11 +21,20 @@
 package api
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+
 	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
 )
 
+const (
+	defaultTxPoolPageSize = 100  // Number of transactions returned in a page if the limit is not given
+	maxTxPoolPageSize     = 1000 // Maximum number of transactions returned in a page
+)
+
 // PublicTxPoolAPI offers and API for the transaction pool. It only operates on data that is non confidential.
 type PublicTxPoolAPI struct {
 	b Backend
@@ -63,6 +72,124 @@ func (s *PublicTxPoolAPI) Content() map[string]map[string]map[string]map[string]
 	return content
 }
 
+// ContentFrom returns a page of the pending and queued transactions sent from the
+// given address. Pending transactions come first, and each group is sorted by nonce.
+func (s *PublicTxPoolAPI) ContentFrom(addr common.Address, offset, limit *hexutil.Uint) map[string]interface{} {
+	pending, queued := s.b.TxPoolContentFrom(addr)
+	return s.paginate(pending, queued, offset, limit)
+}
+
+// ContentByFeePayer returns a page of the pending and queued transactions whose
+// fee payer is the given address. Pending transactions come first, and each group
+// is sorted by sender and nonce.
+func (s *PublicTxPoolAPI) ContentByFeePayer(feePayer common.Address, offset, limit *hexutil.Uint) map[string]interface{} {
+	pending, queued := s.b.TxPoolContentByFeePayer(feePayer)
+	return s.paginate(flattenBySender(pending), flattenBySender(queued), offset, limit)
+}
+
+// Summary returns the number of pending and queued transactions grouped by the
+// transaction type, and the number of queued transactions grouped by the reason
+// why they can not be executed.
+func (s *PublicTxPoolAPI) Summary() map[string]map[string]hexutil.Uint {
+	summary := map[string]map[string]hexutil.Uint{
+		"pending":       make(map[string]hexutil.Uint),
+		"queued":        make(map[string]hexutil.Uint),
+		"nonExecutable": make(map[string]hexutil.Uint),
+	}
+	pending, queue := s.b.TxPoolContent()
+
+	for _, txs := range pending {
+		for _, tx := range txs {
+			summary["pending"][tx.Type().String()]++
+		}
+	}
+	var queued types.Transactions
+	for _, txs := range queue {
+		for _, tx := range txs {
+			summary["queued"][tx.Type().String()]++
+		}
+		queued = append(queued, txs...)
+	}
+	for _, reason := range s.b.TxPoolNonExecutableReasons(queued) {
+		if reason != "" {
+			summary["nonExecutable"][reason]++
+		}
+	}
+	return summary
+}
+
+// paginate returns the transactions in the requested range of the pending and
+// queued transactions. The queued transactions in the page come with the reason
+// why they can not be executed.
+func (s *PublicTxPoolAPI) paginate(pending, queued types.Transactions, offset, limit *hexutil.Uint) map[string]interface{} {
+	start, size := 0, defaultTxPoolPageSize
+	if offset != nil {
+		start = int(*offset)
+	}
+	if limit != nil && int(*limit) < maxTxPoolPageSize {
+		size = int(*limit)
+	} else if limit != nil {
+		size = maxTxPoolPageSize
+	}
+	total := len(pending) + len(queued)
+
+	txs := make([]map[string]interface{}, 0)
+	var queuedInPage types.Transactions
+	for i := start; i < total && i < start+size; i++ {
+		if i < len(pending) {
+			txs = append(txs, newRPCPoolTransaction(pending[i], "pending", ""))
+		} else {
+			queuedInPage = append(queuedInPage, queued[i-len(pending)])
+		}
+	}
+	for i, reason := range s.b.TxPoolNonExecutableReasons(queuedInPage) {
+		txs = append(txs, newRPCPoolTransaction(queuedInPage[i], "queued", reason))
+	}
+
+	page := map[string]interface{}{
+		"transactions": txs,
+		"total":        hexutil.Uint(total),
+	}
+	if next := start + size; next < total {
+		page["nextOffset"] = hexutil.Uint(next)
+	}
+	return page
+}
+
+// flattenBySender flattens the transactions grouped by sender into a list sorted
+// by sender and nonce.
+func flattenBySender(content map[common.Address]types.Transactions) types.Transactions {
+	senders := make([]common.Address, 0, len(content))
+	for addr := range content {
+		senders = append(senders, addr)
+	}
+	sort.Slice(senders, func(i, j int) bool { return bytes.Compare(senders[i][:], senders[j][:]) < 0 })
+
+	var txs types.Transactions
+	for _, addr := range senders {
+		txs = append(txs, content[addr]...)
+	}
+	return txs
+}
+
+// newRPCPoolTransaction returns a transaction in the pool with its fee payer, fee
+// ratio and status. A transaction which is not fee-delegated is paid by its sender.
+func newRPCPoolTransaction(tx *types.Transaction, status string, reason string) map[string]interface{} {
+	output := newRPCPendingTransaction(tx)
+	if tx.IsFeeDelegatedTransaction() {
+		output["feePayer"], _ = tx.FeePayer()
+	} else {
+		output["feePayer"] = output["from"]
+	}
+	feeRatio, _ := tx.FeeRatio()
+	output["feeRatio"] = hexutil.Uint(feeRatio)
+	output["status"] = status
+	if reason != "" {
+		output["reason"] = reason
+	}
+	return output
+}
+
 // Status returns the number of pending and queued transaction in the pool.
 func (s *PublicTxPoolAPI) Status() map[string]hexutil.Uint {
 	pending, queue := s.b.Stats()
@@ -72,6 +199,23 @@ func (s *PublicTxPoolAPI) Status() map[string]hexutil.Uint {
 	}
 }
 
+// PriorityStatus returns the number of reserved slots and the number of pending
+// and queued transactions of each priority class in the pool.
+func (s *PublicTxPoolAPI) PriorityStatus() []map[string]interface{} {
+	classes := s.b.TxPoolPriorityStatus()
+
+	status := make([]map[string]interface{}, len(classes))
+	for i, class := range classes {
+		status[i] = map[string]interface{}{
+			"name":    class.Name,
+			"slots":   hexutil.Uint64(class.Slots),
+			"pending": hexutil.Uint(class.Pending),
+			"queued":  hexutil.Uint(class.Queued),
+		}
+	}
+	return status
+}
+
 // Inspect retrieves the content of the transaction pool and flattens it into an
 // easily inspectable list.
 func (s *PublicTxPoolAPI) Inspect() map[string]map[string]map[string]string {
@@ -69,11 +69,18 @@ type Backend interface {
 
 	// TxPool API
 	SendTx(ctx context.Context, signedTx *types.Transaction) error
+	ReplaceTx(ctx context.Context, signedTx *types.Transaction) error
 	GetPoolTransactions() (types.Transactions, error)
 	GetPoolTransaction(txHash common.Hash) *types.Transaction
+	RemovePoolTransaction(txHash common.Hash) *types.Transaction
+	RemovePoolTransactionsBySender(addr common.Address) types.Transactions
 	GetPoolNonce(ctx context.Context, addr common.Address) uint64
 	Stats() (pending int, queued int)
 	TxPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	TxPoolPriorityStatus() []blockchain.TxPriorityClassStatus
+	TxPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions)
+	TxPoolContentByFeePayer(feePayer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	TxPoolNonExecutableReasons(txs types.Transactions) []string
 	SubscribeNewTxsEvent(chan<- blockchain.NewTxsEvent) event.Subscription
 
 	ChainConfig() *params.ChainConfig
@@ -107,6 +114,11 @@ func GetAPIs(apiBackend Backend) []rpc.API {
 			Version:   "1.0",
 			Service:   NewPublicTxPoolAPI(apiBackend),
 			Public:    true,
+		}, {
+			Namespace: "txpool",
+			Version:   "1.0",
+			Service:   NewPrivateTxPoolAPI(apiBackend),
+			Public:    false,
 		}, {
 			Namespace: "debug",
 			Version:   "1.0",
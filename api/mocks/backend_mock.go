@@ -402,6 +402,48 @@ func (mr *MockBackendMockRecorder) ProtocolVersion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProtocolVersion", reflect.TypeOf((*MockBackend)(nil).ProtocolVersion))
 }
 
+// RemovePoolTransaction mocks base method
+func (m *MockBackend) RemovePoolTransaction(arg0 common.Hash) *types.Transaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePoolTransaction", arg0)
+	ret0, _ := ret[0].(*types.Transaction)
+	return ret0
+}
+
+// RemovePoolTransaction indicates an expected call of RemovePoolTransaction
+func (mr *MockBackendMockRecorder) RemovePoolTransaction(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePoolTransaction", reflect.TypeOf((*MockBackend)(nil).RemovePoolTransaction), arg0)
+}
+
+// RemovePoolTransactionsBySender mocks base method
+func (m *MockBackend) RemovePoolTransactionsBySender(arg0 common.Address) types.Transactions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePoolTransactionsBySender", arg0)
+	ret0, _ := ret[0].(types.Transactions)
+	return ret0
+}
+
+// RemovePoolTransactionsBySender indicates an expected call of RemovePoolTransactionsBySender
+func (mr *MockBackendMockRecorder) RemovePoolTransactionsBySender(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePoolTransactionsBySender", reflect.TypeOf((*MockBackend)(nil).RemovePoolTransactionsBySender), arg0)
+}
+
+// ReplaceTx mocks base method
+func (m *MockBackend) ReplaceTx(arg0 context.Context, arg1 *types.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceTx indicates an expected call of ReplaceTx
+func (mr *MockBackendMockRecorder) ReplaceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTx", reflect.TypeOf((*MockBackend)(nil).ReplaceTx), arg0, arg1)
+}
+
 // SendTx mocks base method
 func (m *MockBackend) SendTx(arg0 context.Context, arg1 *types.Transaction) error {
 	m.ctrl.T.Helper()
@@ -544,3 +586,61 @@ func (mr *MockBackendMockRecorder) TxPoolContent() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPoolContent", reflect.TypeOf((*MockBackend)(nil).TxPoolContent))
 }
+
+// TxPoolContentByFeePayer mocks base method
+func (m *MockBackend) TxPoolContentByFeePayer(arg0 common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPoolContentByFeePayer", arg0)
+	ret0, _ := ret[0].(map[common.Address]types.Transactions)
+	ret1, _ := ret[1].(map[common.Address]types.Transactions)
+	return ret0, ret1
+}
+
+// TxPoolContentByFeePayer indicates an expected call of TxPoolContentByFeePayer
+func (mr *MockBackendMockRecorder) TxPoolContentByFeePayer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPoolContentByFeePayer", reflect.TypeOf((*MockBackend)(nil).TxPoolContentByFeePayer), arg0)
+}
+
+// TxPoolContentFrom mocks base method
+func (m *MockBackend) TxPoolContentFrom(arg0 common.Address) (types.Transactions, types.Transactions) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPoolContentFrom", arg0)
+	ret0, _ := ret[0].(types.Transactions)
+	ret1, _ := ret[1].(types.Transactions)
+	return ret0, ret1
+}
+
+// TxPoolContentFrom indicates an expected call of TxPoolContentFrom
+func (mr *MockBackendMockRecorder) TxPoolContentFrom(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPoolContentFrom", reflect.TypeOf((*MockBackend)(nil).TxPoolContentFrom), arg0)
+}
+
+// TxPoolNonExecutableReasons mocks base method
+func (m *MockBackend) TxPoolNonExecutableReasons(arg0 types.Transactions) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPoolNonExecutableReasons", arg0)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// TxPoolNonExecutableReasons indicates an expected call of TxPoolNonExecutableReasons
+func (mr *MockBackendMockRecorder) TxPoolNonExecutableReasons(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPoolNonExecutableReasons", reflect.TypeOf((*MockBackend)(nil).TxPoolNonExecutableReasons), arg0)
+}
+
+// TxPoolPriorityStatus mocks base method
+func (m *MockBackend) TxPoolPriorityStatus() []blockchain.TxPriorityClassStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPoolPriorityStatus")
+	ret0, _ := ret[0].([]blockchain.TxPriorityClassStatus)
+	return ret0
+}
+
+// TxPoolPriorityStatus indicates an expected call of TxPoolPriorityStatus
+func (mr *MockBackendMockRecorder) TxPoolPriorityStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPoolPriorityStatus", reflect.TypeOf((*MockBackend)(nil).TxPoolPriorityStatus))
+}
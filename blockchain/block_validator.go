@@ -66,6 +66,9 @@ func (v *BlockValidator) ValidateBody(block *types.Block) error {
 	}
 	// Header validity is known at this point, check the transactions
 	header := block.Header()
+	if limit := v.bc.BlockGasLimit(block.NumberU64()); limit != 0 && header.GasUsed > limit {
+		return ErrBlockGasLimitExceeded
+	}
 	if hash := types.DeriveSha(block.Transactions()); hash != header.TxHash {
 		return fmt.Errorf("transaction root hash mismatch: have %x, want %x", hash, header.TxHash)
 	}
@@ -21,13 +21,16 @@
 package blockchain
 
 import (
+	"math/big"
 	"runtime"
 	"testing"
 	"time"
 
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/consensus/gxhash"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
 )
@@ -201,3 +204,53 @@ func testHeaderConcurrentAbortion(t *testing.T, threads int) {
 		t.Errorf("verification count too large: have %d, want below %d", verified, 2*threads)
 	}
 }
+
+// testGovernance is a GovernanceReader returning the block gas limit from the given block number.
+type testGovernance struct {
+	gasLimit     uint64
+	gasLimitFrom uint64
+}
+
+func (gov *testGovernance) BlockGasLimit(num uint64) uint64 {
+	if num < gov.gasLimitFrom {
+		return params.DefaultBlockGasLimit
+	}
+	return gov.gasLimit
+}
+
+func (gov *testGovernance) ComputationCostLimit(num uint64) uint64 {
+	return params.OpcodeComputationCostLimit
+}
+
+// Tests that a block whose transactions use more gas than the block gas limit
+// in effect at the block is rejected.
+func TestBlockGasLimitValidation(t *testing.T) {
+	var (
+		testdb  = database.NewMemoryDBManager()
+		key, _  = crypto.GenerateKey()
+		sender  = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &Genesis{Config: params.TestChainConfig, Alloc: GenesisAlloc{sender: {Balance: big.NewInt(params.KLAY)}}}
+		genesis = gspec.MustCommit(testdb)
+		signer  = types.NewEIP155Signer(gspec.Config.ChainID)
+	)
+	// Every block has two value transfers
+	blocks, _ := GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), testdb, 2, func(i int, gen *BlockGen) {
+		for j := 0; j < 2; j++ {
+			tx, _ := types.SignTx(types.NewTransaction(gen.TxNonce(sender), common.Address{1}, common.Big1, params.TxGas, common.Big0, nil), signer, key)
+			gen.AddTx(tx)
+		}
+	})
+
+	chain, _ := NewBlockChain(testdb, nil, gspec.Config, gxhash.NewFaker(), vm.Config{})
+	defer chain.Stop()
+
+	// The limit allowing only one transfer is applied from the second block
+	chain.SetGovernance(&testGovernance{gasLimit: params.TxGas, gasLimitFrom: 2})
+
+	if _, err := chain.InsertChain(blocks[:1]); err != nil {
+		t.Fatalf("failed to insert the block before the limit: %v", err)
+	}
+	if _, err := chain.InsertChain(blocks[1:]); err != ErrBlockGasLimitExceeded {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrBlockGasLimitExceeded)
+	}
+}
@@ -90,6 +90,8 @@ type CacheConfig struct {
 	TriesInMemory        uint64                      // Maximum number of recent state tries according to its block number
 	SenderTxHashIndexing bool                        // Enables saving senderTxHash to txHash mapping information to database and cache
 	TrieNodeCacheConfig  statedb.TrieNodeCacheConfig // Configures trie node cache
+	SlowTxThreshold      time.Duration               // Execution time over which a transaction is logged as slow (0 = disabled)
+	ParallelTxExecution  bool                        // Enables applying the transactions of an imported block in parallel
 }
 
 // gcBlock is used for priority queue for GC.
@@ -98,6 +100,12 @@ type gcBlock struct {
 	blockNum uint64
 }
 
+// GovernanceReader reads the parameters which are set by the governance at a block.
+type GovernanceReader interface {
+	BlockGasLimit(num uint64) uint64
+	ComputationCostLimit(num uint64) uint64
+}
+
 // BlockChain represents the canonical chain given a database with a genesis
 // block. The Blockchain manages chain imports, reverts, chain reorganisations.
 //
@@ -153,7 +161,10 @@ type BlockChain struct {
 	validator Validator // block and state validator interface
 	vmConfig  vm.Config
 
-	badBlocks *lru.Cache // Bad block cache
+	governance GovernanceReader // reader of the parameters set by the governance, guarded by chainConfigMu
+
+	badBlocks         *lru.Cache // Bad block cache
+	executionProfiles *lru.Cache // Execution profiles of recent blocks
 
 	parallelDBWrite bool // TODO-Klaytn-Storage parallelDBWrite will be replaced by number of goroutines when worker pool pattern is introduced.
 
@@ -197,6 +208,7 @@ func NewBlockChain(db database.DBManager, cacheConfig *CacheConfig, chainConfig
 
 	futureBlocks, _ := lru.New(maxFutureBlocks)
 	badBlocks, _ := lru.New(maxBadBlocks)
+	executionProfiles, _ := lru.New(maxExecutionProfiles)
 
 	var nonceCache common.Cache
 	var balanceCache common.Cache
@@ -218,6 +230,7 @@ func NewBlockChain(db database.DBManager, cacheConfig *CacheConfig, chainConfig
 		engine:             engine,
 		vmConfig:           vmConfig,
 		badBlocks:          badBlocks,
+		executionProfiles:  executionProfiles,
 		parallelDBWrite:    db.IsParallelDBWrite(),
 		nonceCache:         nonceCache,
 		balanceCache:       balanceCache,
@@ -292,6 +305,38 @@ func (bc *BlockChain) SetProposerPolicy(val uint64) {
 	bc.chainConfig.Istanbul.ProposerPolicy = val
 }
 
+// SetGovernance sets the governance which the block gas limit and the computation cost limit are read from.
+func (bc *BlockChain) SetGovernance(gov GovernanceReader) {
+	bc.chainConfigMu.Lock()
+	defer bc.chainConfigMu.Unlock()
+
+	bc.governance = gov
+}
+
+// BlockGasLimit returns the gas limit of all transactions in the block of the given number.
+// Zero means no limit.
+func (bc *BlockChain) BlockGasLimit(num uint64) uint64 {
+	bc.chainConfigMu.RLock()
+	defer bc.chainConfigMu.RUnlock()
+
+	if bc.governance == nil {
+		return params.DefaultBlockGasLimit
+	}
+	return bc.governance.BlockGasLimit(num)
+}
+
+// ComputationCostLimit returns the opcode computation cost limit of a transaction
+// in the block of the given number.
+func (bc *BlockChain) ComputationCostLimit(num uint64) uint64 {
+	bc.chainConfigMu.RLock()
+	defer bc.chainConfigMu.RUnlock()
+
+	if bc.governance == nil {
+		return params.OpcodeComputationCostLimit
+	}
+	return bc.governance.ComputationCostLimit(num)
+}
+
 func (bc *BlockChain) getProcInterrupt() bool {
 	return atomic.LoadInt32(&bc.procInterrupt) == 1
 }
@@ -727,6 +772,12 @@ func (bc *BlockChain) GetBlockByNumber(number uint64) *types.Block {
 	return bc.db.ReadBlockByNumber(number)
 }
 
+// GetCanonicalHash returns the hash of the canonical block of the given number,
+// or an empty hash if there is no such block.
+func (bc *BlockChain) GetCanonicalHash(number uint64) common.Hash {
+	return bc.db.ReadCanonicalHash(number)
+}
+
 // GetTxAndLookupInfo retrieves a tx and lookup info for a given transaction hash.
 func (bc *BlockChain) GetTxAndLookupInfo(txHash common.Hash) (*types.Transaction, common.Hash, uint64, uint64) {
 	tx, blockHash, blockNumber, index := bc.GetTxAndLookupInfoInCache(txHash)
@@ -2133,11 +2184,14 @@ func (bc *BlockChain) ApplyTransaction(chainConfig *params.ChainConfig, author *
 	// about the transaction and calling mechanisms.
 	vmenv := vm.NewEVM(context, statedb, chainConfig, vmConfig)
 	// Apply the transaction to the current state (included in the env)
-	_, gas, kerr := ApplyMessage(vmenv, msg)
+	ret, gas, kerr := ApplyMessage(vmenv, msg)
 	err = kerr.ErrTxInvalid
 	if err != nil {
 		return nil, 0, nil, err
 	}
+	if vmConfig.ComputationCostHook != nil {
+		vmConfig.ComputationCostHook(vmenv.GetOpCodeComputationCost())
+	}
 
 	var internalTrace *vm.InternalTxTrace
 	if vmConfig.EnableInternalTxTracing {
@@ -2157,6 +2211,10 @@ func (bc *BlockChain) ApplyTransaction(chainConfig *params.ChainConfig, author *
 	// Set the receipt logs and create a bloom for filtering
 	receipt.Logs = statedb.GetLogs(tx.Hash())
 	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	// Keep the revert payload so that the reason can be decoded and indexed
+	if kerr.Status == types.ReceiptStatusErrExecutionReverted && len(ret) > 0 {
+		receipt.RevertReason = common.CopyBytes(ret)
+	}
 
 	return receipt, gas, internalTrace, err
 }
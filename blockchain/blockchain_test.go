@@ -42,6 +42,7 @@ import (
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
+	"github.com/klaytn/klaytn/storage/statedb"
 )
 
 // So we can deterministically seed different blockchains
@@ -1565,3 +1566,63 @@ func TestCallTraceChainEventSubscription(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("0x%x", 0), ev.InternalTxTraces[1].Value)
 	}
 }
+
+// TestBlockExecutionProfile tests that the resources spent by the transactions of
+// the imported blocks are recorded, and the slow transactions are counted.
+func TestBlockExecutionProfile(t *testing.T) {
+	var (
+		db      = database.NewMemoryDBManager()
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc:  GenesisAlloc{address: {Balance: big.NewInt(10000000000000)}},
+		}
+		genesis = gspec.MustCommit(db)
+		signer  = types.NewEIP155Signer(gspec.Config.ChainID)
+	)
+
+	cacheConfig := &CacheConfig{
+		CacheSize:           512,
+		BlockInterval:       DefaultBlockInterval,
+		TriesInMemory:       DefaultTriesInMemory,
+		TrieNodeCacheConfig: statedb.GetEmptyTrieNodeCacheConfig(),
+		SlowTxThreshold:     time.Nanosecond,
+	}
+	blockchain, _ := NewBlockChain(db, cacheConfig, gspec.Config, gxhash.NewFaker(), vm.Config{})
+	defer blockchain.Stop()
+
+	blocks, receipts := GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), db, 2, func(i int, gen *BlockGen) {
+		for j := 0; j < 2; j++ {
+			tx, err := types.SignTx(types.NewTransaction(gen.TxNonce(address), common.Address{0xaa}, big.NewInt(1), params.TxGas, new(big.Int), nil), signer, key)
+			assert.NoError(t, err)
+			gen.AddTx(tx)
+		}
+	})
+
+	slowTxs := slowTxCounter.Count()
+	_, err := blockchain.InsertChain(blocks)
+	assert.NoError(t, err)
+	assert.Equal(t, slowTxs+4, slowTxCounter.Count())
+
+	for i, block := range blocks {
+		profile := blockchain.GetBlockExecutionProfile(block.Hash())
+		if !assert.NotNil(t, profile) {
+			continue
+		}
+		assert.Equal(t, block.NumberU64(), profile.Number)
+		assert.Equal(t, block.Hash(), profile.Hash)
+		assert.False(t, profile.Built)
+		assert.Equal(t, len(block.Transactions()), len(profile.Txs))
+
+		var total time.Duration
+		for j, tx := range profile.Txs {
+			assert.Equal(t, block.Transactions()[j].Hash(), tx.TxHash)
+			assert.Equal(t, receipts[i][j].GasUsed, tx.GasUsed)
+			assert.True(t, tx.Slow)
+			total += tx.ExecutionTime
+		}
+		assert.Equal(t, total, profile.ExecutionTime)
+	}
+	assert.Nil(t, blockchain.GetBlockExecutionProfile(common.Hash{}))
+}
@@ -39,6 +39,7 @@ Each file provides the following features
  - error.go : defines errors frequently used in blockchain package.
  - events.go : defines event structs delivered between go-routines.
  - evm.go : creates an EVM with a given context for use.
+ - execution_profile.go : records the execution time and the computation cost of the transactions in a block.
  - gaspool.go : defines GasPool which tracks and manages the amount of gas available during the transaction execution.
  - gen_genesis.go : is auto-generated code by gencodec to marshal/unmarshal Genesis as/from JSON.
  - gen_genesis_account.go : is auto-generated code by gencodec to marshal/unmarshal GenesisAccount as/from JSON.
@@ -48,6 +49,7 @@ Each file provides the following features
  - init_derive_sha.go : initialize a DeriveSha function with a specific type.
  - metrics.go : contains metrics used for blockchain package.
  - mkalloc.go : creates the genesis allocation constants in genesis_alloc.go.
+ - parallel_processor.go : applies the transactions of a block in parallel with conflict detection.
  - state_processor.go : implements StateProcessor which takes care of transitioning state.
  - state_transition.go : implements a state transaction model worked with messages in transactions.
  - tx_cacher.go : recovers senders of transactions from signatures and caches the sender address.
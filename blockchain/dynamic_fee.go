@@ -0,0 +1,71 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"math/big"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/params"
+)
+
+// CalcBaseFee returns the base fee of the block following the parent under the
+// dynamic fee mode. The first block of the dynamic fee fork, whose parent has no
+// base fee, starts with the lower bound.
+func CalcBaseFee(parent *types.Header, config *params.DynamicFeeConfig) *big.Int {
+	lowerBound := new(big.Int).SetUint64(config.LowerBoundBaseFee)
+	upperBound := new(big.Int).SetUint64(config.UpperBoundBaseFee)
+	if upperBound.Cmp(lowerBound) < 0 {
+		upperBound = lowerBound
+	}
+	if parent.BaseFee == nil {
+		return lowerBound
+	}
+
+	baseFee := new(big.Int).Set(parent.BaseFee)
+	if config.GasTarget != 0 && config.BaseFeeDenominator != 0 && parent.GasUsed != config.GasTarget {
+		var (
+			gasTarget   = new(big.Int).SetUint64(config.GasTarget)
+			denominator = new(big.Int).SetUint64(config.BaseFeeDenominator)
+			gasUsed     = new(big.Int).SetUint64(parent.GasUsed)
+		)
+		// delta = parentBaseFee * |gasUsed - gasTarget| / gasTarget / denominator
+		delta := new(big.Int).Sub(gasUsed, gasTarget)
+		delta.Abs(delta)
+		delta.Mul(delta, parent.BaseFee)
+		delta.Div(delta, gasTarget)
+		delta.Div(delta, denominator)
+
+		if parent.GasUsed > config.GasTarget {
+			// Make sure the base fee goes up when the block is congested.
+			if delta.Sign() == 0 {
+				delta.SetUint64(1)
+			}
+			baseFee.Add(baseFee, delta)
+		} else {
+			baseFee.Sub(baseFee, delta)
+		}
+	}
+
+	if baseFee.Cmp(lowerBound) < 0 {
+		return lowerBound
+	}
+	if baseFee.Cmp(upperBound) > 0 {
+		return upperBound
+	}
+	return baseFee
+}
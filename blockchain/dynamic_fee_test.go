@@ -0,0 +1,61 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcBaseFee(t *testing.T) {
+	config := &params.DynamicFeeConfig{
+		LowerBoundBaseFee:  100,
+		UpperBoundBaseFee:  1000,
+		GasTarget:          1000,
+		BaseFeeDenominator: 10,
+	}
+	testCases := []struct {
+		parentBaseFee *big.Int
+		gasUsed       uint64
+		expected      int64
+	}{
+		{nil, 0, 100},    // the first block of the fork
+		{nil, 5000, 100}, // the first block of the fork
+		{big.NewInt(500), 1000, 500},
+		{big.NewInt(500), 2000, 550},
+		{big.NewInt(500), 1500, 525},
+		{big.NewInt(500), 0, 450},
+		{big.NewInt(500), 500, 475},
+		{big.NewInt(100), 0, 100},      // lower bound
+		{big.NewInt(990), 2000, 1000},  // upper bound
+		{big.NewInt(101), 1001, 102},   // the base fee increases at least by 1
+		{big.NewInt(50), 1000, 100},    // raised to a new lower bound
+		{big.NewInt(2000), 1000, 1000}, // lowered to a new upper bound
+	}
+	for _, tc := range testCases {
+		parent := &types.Header{BaseFee: tc.parentBaseFee, GasUsed: tc.gasUsed}
+		assert.Equal(t, big.NewInt(tc.expected), CalcBaseFee(parent, config), "parentBaseFee: %v, gasUsed: %v", tc.parentBaseFee, tc.gasUsed)
+	}
+
+	// The upper bound lower than the lower bound is ignored.
+	config.UpperBoundBaseFee = 10
+	assert.Equal(t, big.NewInt(100), CalcBaseFee(&types.Header{BaseFee: big.NewInt(500), GasUsed: 2000}, config))
+}
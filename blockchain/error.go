@@ -30,6 +30,10 @@ var (
 	// by a transaction is higher than what's left in the block.
 	ErrGasLimitReached = errors.New("gas limit reached")
 
+	// ErrBlockGasLimitExceeded is returned if the gas used by a block to import is higher than
+	// the block gas limit set by the governance.
+	ErrBlockGasLimitExceeded = errors.New("block gas limit exceeded")
+
 	// ErrBlacklistedHash is returned if a block to import is on the blacklist.
 	ErrBlacklistedHash = errors.New("blacklisted hash")
 
@@ -60,6 +64,9 @@ var (
 	// ErrAlreadyNonceExistInPool is returned if there is another tx with the same nonce in the tx pool.
 	ErrAlreadyNonceExistInPool = errors.New("there is another tx which has the same nonce in the tx pool")
 
+	// ErrNoTxToReplace is returned if there is no tx with the same sender and nonce in the tx pool to be replaced.
+	ErrNoTxToReplace = errors.New("there is no tx which has the same nonce in the tx pool to replace")
+
 	// ErrInsufficientFunds is returned if the total cost of executing a transaction
 	// is higher than the balance of the user's account.
 	ErrInsufficientFunds = errors.New("insufficient funds for gas * price + value")
@@ -92,6 +99,9 @@ var (
 	// ErrInvlidUnitPrice is returned if gas price of transaction is not equal to UnitPrice
 	ErrInvalidUnitPrice = errors.New("invalid unit price")
 
+	// ErrGasPriceBelowBaseFee is returned if gas price of transaction is lower than the base fee of the block.
+	ErrGasPriceBelowBaseFee = errors.New("gas price below base fee")
+
 	// ErrInvalidChainId is returned if the chain id of transaction is not equal to the chain id of the chain config.
 	ErrInvalidChainId = errors.New("invalid chain id")
 
@@ -29,6 +29,20 @@ import (
 // NewTxsEvent is posted when a batch of transactions enter the transaction pool.
 type NewTxsEvent struct{ Txs []*types.Transaction }
 
+// ReplacedTxEvent is posted when a transaction in the transaction pool is replaced
+// by a new one with the same sender and nonce.
+type ReplacedTxEvent struct {
+	Old *types.Transaction
+	New *types.Transaction
+}
+
+// DroppedTxsEvent is posted when a batch of transactions is dropped from the
+// transaction pool without being included in a block.
+type DroppedTxsEvent struct {
+	Txs    []*types.Transaction
+	Reason string
+}
+
 // PendingLogsEvent is posted pre mining and notifies of pending logs.
 type PendingLogsEvent struct {
 	Logs []*types.Log
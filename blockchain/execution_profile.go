@@ -0,0 +1,97 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/rcrowley/go-metrics"
+)
+
+// maxExecutionProfiles is the number of recent block execution profiles kept in memory.
+const maxExecutionProfiles = 128
+
+var slowTxCounter = metrics.NewRegisteredCounter("chain/slowtx", nil)
+
+// TxExecutionProfile is the resources spent to execute a transaction.
+type TxExecutionProfile struct {
+	TxHash          common.Hash
+	ExecutionTime   time.Duration
+	ComputationCost uint64
+	GasUsed         uint64
+	Slow            bool // True if the execution time exceeds the slow transaction threshold
+}
+
+// BlockExecutionProfile is the resources spent to execute the transactions of a
+// block, recorded while the block is built or imported.
+type BlockExecutionProfile struct {
+	Number           uint64
+	Hash             common.Hash
+	Built            bool          // True if the block is built by this node, false if imported
+	ExecutionTime    time.Duration // Sum of the execution time of the transactions
+	TimeLimitReached bool          // True if building the block stopped by the time limit
+	Txs              []TxExecutionProfile
+
+	slowTxThreshold time.Duration
+}
+
+// NewBlockExecutionProfile creates an empty execution profile of the block with the
+// given header. The header hash is filled when the profile is written, since the
+// hash of a block being built is not known yet.
+func (bc *BlockChain) NewBlockExecutionProfile(header *types.Header, built bool) *BlockExecutionProfile {
+	return &BlockExecutionProfile{
+		Number:          header.Number.Uint64(),
+		Built:           built,
+		slowTxThreshold: bc.cacheConfig.SlowTxThreshold,
+	}
+}
+
+// WriteBlockExecutionProfile keeps the execution profile of the block with the given hash.
+func (bc *BlockChain) WriteBlockExecutionProfile(hash common.Hash, profile *BlockExecutionProfile) {
+	profile.Hash = hash
+	bc.executionProfiles.Add(hash, profile)
+}
+
+// GetBlockExecutionProfile returns the execution profile of the block with the given
+// hash, or nil if it is not kept.
+func (bc *BlockChain) GetBlockExecutionProfile(hash common.Hash) *BlockExecutionProfile {
+	if profile, ok := bc.executionProfiles.Get(hash); ok {
+		return profile.(*BlockExecutionProfile)
+	}
+	return nil
+}
+
+// AddTx adds the execution profile of a transaction. The transaction is logged and
+// counted as a slow one if its execution time exceeds the slow transaction threshold.
+func (p *BlockExecutionProfile) AddTx(tx *types.Transaction, elapsed time.Duration, computationCost uint64, gasUsed uint64) {
+	slow := p.slowTxThreshold > 0 && elapsed > p.slowTxThreshold
+	if slow {
+		slowTxCounter.Inc(1)
+		logger.Warn("Slow transaction execution", "number", p.Number, "built", p.Built, "tx", tx.Hash(),
+			"elapsed", common.PrettyDuration(elapsed), "computationCost", computationCost, "gas", gasUsed)
+	}
+	p.ExecutionTime += elapsed
+	p.Txs = append(p.Txs, TxExecutionProfile{
+		TxHash:          tx.Hash(),
+		ExecutionTime:   elapsed,
+		ComputationCost: computationCost,
+		GasUsed:         gasUsed,
+		Slow:            slow,
+	})
+}
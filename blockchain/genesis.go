@@ -179,6 +179,16 @@ func SetupGenesisBlock(db database.DBManager, genesis *Genesis, networkId uint64
 	if genesis != nil && genesis.Config == nil {
 		return params.AllGxhashProtocolChanges, common.Hash{}, errGenesisNoConfig
 	}
+	if genesis != nil {
+		if err := genesis.Config.CheckConfigForkOrder(); err != nil {
+			return genesis.Config, common.Hash{}, err
+		}
+		if gov := genesis.Config.Governance; gov != nil && gov.DynamicFee != nil {
+			if err := gov.DynamicFee.CheckBaseFeeBounds(); err != nil {
+				return genesis.Config, common.Hash{}, err
+			}
+		}
+	}
 
 	// Just commit the new block if there is no stored genesis block.
 	stored := db.ReadCanonicalHash(0)
@@ -421,6 +431,16 @@ func SetGenesisGovernance(genesis *Genesis) []byte {
 	g["istanbul.epoch"] = genesis.Config.Istanbul.Epoch
 	g["istanbul.policy"] = genesis.Config.Istanbul.ProposerPolicy
 	g["istanbul.committeesize"] = genesis.Config.Istanbul.SubGroupSize
+	if governance.Reward.StakingReward {
+		g["reward.stakingreward"] = governance.Reward.StakingReward
+	}
+	if dynamicFee := governance.DynamicFee; dynamicFee != nil {
+		g["dynamicfee.lowerboundbasefee"] = dynamicFee.LowerBoundBaseFee
+		g["dynamicfee.upperboundbasefee"] = dynamicFee.UpperBoundBaseFee
+		g["dynamicfee.gastarget"] = dynamicFee.GasTarget
+		g["dynamicfee.basefeedenominator"] = dynamicFee.BaseFeeDenominator
+		g["dynamicfee.burnratio"] = dynamicFee.BurnRatio
+	}
 
 	data, err := json.Marshal(g)
 	if err != nil {
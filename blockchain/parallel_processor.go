@@ -0,0 +1,165 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"errors"
+	"runtime"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/rcrowley/go-metrics"
+)
+
+var (
+	parallelTxCounter           = metrics.NewRegisteredCounter("chain/parallel/tx", nil)
+	parallelReexecutedTxCounter = metrics.NewRegisteredCounter("chain/parallel/reexecuted", nil)
+
+	errSpeculationAborted = errors.New("speculative execution aborted")
+)
+
+// speculativeTx is the result of a transaction applied to a copy of the state
+// at the beginning of the block.
+type speculativeTx struct {
+	state           *state.StateDB
+	accessSet       *state.AccessSet
+	receipt         *types.Receipt
+	gas             uint64
+	computationCost uint64
+	elapsed         time.Duration
+	err             error
+	done            chan struct{}
+}
+
+// canProcessInParallel returns true if the transactions of the block can be
+// applied in parallel. The transactions are applied one by one if a tracer is
+// used, since the tracer can not be shared by the transactions.
+func (p *StateProcessor) canProcessInParallel(block *types.Block, cfg vm.Config) bool {
+	return p.bc.cacheConfig.ParallelTxExecution && len(block.Transactions()) > 1 &&
+		!cfg.Debug && cfg.Tracer == nil && !cfg.EnableInternalTxTracing
+}
+
+// processInParallel applies the transactions of the block to the state and
+// returns the receipts, producing the same state and receipts as applying them
+// one by one.
+//
+// The transactions are applied speculatively in parallel, each to its own copy
+// of the state at the beginning of the block, while the accounts and storage
+// slots they access are recorded. The fee credited to the author by every
+// transaction is not recorded as an access, but deferred until the results are
+// merged into the state in the block order. A transaction is applied again to
+// the state if it failed or accessed anything written by a preceding
+// transaction, including the fees credited to the author.
+func (p *StateProcessor) processInParallel(block *types.Block, statedb *state.StateDB, cfg vm.Config, author common.Address, usedGas *uint64, profile *BlockExecutionProfile) (types.Receipts, error) {
+	var (
+		header = block.Header()
+		txs    = block.Transactions()
+		specs  = make([]*speculativeTx, len(txs))
+		jobs   = make(chan int, len(txs))
+		abort  = make(chan struct{})
+		base   = statedb.Copy()
+	)
+	defer close(abort)
+
+	for i := range txs {
+		specs[i] = &speculativeTx{done: make(chan struct{})}
+		jobs <- i
+	}
+	close(jobs)
+
+	workers := runtime.NumCPU()
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range jobs {
+				select {
+				case <-abort:
+					specs[i].err = errSpeculationAborted
+				default:
+					p.applySpeculatively(base, block, i, author, cfg, specs[i])
+				}
+				close(specs[i].done)
+			}
+		}()
+	}
+
+	var (
+		receipts = make(types.Receipts, 0, len(txs))
+		written  = state.NewAccessSet()
+
+		computationCost uint64
+		reexecCfg       = cfg
+	)
+	reexecCfg.ComputationCostHook = func(cost uint64) { computationCost = cost }
+
+	for i, tx := range txs {
+		spec := specs[i]
+		<-spec.done
+
+		statedb.Prepare(tx.Hash(), block.Hash(), i)
+		if spec.err != nil || spec.accessSet.Conflicts(written) {
+			accessSet := state.NewAccessSet()
+			statedb.SetAccessSet(accessSet)
+			start := time.Now()
+			receipt, _, _, err := p.bc.ApplyTransaction(p.config, &author, statedb, header, tx, usedGas, &reexecCfg)
+			statedb.SetAccessSet(nil)
+			if err != nil {
+				return nil, err
+			}
+			profile.AddTx(tx, time.Since(start), computationCost, receipt.GasUsed)
+			parallelReexecutedTxCounter.Inc(1)
+
+			receipts = append(receipts, receipt)
+			written.MergeWrites(accessSet)
+			continue
+		}
+
+		statedb.ApplyWrites(spec.state, spec.accessSet)
+		*usedGas += spec.gas
+		profile.AddTx(tx, spec.elapsed, spec.computationCost, spec.receipt.GasUsed)
+
+		// The logs are indexed again in the block
+		spec.receipt.Logs = statedb.GetLogs(tx.Hash())
+		receipts = append(receipts, spec.receipt)
+		written.MergeWrites(spec.accessSet)
+	}
+	parallelTxCounter.Inc(int64(len(txs)))
+
+	return receipts, nil
+}
+
+// applySpeculatively applies the i-th transaction of the block to a copy of the
+// given state, recording the accounts and storage slots accessed.
+func (p *StateProcessor) applySpeculatively(base *state.StateDB, block *types.Block, i int, author common.Address, cfg vm.Config, spec *speculativeTx) {
+	tx := block.Transactions()[i]
+
+	spec.state = base.Copy()
+	spec.accessSet = state.NewAccessSet()
+	spec.accessSet.DeferFees()
+	spec.state.SetAccessSet(spec.accessSet)
+	spec.state.Prepare(tx.Hash(), block.Hash(), i)
+
+	cfg.ComputationCostHook = func(cost uint64) { spec.computationCost = cost }
+	start := time.Now()
+	spec.receipt, _, _, spec.err = p.bc.ApplyTransaction(p.config, &author, spec.state, block.Header(), tx, &spec.gas, &cfg)
+	spec.elapsed = time.Since(start)
+}
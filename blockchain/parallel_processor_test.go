@@ -0,0 +1,213 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/gxhash"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/klaytn/klaytn/storage/statedb"
+	"github.com/stretchr/testify/assert"
+)
+
+// storageContractCode deploys a contract storing the second word of the call data
+// to the slot of the first word, and logging the slot as a topic.
+var storageContractCode = common.Hex2Bytes("6010600c60003960106000f3" + "6020356000355560003560006000a100")
+
+// TestParallelTxExecution tests that the transactions of the blocks applied in
+// parallel produce the same state and receipts as the ones applied one by one.
+func TestParallelTxExecution(t *testing.T) {
+	var (
+		db    = database.NewMemoryDBManager()
+		keys  = make([]*ecdsa.PrivateKey, 6)
+		addrs = make([]common.Address, len(keys))
+		alloc = GenesisAlloc{
+			// The author of the test blocks is funded, so that zero fees do not touch it
+			params.AuthorAddressForTesting: {Balance: big.NewInt(1)},
+		}
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+		alloc[addrs[i]] = GenesisAccount{Balance: big.NewInt(1000000000)}
+	}
+	gspec := &Genesis{Config: params.TestChainConfig, Alloc: alloc}
+	genesis := gspec.MustCommit(db)
+	signer := types.NewEIP155Signer(gspec.Config.ChainID)
+	contract := crypto.CreateAddress(addrs[0], 0)
+
+	blocks, receipts := GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), db, 3, func(i int, gen *BlockGen) {
+		send := func(from int, to *common.Address, amount int64, gas uint64, data []byte) {
+			var tx *types.Transaction
+			if to == nil {
+				tx = types.NewContractCreation(gen.TxNonce(addrs[from]), big.NewInt(amount), gas, new(big.Int), data)
+			} else {
+				tx = types.NewTransaction(gen.TxNonce(addrs[from]), *to, big.NewInt(amount), gas, new(big.Int), data)
+			}
+			tx, err := types.SignTx(tx, signer, keys[from])
+			assert.NoError(t, err)
+			gen.AddTx(tx)
+		}
+		store := func(from int, slot, value byte) {
+			send(from, &contract, 0, 100000, append(common.LeftPadBytes([]byte{slot}, 32), common.LeftPadBytes([]byte{value}, 32)...))
+		}
+
+		switch i {
+		case 0:
+			send(0, nil, 0, 1000000, storageContractCode)
+			for j := 1; j < len(keys); j++ {
+				send(j, &common.Address{byte(i), byte(j)}, 1, params.TxGas, nil)
+			}
+		default:
+			// Independent transfers to new accounts
+			send(1, &common.Address{byte(i), 1}, 1, params.TxGas, nil)
+			send(2, &common.Address{byte(i), 2}, 1, params.TxGas, nil)
+			// Transfers depending on the preceding ones of the same sender or recipient
+			send(1, &common.Address{byte(i), 3}, 1, params.TxGas, nil)
+			send(2, &addrs[3], 100, params.TxGas, nil)
+			send(3, &addrs[4], 100, params.TxGas, nil)
+			// Independent and conflicting storage writes
+			store(4, 1, byte(i))
+			store(5, 2, byte(i))
+			store(0, 1, byte(i+10))
+		}
+	})
+
+	cacheConfig := &CacheConfig{
+		CacheSize:           512,
+		BlockInterval:       DefaultBlockInterval,
+		TriesInMemory:       DefaultTriesInMemory,
+		TrieNodeCacheConfig: statedb.GetEmptyTrieNodeCacheConfig(),
+		ParallelTxExecution: true,
+	}
+	chainDB := database.NewMemoryDBManager()
+	gspec.MustCommit(chainDB)
+	blockchain, _ := NewBlockChain(chainDB, cacheConfig, gspec.Config, gxhash.NewFaker(), vm.Config{})
+	defer blockchain.Stop()
+
+	txs, reexecuted := parallelTxCounter.Count(), parallelReexecutedTxCounter.Count()
+	_, err := blockchain.InsertChain(blocks)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Some transactions are applied speculatively and the others are applied again
+	total := 0
+	for _, block := range blocks {
+		total += len(block.Transactions())
+	}
+	txs, reexecuted = parallelTxCounter.Count()-txs, parallelReexecutedTxCounter.Count()-reexecuted
+	assert.Equal(t, int64(total), txs)
+	assert.True(t, reexecuted > 0)
+	assert.True(t, reexecuted < txs)
+
+	for i, block := range blocks {
+		assert.Equal(t, block.Root(), blockchain.GetBlockByHash(block.Hash()).Root())
+
+		stored := blockchain.GetReceiptsByBlockHash(block.Hash())
+		if !assert.Equal(t, len(receipts[i]), len(stored)) {
+			continue
+		}
+		for j, receipt := range receipts[i] {
+			assert.Equal(t, receipt.Status, stored[j].Status)
+			assert.Equal(t, receipt.GasUsed, stored[j].GasUsed)
+			assert.Equal(t, receipt.ContractAddress, stored[j].ContractAddress)
+			assert.Equal(t, receipt.Bloom, stored[j].Bloom)
+			if !assert.Equal(t, len(receipt.Logs), len(stored[j].Logs)) {
+				continue
+			}
+			for k, log := range receipt.Logs {
+				assert.Equal(t, log.Topics, stored[j].Logs[k].Topics)
+				assert.Equal(t, log.Index, stored[j].Logs[k].Index)
+				assert.Equal(t, log.TxIndex, stored[j].Logs[k].TxIndex)
+			}
+		}
+	}
+
+	state, err := blockchain.State()
+	assert.NoError(t, err)
+	assert.Equal(t, common.BytesToHash([]byte{12}), state.GetState(contract, common.BytesToHash([]byte{1})))
+	assert.Equal(t, common.BytesToHash([]byte{2}), state.GetState(contract, common.BytesToHash([]byte{2})))
+}
+
+// TestParallelTxExecution_Fees tests that the fees credited to the author do not make
+// the transactions conflict, while a transaction accessing the author is applied again.
+func TestParallelTxExecution_Fees(t *testing.T) {
+	var (
+		db       = database.NewMemoryDBManager()
+		keys     = make([]*ecdsa.PrivateKey, 8)
+		addrs    = make([]common.Address, len(keys))
+		alloc    = GenesisAlloc{}
+		gasPrice = big.NewInt(25)
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+		alloc[addrs[i]] = GenesisAccount{Balance: big.NewInt(params.KLAY)}
+	}
+	gspec := &Genesis{Config: params.TestChainConfig, Alloc: alloc}
+	genesis := gspec.MustCommit(db)
+	signer := types.NewEIP155Signer(gspec.Config.ChainID)
+
+	// Independent transfers paying fees to the author, and a transfer to the author in the middle
+	author := params.AuthorAddressForTesting
+	blocks, _ := GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), db, 1, func(i int, gen *BlockGen) {
+		for j := range keys {
+			to := common.Address{byte(j + 1)}
+			if j == len(keys)/2 {
+				to = author
+			}
+			tx, err := types.SignTx(types.NewTransaction(gen.TxNonce(addrs[j]), to, big.NewInt(1), params.TxGas, gasPrice, nil), signer, keys[j])
+			assert.NoError(t, err)
+			gen.AddTx(tx)
+		}
+	})
+
+	cacheConfig := &CacheConfig{
+		CacheSize:           512,
+		BlockInterval:       DefaultBlockInterval,
+		TriesInMemory:       DefaultTriesInMemory,
+		TrieNodeCacheConfig: statedb.GetEmptyTrieNodeCacheConfig(),
+		ParallelTxExecution: true,
+	}
+	chainDB := database.NewMemoryDBManager()
+	gspec.MustCommit(chainDB)
+	blockchain, _ := NewBlockChain(chainDB, cacheConfig, gspec.Config, gxhash.NewFaker(), vm.Config{})
+	defer blockchain.Stop()
+
+	txs, reexecuted := parallelTxCounter.Count(), parallelReexecutedTxCounter.Count()
+	_, err := blockchain.InsertChain(blocks)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Only the transfer to the author is applied again
+	txs, reexecuted = parallelTxCounter.Count()-txs, parallelReexecutedTxCounter.Count()-reexecuted
+	assert.Equal(t, int64(len(keys)), txs)
+	assert.Equal(t, int64(1), reexecuted)
+
+	// The state root is the same as the one of the transactions applied one by one
+	assert.Equal(t, blocks[0].Root(), blockchain.CurrentBlock().Root())
+}
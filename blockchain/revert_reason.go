@@ -0,0 +1,60 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/common/hexutil"
+)
+
+// RevertReason is the decoded revert payload of a reverted execution.
+// Message is set if the payload is encoded as Error(string), and PanicCode is
+// set if it is encoded as Panic(uint256). Data always holds the raw payload.
+type RevertReason struct {
+	Message   string        `json:"message,omitempty"`
+	PanicCode *hexutil.Big  `json:"panicCode,omitempty"`
+	Data      hexutil.Bytes `json:"data"`
+}
+
+// DecodeRevertReason decodes the given revert payload. It returns nil if the
+// payload is empty.
+func DecodeRevertReason(data []byte) *RevertReason {
+	if len(data) == 0 {
+		return nil
+	}
+	reason := &RevertReason{Data: data}
+	if message, err := abi.UnpackRevert(data); err == nil {
+		reason.Message = message
+	} else if code, err := abi.UnpackPanic(data); err == nil {
+		reason.PanicCode = (*hexutil.Big)(code)
+	}
+	return reason
+}
+
+// String returns a human readable form of the revert reason.
+func (r *RevertReason) String() string {
+	switch {
+	case r.Message != "":
+		return r.Message
+	case r.PanicCode != nil:
+		return fmt.Sprintf("panic code %#x", r.PanicCode.ToInt())
+	default:
+		return r.Data.String()
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRevertReason(t *testing.T) {
+	assert.Nil(t, DecodeRevertReason(nil))
+
+	errorData := common.Hex2Bytes("08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000d72657665727420726561736f6e00000000000000000000000000000000000000")
+	reason := DecodeRevertReason(errorData)
+	assert.Equal(t, "revert reason", reason.Message)
+	assert.Nil(t, reason.PanicCode)
+	assert.Equal(t, errorData, []byte(reason.Data))
+	assert.Equal(t, "revert reason", reason.String())
+
+	panicData := common.Hex2Bytes("4e487b710000000000000000000000000000000000000000000000000000000000000001")
+	reason = DecodeRevertReason(panicData)
+	assert.Equal(t, "", reason.Message)
+	assert.Equal(t, big.NewInt(1), reason.PanicCode.ToInt())
+	assert.Equal(t, "panic code 0x1", reason.String())
+
+	customData := common.Hex2Bytes("deadbeef")
+	reason = DecodeRevertReason(customData)
+	assert.Equal(t, "", reason.Message)
+	assert.Nil(t, reason.PanicCode)
+	assert.Equal(t, "0xdeadbeef", reason.String())
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"github.com/klaytn/klaytn/blockchain/types/account"
+	"github.com/klaytn/klaytn/common"
+	"math/big"
+)
+
+// StorageSlot identifies a storage slot of an account.
+type StorageSlot struct {
+	Address common.Address
+	Key     common.Hash
+}
+
+// AccessSet is the set of the accounts and the storage slots read and written
+// while transactions are applied to a StateDB.
+//
+// An account is read if any of its fields or storage slots is read, and it is
+// written if any of its fields is changed. A change of a storage slot only
+// writes the slot, not the account.
+type AccessSet struct {
+	ReadAccounts  map[common.Address]struct{}
+	WriteAccounts map[common.Address]struct{}
+	ReadSlots     map[StorageSlot]struct{}
+	WriteSlots    map[StorageSlot]struct{}
+
+	// Accounts created or reset, whose storage is replaced as a whole
+	Created map[common.Address]struct{}
+
+	// Transaction fees deferred until the writes are applied, if not nil
+	Fees map[common.Address]*big.Int
+}
+
+// NewAccessSet returns an empty access set.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		ReadAccounts:  make(map[common.Address]struct{}),
+		WriteAccounts: make(map[common.Address]struct{}),
+		ReadSlots:     make(map[StorageSlot]struct{}),
+		WriteSlots:    make(map[StorageSlot]struct{}),
+		Created:       make(map[common.Address]struct{}),
+	}
+}
+
+// DeferFees makes the transaction fees accumulated in the set instead of being
+// credited to the state. The fee of every transaction is credited to the same
+// account, so it would make all the transactions conflict if it were recorded
+// as an access. The fees are credited when the writes of the set are applied.
+func (set *AccessSet) DeferFees() {
+	set.Fees = make(map[common.Address]*big.Int)
+}
+
+// Conflicts returns true if any account or storage slot read or written in the
+// set is written in the given set.
+func (set *AccessSet) Conflicts(written *AccessSet) bool {
+	for _, accounts := range []map[common.Address]struct{}{set.ReadAccounts, set.WriteAccounts} {
+		for addr := range accounts {
+			if _, ok := written.WriteAccounts[addr]; ok {
+				return true
+			}
+		}
+	}
+	for _, slots := range []map[StorageSlot]struct{}{set.ReadSlots, set.WriteSlots} {
+		for slot := range slots {
+			if _, ok := written.WriteSlots[slot]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MergeWrites adds the accounts and the storage slots written in the given set.
+// The accounts credited with the deferred fees are written as well.
+func (set *AccessSet) MergeWrites(other *AccessSet) {
+	for addr := range other.WriteAccounts {
+		set.WriteAccounts[addr] = struct{}{}
+	}
+	for addr := range other.Fees {
+		set.WriteAccounts[addr] = struct{}{}
+	}
+	for slot := range other.WriteSlots {
+		set.WriteSlots[slot] = struct{}{}
+	}
+	for addr := range other.Created {
+		set.Created[addr] = struct{}{}
+	}
+}
+
+// recordJournal adds the accounts and the storage slots changed by the journal
+// entries. Reverted changes are not recorded since their entries are removed.
+func (set *AccessSet) recordJournal(j *journal) {
+	for _, entry := range j.entries {
+		switch ch := entry.(type) {
+		case storageChange:
+			set.WriteSlots[StorageSlot{*ch.account, ch.key}] = struct{}{}
+		case createObjectChange:
+			set.WriteAccounts[*ch.account] = struct{}{}
+			set.Created[*ch.account] = struct{}{}
+		case resetObjectChange:
+			set.WriteAccounts[ch.prev.address] = struct{}{}
+			set.Created[ch.prev.address] = struct{}{}
+		default:
+			if addr := entry.dirtied(); addr != nil {
+				set.WriteAccounts[*addr] = struct{}{}
+			}
+		}
+	}
+}
+
+// SetAccessSet starts recording the accounts and the storage slots accessed in
+// the state to the given set. A nil set stops recording.
+//
+// The changes are recorded when the state is finalised, so the set of a
+// transaction is complete after the transaction is applied.
+func (self *StateDB) SetAccessSet(set *AccessSet) {
+	self.accessSet = set
+}
+
+// ApplyWrites copies the accounts and the storage slots written in the set from
+// src, a copy of the state to which a transaction was applied speculatively,
+// and finalises the state as if the transaction was applied to it. The fees
+// deferred in the set are credited, and the logs of the transaction are added
+// with the transaction hash and index prepared in the state.
+//
+// The caller should make sure that nothing accessed in the set is changed in
+// the state since src was copied.
+func (self *StateDB) ApplyWrites(src *StateDB, set *AccessSet) {
+	for addr := range set.WriteAccounts {
+		srcObj := src.stateObjects[addr]
+		if srcObj == nil {
+			continue
+		}
+		obj := self.getStateObject(addr)
+		if _, created := set.Created[addr]; obj == nil || created || srcObj.deleted {
+			self.setStateObject(srcObj.deepCopy(self))
+		} else {
+			// Keep the storage of the object, which may be changed by the
+			// previous transactions, and take the other fields from src.
+			acc := srcObj.account.DeepCopy()
+			if pa, prev := account.GetProgramAccount(acc), account.GetProgramAccount(obj.account); pa != nil && prev != nil {
+				pa.SetStorageRoot(prev.GetStorageRoot())
+			}
+			obj.account = acc
+			obj.code, obj.dirtyCode = srcObj.code, srcObj.dirtyCode
+			obj.suicided = srcObj.suicided
+		}
+		self.journal.dirty(addr)
+	}
+	for slot := range set.WriteSlots {
+		if _, created := set.Created[slot.Address]; created {
+			continue
+		}
+		srcObj, obj := src.stateObjects[slot.Address], self.getStateObject(slot.Address)
+		if srcObj == nil || srcObj.deleted || obj == nil {
+			continue
+		}
+		obj.setState(slot.Key, srcObj.GetState(src.db, slot.Key))
+		self.journal.dirty(slot.Address)
+	}
+	for addr, fee := range set.Fees {
+		self.AddBalance(addr, fee)
+	}
+	for _, log := range src.logs[src.thash] {
+		copied := *log
+		self.AddLog(&copied)
+	}
+	for hash, preimage := range src.preimages {
+		if _, ok := self.preimages[hash]; !ok {
+			self.preimages[hash] = preimage
+		}
+	}
+	self.Finalise(true, false)
+}
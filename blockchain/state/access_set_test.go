@@ -0,0 +1,93 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAccessSet tests that the accesses of a change are recorded, and that the
+// change applied to a copy of the state is merged with the preceding change.
+func TestAccessSet(t *testing.T) {
+	var (
+		eoa      = common.HexToAddress("0x1111")
+		newEOA   = common.HexToAddress("0x2222")
+		contract = common.HexToAddress("0x3333")
+		slot     = func(b byte) common.Hash { return common.BytesToHash([]byte{b}) }
+	)
+	preceding := func(s *StateDB) {
+		s.SetState(contract, slot(1), slot(10))
+		s.Finalise(true, false)
+	}
+	change := func(s *StateDB) {
+		s.SubBalance(eoa, big.NewInt(1))
+		s.AddBalance(newEOA, big.NewInt(1))
+		s.GetState(contract, slot(4))
+		s.SetState(contract, slot(2), slot(20))
+		snapshot := s.Snapshot()
+		s.SetState(contract, slot(3), slot(30))
+		s.RevertToSnapshot(snapshot)
+		s.Finalise(true, false)
+	}
+
+	base, _ := New(common.Hash{}, NewDatabase(database.NewMemoryDBManager()))
+	base.AddBalance(eoa, big.NewInt(10))
+	base.SetCode(contract, []byte{0x00})
+	root, _ := base.Commit(true)
+
+	// Apply the change to a copy of the state
+	speculative, _ := New(root, base.Database())
+	set := NewAccessSet()
+	speculative.SetAccessSet(set)
+	change(speculative)
+
+	assert.Equal(t, map[common.Address]struct{}{eoa: {}, newEOA: {}}, set.WriteAccounts)
+	assert.Equal(t, map[common.Address]struct{}{newEOA: {}}, set.Created)
+	assert.Equal(t, map[StorageSlot]struct{}{{contract, slot(2)}: {}}, set.WriteSlots)
+	assert.Contains(t, set.ReadAccounts, contract)
+	assert.Contains(t, set.ReadSlots, StorageSlot{contract, slot(3)})
+	assert.Contains(t, set.ReadSlots, StorageSlot{contract, slot(4)})
+
+	// Only the accesses to the written accounts and storage slots conflict
+	written := NewAccessSet()
+	written.WriteSlots[StorageSlot{contract, slot(1)}] = struct{}{}
+	assert.False(t, set.Conflicts(written))
+	written.WriteSlots[StorageSlot{contract, slot(4)}] = struct{}{}
+	assert.True(t, set.Conflicts(written))
+	written = NewAccessSet()
+	written.WriteAccounts[contract] = struct{}{}
+	assert.True(t, set.Conflicts(written))
+
+	// Merging the change after the preceding one is the same as applying both
+	sequential, _ := New(root, base.Database())
+	preceding(sequential)
+	change(sequential)
+
+	merged, _ := New(root, base.Database())
+	preceding(merged)
+	merged.ApplyWrites(speculative, set)
+
+	assert.Equal(t, sequential.IntermediateRoot(true), merged.IntermediateRoot(true))
+	assert.Equal(t, slot(10), merged.GetState(contract, slot(1)))
+	assert.Equal(t, slot(20), merged.GetState(contract, slot(2)))
+	assert.Equal(t, big.NewInt(1), merged.GetBalance(newEOA))
+}
@@ -34,6 +34,7 @@ StateDB caches stateObjects and mediates the operations to them.
 Source Files
 
 Related functions and variables are defined in the files listed below
+  - access_set.go            : AccessSet records the accounts and storage slots accessed in StateDB
   - database.go              : Defines Database and other interfaces used in the package
   - dump.go                  : Functions to dump the contents of StateDB both in raw format and indented format
   - journal.go               : journal and state changes to track the list of state modifications since the last state commit
@@ -88,6 +88,7 @@ type stateObject struct {
 	storageTrie Trie // storage trie, which becomes non-nil on first access
 	code        Code // contract bytecode, which gets set when code is loaded
 
+	originStorage Storage // Storage entries committed by the previous transactions
 	cachedStorage Storage // Storage entry cache to avoid duplicate reads
 	dirtyStorage  Storage // Storage entries that need to be flushed to disk
 
@@ -119,6 +120,7 @@ func newObject(db *StateDB, address common.Address, data account.Account) *state
 		db:            db,
 		address:       address,
 		account:       data,
+		originStorage: make(Storage),
 		cachedStorage: make(Storage),
 		dirtyStorage:  make(Storage),
 	}
@@ -175,6 +177,18 @@ func (self *stateObject) GetState(db Database, key common.Hash) common.Hash {
 	if exists {
 		return value
 	}
+	value = self.GetCommittedState(db, key)
+	self.cachedStorage[key] = value
+	return value
+}
+
+// GetCommittedState returns a value in account storage committed by the previous
+// transactions, ignoring the changes made by the current transaction.
+func (self *stateObject) GetCommittedState(db Database, key common.Hash) common.Hash {
+	value, exists := self.originStorage[key]
+	if exists {
+		return value
+	}
 	// Load from DB in case it is missing.
 	enc, err := self.getStorageTrie(db).TryGet(key[:])
 	if err != nil {
@@ -188,7 +202,7 @@ func (self *stateObject) GetState(db Database, key common.Hash) common.Hash {
 		}
 		value.SetBytes(content)
 	}
-	self.cachedStorage[key] = value
+	self.originStorage[key] = value
 	return value
 }
 
@@ -246,6 +260,7 @@ func (self *stateObject) updateStorageTrie(db Database) Trie {
 	tr := self.getStorageTrie(db)
 	for key, value := range self.dirtyStorage {
 		delete(self.dirtyStorage, key)
+		self.originStorage[key] = value
 		if (value == common.Hash{}) {
 			self.setError(tr.TryDelete(key[:]))
 			continue
@@ -341,6 +356,7 @@ func (self *stateObject) deepCopy(db *StateDB) *stateObject {
 	stateObject.code = self.code
 	stateObject.dirtyStorage = self.dirtyStorage.Copy()
 	stateObject.cachedStorage = self.dirtyStorage.Copy()
+	stateObject.originStorage = self.originStorage.Copy()
 	stateObject.suicided = self.suicided
 	stateObject.dirtyCode = self.dirtyCode
 	stateObject.deleted = self.deleted
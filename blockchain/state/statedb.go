@@ -91,6 +91,9 @@ type StateDB struct {
 	journal        *journal
 	validRevisions []revision
 	nextRevisionId int
+
+	// Accounts and storage slots accessed, recorded only if not nil.
+	accessSet *AccessSet
 }
 
 // NewCachedStateObjects returns a new Common.Cache object for cachedStateObjects.
@@ -272,6 +275,16 @@ func (self *StateDB) AddRefund(gas uint64) {
 	self.refund += gas
 }
 
+// SubRefund removes gas from the refund counter.
+// This method will panic if the refund counter goes below zero
+func (self *StateDB) SubRefund(gas uint64) {
+	self.journal.append(refundChange{prev: self.refund})
+	if gas > self.refund {
+		panic("Refund counter below zero")
+	}
+	self.refund -= gas
+}
+
 // Exist reports whether the given account address exists in the state.
 // Notably this also returns true for suicided accounts.
 func (self *StateDB) Exist(addr common.Address) bool {
@@ -358,6 +371,9 @@ func (self *StateDB) GetCodeHash(addr common.Address) common.Hash {
 }
 
 func (self *StateDB) GetState(addr common.Address, bhash common.Hash) common.Hash {
+	if self.accessSet != nil {
+		self.accessSet.ReadSlots[StorageSlot{addr, bhash}] = struct{}{}
+	}
 	stateObject := self.getStateObject(addr)
 	if stateObject != nil {
 		return stateObject.GetState(self.db, bhash)
@@ -365,6 +381,18 @@ func (self *StateDB) GetState(addr common.Address, bhash common.Hash) common.Has
 	return common.Hash{}
 }
 
+// GetCommittedState retrieves a value from the given account's committed storage trie.
+func (self *StateDB) GetCommittedState(addr common.Address, hash common.Hash) common.Hash {
+	if self.accessSet != nil {
+		self.accessSet.ReadSlots[StorageSlot{addr, hash}] = struct{}{}
+	}
+	stateObject := self.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetCommittedState(self.db, hash)
+	}
+	return common.Hash{}
+}
+
 // IsContractAvailable returns true if the account corresponding to the given address implements ProgramAccount.
 func (self *StateDB) IsContractAvailable(addr common.Address) bool {
 	stateObject := self.getStateObject(addr)
@@ -439,6 +467,21 @@ func (self *StateDB) AddBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// AddFee adds the transaction fee to the account associated with addr.
+// If the fees are deferred in the access set being recorded, the fee is
+// added to the set instead, and credited when the writes of the set are applied.
+func (self *StateDB) AddFee(addr common.Address, amount *big.Int) {
+	if self.accessSet != nil && self.accessSet.Fees != nil {
+		if fee, ok := self.accessSet.Fees[addr]; ok {
+			fee.Add(fee, amount)
+		} else {
+			self.accessSet.Fees[addr] = new(big.Int).Set(amount)
+		}
+		return
+	}
+	self.AddBalance(addr, amount)
+}
+
 // SubBalance subtracts amount from the account associated with addr.
 func (self *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	stateObject := self.GetOrNewStateObject(addr)
@@ -479,6 +522,10 @@ func (self *StateDB) SetCode(addr common.Address, code []byte) error {
 }
 
 func (self *StateDB) SetState(addr common.Address, key, value common.Hash) {
+	// The previous value is read for the journal, so the slot is read as well.
+	if self.accessSet != nil {
+		self.accessSet.ReadSlots[StorageSlot{addr, key}] = struct{}{}
+	}
 	stateObject := self.GetOrNewSmartContract(addr)
 	if stateObject != nil {
 		stateObject.SetState(self.db, key, value)
@@ -489,6 +536,10 @@ func (self *StateDB) SetState(addr common.Address, key, value common.Hash) {
 func (self *StateDB) UpdateKey(addr common.Address, newKey accountkey.AccountKey, currentBlockNumber uint64) error {
 	stateObject := self.getStateObject(addr)
 	if stateObject != nil {
+		// The key change is not journaled, so it is recorded here.
+		if self.accessSet != nil {
+			self.accessSet.WriteAccounts[addr] = struct{}{}
+		}
 		return stateObject.UpdateKey(newKey, currentBlockNumber)
 	}
 
@@ -549,6 +600,10 @@ func (self *StateDB) deleteStateObject(stateObject *stateObject) {
 
 // Retrieve a state object given by the address. Returns nil if not found.
 func (self *StateDB) getStateObject(addr common.Address) *stateObject {
+	if self.accessSet != nil {
+		self.accessSet.ReadAccounts[addr] = struct{}{}
+	}
+
 	// First, check stateObjects if there is "live" object.
 	if obj := self.stateObjects[addr]; obj != nil {
 		if obj.deleted {
@@ -732,16 +787,17 @@ func (db *StateDB) ForEachStorage(addr common.Address, cb func(key, value common
 func (self *StateDB) Copy() *StateDB {
 	// Copy all the basic fields, initialize the memory ones
 	state := &StateDB{
-		db:                 self.db,
-		trie:               self.db.CopyTrie(self.trie),
-		stateObjects:       make(map[common.Address]*stateObject, len(self.journal.dirties)),
-		stateObjectsDirty:  make(map[common.Address]struct{}, len(self.journal.dirties)),
-		cachedStateObjects: nil,
-		refund:             self.refund,
-		logs:               make(map[common.Hash][]*types.Log, len(self.logs)),
-		logSize:            self.logSize,
-		preimages:          make(map[common.Hash][]byte),
-		journal:            newJournal(),
+		db:                       self.db,
+		trie:                     self.db.CopyTrie(self.trie),
+		stateObjects:             make(map[common.Address]*stateObject, len(self.journal.dirties)),
+		stateObjectsDirty:        make(map[common.Address]struct{}, len(self.journal.dirties)),
+		stateObjectsDirtyStorage: make(map[common.Address]struct{}, len(self.stateObjectsDirtyStorage)),
+		cachedStateObjects:       nil,
+		refund:                   self.refund,
+		logs:                     make(map[common.Hash][]*types.Log, len(self.logs)),
+		logSize:                  self.logSize,
+		preimages:                make(map[common.Hash][]byte),
+		journal:                  newJournal(),
 	}
 	// Copy the dirty states, logs, and preimages
 	for addr := range self.journal.dirties {
@@ -764,6 +820,10 @@ func (self *StateDB) Copy() *StateDB {
 		}
 	}
 
+	for addr := range self.stateObjectsDirtyStorage {
+		state.stateObjectsDirtyStorage[addr] = struct{}{}
+	}
+
 	// NOTE-Klaytn-StateDB cachedStateObject is cache, so not copied to copied StateDB.
 
 	deepCopyLogs(self, state)
@@ -824,6 +884,9 @@ func (self *StateDB) GetRefund() uint64 {
 // Finalise finalises the state by removing the self destructed objects
 // and clears the journal as well as the refunds.
 func (stateDB *StateDB) Finalise(deleteEmptyObjects bool, setStorageRoot bool) {
+	if stateDB.accessSet != nil {
+		stateDB.accessSet.recordJournal(stateDB.journal)
+	}
 	for addr := range stateDB.journal.dirties {
 		so, exist := stateDB.stateObjects[addr]
 		if !exist {
@@ -837,6 +900,9 @@ func (stateDB *StateDB) Finalise(deleteEmptyObjects bool, setStorageRoot bool) {
 		}
 
 		if so.suicided || (deleteEmptyObjects && so.empty()) {
+			if stateDB.accessSet != nil {
+				stateDB.accessSet.WriteAccounts[addr] = struct{}{}
+			}
 			stateDB.deleteStateObject(so)
 		} else {
 			so.updateStorageTrie(stateDB.db)
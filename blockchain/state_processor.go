@@ -26,6 +26,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain/vm"
 	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/params"
+	"time"
 )
 
 // StateProcessor is a basic Processor, which takes care of transitioning
@@ -62,28 +63,48 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 		internalTxTraces []*vm.InternalTxTrace
 	)
 
-	// Enable the opcode computation cost limit
+	// Enable the opcode computation cost limit in effect at the block
 	cfg.UseOpcodeComputationCost = true
+	cfg.ComputationCostLimit = p.bc.ComputationCostLimit(block.NumberU64())
+
+	// Record the resources spent by each transaction
+	profile := p.bc.NewBlockExecutionProfile(header, false)
+	var computationCost uint64
+	cfg.ComputationCostHook = func(cost uint64) { computationCost = cost }
 
 	// Extract author from the header
 	author, _ := p.bc.Engine().Author(header) // Ignore error, we're past header validation
 
-	// Iterate over and process the individual transactions
-	for i, tx := range block.Transactions() {
-		statedb.Prepare(tx.Hash(), block.Hash(), i)
-		receipt, _, internalTxTrace, err := p.bc.ApplyTransaction(p.config, &author, statedb, header, tx, usedGas, &cfg)
-		if err != nil {
+	if p.canProcessInParallel(block, cfg) {
+		var err error
+		if receipts, err = p.processInParallel(block, statedb, cfg, author, usedGas, profile); err != nil {
 			return nil, nil, 0, nil, err
 		}
-		receipts = append(receipts, receipt)
-		allLogs = append(allLogs, receipt.Logs...)
-		internalTxTraces = append(internalTxTraces, internalTxTrace)
+		for _, receipt := range receipts {
+			allLogs = append(allLogs, receipt.Logs...)
+			internalTxTraces = append(internalTxTraces, nil)
+		}
+	} else {
+		// Iterate over and process the individual transactions
+		for i, tx := range block.Transactions() {
+			statedb.Prepare(tx.Hash(), block.Hash(), i)
+			start := time.Now()
+			receipt, _, internalTxTrace, err := p.bc.ApplyTransaction(p.config, &author, statedb, header, tx, usedGas, &cfg)
+			if err != nil {
+				return nil, nil, 0, nil, err
+			}
+			profile.AddTx(tx, time.Since(start), computationCost, receipt.GasUsed)
+			receipts = append(receipts, receipt)
+			allLogs = append(allLogs, receipt.Logs...)
+			internalTxTraces = append(internalTxTraces, internalTxTrace)
+		}
 	}
 
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
 	if _, err := p.engine.Finalize(p.bc, header, statedb, block.Transactions(), receipts); err != nil {
 		return nil, nil, 0, nil, err
 	}
+	p.bc.WriteBlockExecutionProfile(block.Hash(), profile)
 
 	return receipts, allLogs, *usedGas, internalTxTraces, nil
 }
@@ -125,10 +125,15 @@ type kerror struct {
 
 // NewStateTransition initialises and returns a new state transition object.
 func NewStateTransition(evm *vm.EVM, msg Message) *StateTransition {
+	// After the dynamic fee fork, a transaction is charged at the base fee of the block.
+	gasPrice := msg.GasPrice()
+	if evm.BaseFee != nil && msg.CheckNonce() {
+		gasPrice = evm.BaseFee
+	}
 	return &StateTransition{
 		evm:      evm,
 		msg:      msg,
-		gasPrice: msg.GasPrice(),
+		gasPrice: gasPrice,
 		value:    msg.Value(),
 		data:     msg.Data(),
 		state:    evm.StateDB,
@@ -219,6 +224,13 @@ func (st *StateTransition) preCheck() error {
 				"accountNonce", nonce, "txNonce", st.msg.Nonce(), "txHash", st.msg.Hash().String())
 			return ErrNonceTooLow
 		}
+		// After the dynamic fee fork, a transaction can not be included in a block
+		// whose base fee is higher than the gas price of the transaction.
+		if st.evm.BaseFee != nil && st.msg.GasPrice().Cmp(st.evm.BaseFee) < 0 {
+			logger.Debug(ErrGasPriceBelowBaseFee.Error(), "gasPrice", st.msg.GasPrice(),
+				"baseFee", st.evm.BaseFee, "txHash", st.msg.Hash().String())
+			return ErrGasPriceBelowBaseFee
+		}
 	}
 	return st.buyGas()
 }
@@ -264,9 +276,10 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, kerr kerr
 	}
 	st.refundGas()
 
-	// Defer transferring Tx fee when DeferredTxFee is true
-	if st.evm.ChainConfig().Governance == nil || !st.evm.ChainConfig().Governance.DeferredTxFee() {
-		st.state.AddBalance(st.evm.Coinbase, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice))
+	// Defer transferring Tx fee when DeferredTxFee is true.
+	// After the dynamic fee fork, Tx fee is always handled at the end of the block, where a part of it is burned.
+	if st.evm.BaseFee == nil && (st.evm.ChainConfig().Governance == nil || !st.evm.ChainConfig().Governance.DeferredTxFee()) {
+		st.state.AddFee(st.evm.Coinbase, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice))
 	}
 
 	kerr.ErrTxInvalid = nil
@@ -316,6 +316,10 @@ func (l *txList) Filter(senderBalance *big.Int, pool *TxPool) (types.Transaction
 		if tx.IsMarkedUnexecutable() {
 			return true
 		}
+		// After the dynamic fee fork, drop a tx which can not pay the base fee of the current head.
+		if isBelowBaseFee(tx, pool.baseFee) {
+			return true
+		}
 		// Since there are mutable values such as accountKey in the state, a tx can be invalidated with the state change.
 		if tx.ValidateMutableValue(pool.currentState, pool.signer, pool.currentBlockNumber) != nil {
 			return true
@@ -351,14 +355,15 @@ func (l *txList) Filter(senderBalance *big.Int, pool *TxPool) (types.Transaction
 	return removed, invalids
 }
 
-// FilterUnexecutable removes all transactions marked as unexecutable.
-func (l *txList) FilterUnexecutable() (types.Transactions, types.Transactions) {
+// FilterUnexecutable removes all transactions marked as unexecutable
+// or the ones which can not pay the given base fee.
+func (l *txList) FilterUnexecutable(baseFee *big.Int) (types.Transactions, types.Transactions) {
 	removed := l.txs.Filter(func(tx *types.Transaction) bool {
 		// Drop a tx if it is marked as un-executable on block generation process.
 		if tx.IsMarkedUnexecutable() {
 			return true
 		}
-		return false
+		return isBelowBaseFee(tx, baseFee)
 	})
 
 	// If the list was strict, filter anything above the lowest nonce
@@ -376,6 +381,12 @@ func (l *txList) FilterUnexecutable() (types.Transactions, types.Transactions) {
 	return removed, invalids
 }
 
+// isBelowBaseFee returns true if the gas price of the tx is lower than the base fee.
+// The base fee is nil before the dynamic fee fork.
+func isBelowBaseFee(tx *types.Transaction, baseFee *big.Int) bool {
+	return baseFee != nil && tx.GasPrice().Cmp(baseFee) < 0
+}
+
 // Cap places a hard limit on the number of items, returning all transactions
 // exceeding that limit.
 func (l *txList) Cap(threshold int) types.Transactions {
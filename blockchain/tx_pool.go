@@ -57,6 +57,24 @@ var (
 	txPoolIsFullErr = fmt.Errorf("txpool is full")
 
 	errNotAllowedAnchoringTx = errors.New("locally anchoring chaindata tx is not allowed in this node")
+	errNotAllowedReplaceTx   = errors.New("replacing tx is not allowed when local transaction handling is disabled")
+)
+
+// Reasons of DroppedTxsEvent, which tell why the transactions are dropped from the pool.
+const (
+	TxDropReasonUnderpriced  = "underpriced"
+	TxDropReasonPoolFull     = "txpool is full"
+	TxDropReasonRateLimit    = "exceeding the slots of the account"
+	TxDropReasonExpired      = "expired"
+	TxDropReasonUnexecutable = "unexecutable"
+	TxDropReasonRemoved      = "removed by request"
+)
+
+// Reasons why a queued transaction can not be executed yet.
+const (
+	TxNonExecReasonNonceGap                    = "nonce gap"
+	TxNonExecReasonInsufficientBalance         = "insufficient balance"
+	TxNonExecReasonInsufficientFeePayerBalance = "insufficient fee payer balance"
 )
 
 var (
@@ -109,6 +127,8 @@ type TxPoolConfig struct {
 	AllowLocalAnchorTx bool          // if this is true, the txpool allow locally submitted anchor transactions
 	Journal            string        // Journal of local transactions to survive node restarts
 	JournalInterval    time.Duration // Time interval to regenerate the local transaction journal
+	Snapshot           string        // Snapshot of all transactions to survive node restarts (disabled if empty)
+	SnapshotInterval   time.Duration // Time interval to write the snapshot of all transactions
 
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
@@ -122,13 +142,16 @@ type TxPoolConfig struct {
 	Lifetime   time.Duration // Maximum amount of time non-executable transaction are queued
 
 	NoAccountCreation bool // Whether account creation transactions should be disabled
+
+	PriorityClasses []TxPriorityClass // Classes of transactions which have reserved slots and are executed first
 }
 
 // DefaultTxPoolConfig contains the default configurations for the transaction
 // pool.
 var DefaultTxPoolConfig = TxPoolConfig{
-	Journal:         "transactions.rlp",
-	JournalInterval: time.Hour,
+	Journal:          "transactions.rlp",
+	JournalInterval:  time.Hour,
+	SnapshotInterval: 10 * time.Minute,
 
 	PriceLimit: 1,
 	PriceBump:  10,
@@ -150,6 +173,10 @@ func (config *TxPoolConfig) sanitize() TxPoolConfig {
 		logger.Error("Sanitizing invalid txpool journal time", "provided", conf.JournalInterval, "updated", time.Second)
 		conf.JournalInterval = time.Second
 	}
+	if conf.SnapshotInterval < time.Second {
+		logger.Error("Sanitizing invalid txpool snapshot time", "provided", conf.SnapshotInterval, "updated", time.Second)
+		conf.SnapshotInterval = time.Second
+	}
 	if conf.PriceLimit < 1 {
 		logger.Error("Sanitizing invalid txpool price limit", "provided", conf.PriceLimit, "updated", DefaultTxPoolConfig.PriceLimit)
 		conf.PriceLimit = DefaultTxPoolConfig.PriceLimit
@@ -173,7 +200,12 @@ type TxPool struct {
 	chainconfig  *params.ChainConfig
 	chain        blockChain
 	gasPrice     *big.Int
+	baseFee      *big.Int // Base fee of the current head, nil before the dynamic fee fork
+	minimumPrice *big.Int // Minimum gas price of the transactions to be accepted
+	maxTxSize    uint64   // Maximum size of the transactions to be accepted
 	txFeed       event.Feed
+	replaceFeed  event.Feed
+	dropFeed     event.Feed
 	scope        event.SubscriptionScope
 	chainHeadCh  chan ChainHeadEvent
 	chainHeadSub event.Subscription
@@ -184,8 +216,10 @@ type TxPool struct {
 	currentState       *state.StateDB            // Current state in the blockchain head
 	pendingNonce       map[common.Address]uint64 // Pending nonce tracking virtual nonces
 
-	locals  *accountSet // Set of local transaction to exempt from eviction rules
-	journal *txJournal  // Journal of local transaction to back up to disk
+	priority *txPriority // Priority classes of transactions
+	locals   *accountSet // Set of local transaction to exempt from eviction rules
+	journal  *txJournal  // Journal of local transaction to back up to disk
+	snapshot *txSnapshot // Snapshot of all transactions to back up to disk
 
 	//TODO-Klaytn
 	txMu sync.RWMutex
@@ -225,11 +259,14 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		// TODO-Klaytn We use ChainConfig.UnitPrice to initialize TxPool.gasPrice,
 		//         later we have to change this rule when governance of UnitPrice is determined.
 		gasPrice:     new(big.Int).SetUint64(chainconfig.UnitPrice),
+		minimumPrice: new(big.Int),
+		maxTxSize:    MaxTxDataSize,
 		nonceCache:   chain.GetNonceCache(),
 		balanceCache: chain.GetBalanceCache(),
 		txMsgCh:      make(chan types.Transactions, txMsgChSize),
 	}
 	pool.locals = newAccountSet(pool.signer)
+	pool.priority = newTxPriority(config.PriorityClasses)
 	pool.priced = newTxPricedList(&pool.all)
 	pool.reset(nil, chain.CurrentBlock().Header())
 
@@ -244,6 +281,11 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 			logger.Error("Failed to rotate transaction journal", "err", err)
 		}
 	}
+	// If the snapshot is enabled, restore the transactions from disk
+	if config.Snapshot != "" {
+		pool.snapshot = newTxSnapshot(config.Snapshot)
+		pool.loadSnapshot()
+	}
 	// Subscribe events from blockchain
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
 
@@ -273,6 +315,9 @@ func (pool *TxPool) loop() {
 	journal := time.NewTicker(pool.config.JournalInterval)
 	defer journal.Stop()
 
+	snapshot := time.NewTicker(pool.config.SnapshotInterval)
+	defer snapshot.Stop()
+
 	// Track the previous head headers for transaction reorgs
 	head := pool.chain.CurrentBlock()
 
@@ -312,6 +357,7 @@ func (pool *TxPool) loop() {
 				txPoolPendingGauge.Update(int64(pending))
 				txPoolQueueGauge.Update(int64(queued))
 			}
+			pool.reportPriorityClasses()
 
 			// Handle inactive account transaction eviction
 		case <-evict.C:
@@ -326,9 +372,11 @@ func (pool *TxPool) loop() {
 				// Any non-locals old enough should be removed
 				if time.Since(beat) > pool.config.Lifetime {
 					if pool.queue[addr] != nil {
-						for _, tx := range pool.queue[addr].Flatten() {
+						expired := pool.queue[addr].Flatten()
+						for _, tx := range expired {
 							pool.removeTx(tx.Hash(), true)
 						}
+						pool.notifyDroppedTxs(expired, TxDropReasonExpired)
 					}
 					delete(pool.beats, addr)
 				}
@@ -344,6 +392,12 @@ func (pool *TxPool) loop() {
 				}
 				pool.mu.Unlock()
 			}
+
+			// Handle transaction pool snapshot writing
+		case <-snapshot.C:
+			if pool.snapshot != nil {
+				pool.saveSnapshot()
+			}
 		}
 	}
 }
@@ -419,6 +473,7 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.currentState = stateDB
 	pool.pendingNonce = make(map[common.Address]uint64)
 	pool.currentBlockNumber = newHead.Number.Uint64()
+	pool.baseFee = newHead.BaseFee
 
 	// Inject any transactions discarded due to reorgs
 	logger.Debug("Reinjecting stale transactions", "count", len(reinject))
@@ -462,6 +517,9 @@ func (pool *TxPool) Stop() {
 	if pool.journal != nil {
 		pool.journal.close()
 	}
+	if pool.snapshot != nil {
+		pool.saveSnapshot()
+	}
 	logger.Info("Transaction pool stopped")
 }
 
@@ -471,15 +529,74 @@ func (pool *TxPool) SubscribeNewTxsEvent(ch chan<- NewTxsEvent) event.Subscripti
 	return pool.scope.Track(pool.txFeed.Subscribe(ch))
 }
 
+// SubscribeReplacedTxEvent registers a subscription of ReplacedTxEvent and
+// starts sending event to the given channel.
+func (pool *TxPool) SubscribeReplacedTxEvent(ch chan<- ReplacedTxEvent) event.Subscription {
+	return pool.scope.Track(pool.replaceFeed.Subscribe(ch))
+}
+
+// SubscribeDroppedTxsEvent registers a subscription of DroppedTxsEvent and
+// starts sending event to the given channel.
+func (pool *TxPool) SubscribeDroppedTxsEvent(ch chan<- DroppedTxsEvent) event.Subscription {
+	return pool.scope.Track(pool.dropFeed.Subscribe(ch))
+}
+
+// notifyReplacedTx notifies subsystems that the old transaction is replaced by the new one.
+func (pool *TxPool) notifyReplacedTx(old, new *types.Transaction) {
+	if old != nil && old.Hash() != new.Hash() {
+		go pool.replaceFeed.Send(ReplacedTxEvent{Old: old, New: new})
+	}
+}
+
+// notifyDroppedTxs notifies subsystems that the transactions are dropped from the pool.
+func (pool *TxPool) notifyDroppedTxs(txs types.Transactions, reason string) {
+	if len(txs) > 0 {
+		go pool.dropFeed.Send(DroppedTxsEvent{Txs: txs, Reason: reason})
+	}
+}
+
 // GasPrice returns the current gas price enforced by the transaction pool.
+// After the dynamic fee fork, it is the minimum gas price, which is the base fee of the current head.
 func (pool *TxPool) GasPrice() *big.Int {
 	pool.mu.RLock()
 	defer pool.mu.RUnlock()
 
+	if pool.baseFee != nil {
+		return new(big.Int).Set(pool.baseFee)
+	}
 	return new(big.Int).Set(pool.gasPrice)
 }
 
+// MinimumPrice returns the minimum gas price of the transactions to be accepted.
+func (pool *TxPool) MinimumPrice() *big.Int {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	return new(big.Int).Set(pool.minimumPrice)
+}
+
+// SetMinimumPrice updates the minimum gas price of the transaction pool for new transactions.
+// The transactions already in the pool are kept.
+func (pool *TxPool) SetMinimumPrice(price *big.Int) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	logger.Info("TxPool.SetMinimumPrice", "before", pool.minimumPrice, "after", price)
+	pool.minimumPrice = new(big.Int).Set(price)
+}
+
+// SetMaxTxSize updates the maximum size of the transactions to be accepted by the transaction pool.
+// The transactions already in the pool are kept.
+func (pool *TxPool) SetMaxTxSize(size uint64) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	logger.Info("TxPool.SetMaxTxSize", "before", pool.maxTxSize, "after", size)
+	pool.maxTxSize = size
+}
+
 // SetGasPrice updates the gas price of the transaction pool for new transactions, and drops all old transactions.
+// After the dynamic fee fork, the gas price is not used to validate transactions, so the transactions are kept.
 func (pool *TxPool) SetGasPrice(price *big.Int) {
 	if pool.gasPrice.Cmp(price) != 0 {
 		pool.mu.Lock()
@@ -487,10 +604,15 @@ func (pool *TxPool) SetGasPrice(price *big.Int) {
 		logger.Info("TxPool.SetGasPrice", "before", pool.gasPrice, "after", price)
 
 		pool.gasPrice = price
+		if pool.baseFee != nil {
+			pool.mu.Unlock()
+			return
+		}
 		pool.pending = make(map[common.Address]*txList)
 		pool.queue = make(map[common.Address]*txList)
 		pool.beats = make(map[common.Address]time.Time)
 		pool.all = make(map[common.Hash]*types.Transaction)
+		pool.priority.resetCounts()
 		pool.pendingNonce = make(map[common.Address]uint64)
 		pool.locals = newAccountSet(pool.signer)
 		pool.priced = newTxPricedList(&pool.all)
@@ -541,6 +663,195 @@ func (pool *TxPool) Content() (map[common.Address]types.Transactions, map[common
 	return pending, queued
 }
 
+// ContentFrom retrieves the pending and queued transactions of the given sender,
+// sorted by nonce.
+func (pool *TxPool) ContentFrom(addr common.Address) (types.Transactions, types.Transactions) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.txMu.Lock()
+	defer pool.txMu.Unlock()
+
+	var pending, queued types.Transactions
+	if list, ok := pool.pending[addr]; ok {
+		pending = list.Flatten()
+	}
+	if list, ok := pool.queue[addr]; ok {
+		queued = list.Flatten()
+	}
+	return pending, queued
+}
+
+// ContentByFeePayer retrieves the pending and queued fee-delegated transactions
+// whose fee payer is the given address, grouped by sender and sorted by nonce.
+func (pool *TxPool) ContentByFeePayer(feePayer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.txMu.Lock()
+	defer pool.txMu.Unlock()
+
+	filter := func(lists map[common.Address]*txList) map[common.Address]types.Transactions {
+		content := make(map[common.Address]types.Transactions)
+		for addr, list := range lists {
+			for _, tx := range list.Flatten() {
+				if !tx.IsFeeDelegatedTransaction() {
+					continue
+				}
+				if payer, err := tx.FeePayer(); err == nil && payer == feePayer {
+					content[addr] = append(content[addr], tx)
+				}
+			}
+		}
+		return content
+	}
+	return filter(pool.pending), filter(pool.queue)
+}
+
+// NonExecutableReasons returns the reason why each of the given queued transactions
+// can not be executed yet. An empty string is returned for a transaction which
+// is waiting to be promoted or is no longer in the queue.
+func (pool *TxPool) NonExecutableReasons(txs types.Transactions) []string {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	reasons := make([]string, len(txs))
+	for i, tx := range txs {
+		reasons[i] = pool.nonExecutableReason(tx)
+	}
+	return reasons
+}
+
+// nonExecutableReason returns the reason why the queued transaction can not be executed.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) nonExecutableReason(tx *types.Transaction) string {
+	from, err := types.Sender(pool.signer, tx)
+	if err != nil {
+		return ""
+	}
+	if list, ok := pool.queue[from]; !ok || list.txs.Get(tx.Nonce()) == nil {
+		return ""
+	}
+	if tx.Nonce() > pool.getPendingNonce(from) {
+		return TxNonExecReasonNonceGap
+	}
+
+	senderBalance := pool.getBalance(from)
+	if !tx.IsFeeDelegatedTransaction() {
+		if senderBalance.Cmp(tx.Cost()) < 0 {
+			return TxNonExecReasonInsufficientBalance
+		}
+		return ""
+	}
+
+	feeByFeePayer, feeBySender := tx.Fee(), common.Big0
+	if feeRatio, isRatioTx := tx.FeeRatio(); isRatioTx {
+		feeByFeePayer, feeBySender = types.CalcFeeWithRatio(feeRatio, tx.Fee())
+	}
+	if senderBalance.Cmp(new(big.Int).Add(tx.Value(), feeBySender)) < 0 {
+		return TxNonExecReasonInsufficientBalance
+	}
+	if feePayer, err := tx.FeePayer(); err == nil && pool.getBalance(feePayer).Cmp(feeByFeePayer) < 0 {
+		return TxNonExecReasonInsufficientFeePayerBalance
+	}
+	return ""
+}
+
+// TxPriority returns the priority class of the transaction. A lower value means
+// a higher priority, and the transactions without priority have the highest value.
+func (pool *TxPool) TxPriority(tx *types.Transaction) int {
+	return pool.priority.classOf(pool.signer, tx)
+}
+
+// PriorityStatus returns the number of pending and queued transactions of each priority class.
+func (pool *TxPool) PriorityStatus() []TxPriorityClassStatus {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	pending, queued := pool.priorityStats()
+	status := make([]TxPriorityClassStatus, len(pool.priority.classes))
+	for i, class := range pool.priority.classes {
+		status[i] = TxPriorityClassStatus{Name: class.Name, Slots: class.Slots, Pending: pending[i], Queued: queued[i]}
+	}
+	return status
+}
+
+// reportPriorityClasses updates the metrics of the priority classes.
+func (pool *TxPool) reportPriorityClasses() {
+	if len(pool.priority.classes) == 0 {
+		return
+	}
+	pool.mu.RLock()
+	pending, queued := pool.priorityStats()
+	pool.mu.RUnlock()
+
+	for i := range pool.priority.classes {
+		pool.priority.pendingGauges[i].Update(int64(pending[i]))
+		pool.priority.queuedGauges[i].Update(int64(queued[i]))
+	}
+}
+
+// priorityStats counts the pending and queued transactions of each priority class.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) priorityStats() ([]int, []int) {
+	count := func(lists map[common.Address]*txList) []int {
+		counts := make([]int, pool.priority.none())
+		if len(counts) == 0 {
+			return counts
+		}
+		for _, list := range lists {
+			for _, tx := range list.txs.items {
+				if class := pool.priority.classOf(pool.signer, tx); class < len(counts) {
+					counts[class]++
+				}
+			}
+		}
+		return counts
+	}
+	return count(pool.pending), count(pool.queue)
+}
+
+// capacity returns the maximum number of transactions in the pool including
+// the slots reserved for the priority classes.
+func (pool *TxPool) capacity() uint64 {
+	return pool.config.ExecSlotsAll + pool.config.NonExecSlotsAll + pool.priority.reserved
+}
+
+// hasReservedSlot returns true if the transaction belongs to a priority class
+// which has a room in its reserved slots.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) hasReservedSlot(tx *types.Transaction) bool {
+	class := pool.priority.classOf(pool.signer, tx)
+	if class == pool.priority.none() || uint64(len(pool.all)) >= pool.capacity() {
+		return false
+	}
+	return uint64(pool.priority.counts[class]) < pool.priority.classes[class].Slots
+}
+
+// addToAll adds the transaction to the set of all known transactions, and counts it
+// for its priority class.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) addToAll(tx *types.Transaction) {
+	hash := tx.Hash()
+	if _, exist := pool.all[hash]; !exist {
+		pool.priority.count(pool.priority.classOf(pool.signer, tx), 1)
+	}
+	pool.all[hash] = tx
+}
+
+// removeFromAll removes the transaction from the set of all known transactions, and
+// uncounts it for its priority class.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) removeFromAll(hash common.Hash) {
+	if tx, exist := pool.all[hash]; exist {
+		pool.priority.count(pool.priority.classOf(pool.signer, tx), -1)
+		delete(pool.all, hash)
+	}
+}
+
 // Pending retrieves all currently processable transactions, groupped by origin
 // account and sorted by nonce. The returned transaction set is a copy and can be
 // freely modified by calling code.
@@ -615,6 +926,72 @@ func (pool *TxPool) local() map[common.Address]types.Transactions {
 	return txs
 }
 
+// loadSnapshot restores the transactions in the snapshot into the pool. The
+// transactions are validated again against the current state, and their arrival
+// times are restored to keep the order of arrival. The heartbeat of a sender is
+// restored to the latest arrival time of its transactions to keep the eviction schedule.
+func (pool *TxPool) loadSnapshot() {
+	entries, err := pool.snapshot.load()
+	if err != nil {
+		logger.Error("Failed to load transaction pool snapshot", "err", err)
+	}
+	// Skip the transactions already restored from the local journal
+	var txs types.Transactions
+	for _, entry := range entries {
+		if pool.Get(entry.Tx.Hash()) == nil {
+			entry.Tx.SetTime(time.Unix(0, int64(entry.Time)))
+			txs = append(txs, entry.Tx)
+		}
+	}
+	if len(txs) == 0 {
+		return
+	}
+	errs := pool.AddRemotes(txs)
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	dropped := 0
+	restored := make(map[common.Address]time.Time)
+	for i, err := range errs {
+		if err != nil {
+			logger.Debug("Failed to add transaction in the snapshot", "hash", txs[i].Hash(), "err", err)
+			dropped++
+			continue
+		}
+		from, _ := types.Sender(pool.signer, txs[i]) // already validated
+		if _, exist := pool.beats[from]; exist {
+			if beat, ok := restored[from]; !ok || txs[i].Time().After(beat) {
+				restored[from] = txs[i].Time()
+			}
+		}
+	}
+	for addr, beat := range restored {
+		pool.beats[addr] = beat
+	}
+	logger.Info("Loaded transaction pool snapshot", "transactions", len(txs), "dropped", dropped)
+}
+
+// saveSnapshot writes all transactions in the pool to the snapshot.
+func (pool *TxPool) saveSnapshot() {
+	pool.mu.RLock()
+	var entries []txSnapshotEntry
+	for _, lists := range []map[common.Address]*txList{pool.pending, pool.queue} {
+		for _, list := range lists {
+			for _, tx := range list.Flatten() {
+				entries = append(entries, txSnapshotEntry{Tx: tx, Time: uint64(tx.Time().UnixNano())})
+			}
+		}
+	}
+	pool.mu.RUnlock()
+
+	if err := pool.snapshot.save(entries); err != nil {
+		logger.Error("Failed to write transaction pool snapshot", "err", err)
+		return
+	}
+	logger.Debug("Wrote transaction pool snapshot", "transactions", len(entries))
+}
+
 // validateTx checks whether a transaction is valid according to the consensus
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction) error {
@@ -625,14 +1002,26 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrInvalidChainId
 	}
 
-	// NOTE-Klaytn Drop transactions with unexpected gasPrice
-	if pool.gasPrice.Cmp(tx.GasPrice()) != 0 {
+	if pool.baseFee != nil {
+		// NOTE-Klaytn After the dynamic fee fork, drop transactions which can not pay the base fee
+		if tx.GasPrice().Cmp(pool.baseFee) < 0 {
+			logger.Trace("fail to validate gas price", "base fee", pool.baseFee, "tx gas price", tx.GasPrice())
+			return ErrGasPriceBelowBaseFee
+		}
+	} else if pool.gasPrice.Cmp(tx.GasPrice()) != 0 {
+		// NOTE-Klaytn Drop transactions with unexpected gasPrice
 		logger.Trace("fail to validate unitprice", "Klaytn unitprice", pool.gasPrice, "tx unitprice", tx.GasPrice())
 		return ErrInvalidUnitPrice
 	}
 
-	// Heuristic limit, reject transactions over 32KB to prevent DOS attacks
-	if tx.Size() > MaxTxDataSize {
+	// Drop transactions under the minimum gas price of the pool
+	if tx.GasPrice().Cmp(pool.minimumPrice) < 0 {
+		logger.Trace("fail to validate minimum gas price", "minimum price", pool.minimumPrice, "tx gas price", tx.GasPrice())
+		return ErrUnderpriced
+	}
+
+	// Heuristic limit, reject transactions over 32KB by default to prevent DOS attacks
+	if tx.Size() > common.StorageSize(pool.maxTxSize) {
 		return ErrOversizedData
 	}
 
@@ -762,13 +1151,17 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 		invalidTxCounter.Inc(1)
 		return false, err
 	}
+	// Record when the transaction is first seen, which is used to order transactions by arrival
+	if tx.Time().IsZero() {
+		tx.SetTime(time.Now())
+	}
 
 	// If the transaction pool is full and new Tx is valid,
 	// (1) discard a new Tx if there is no room for the account of the Tx
 	// (2) remove an old Tx with the largest nonce from queue to make a room for a new Tx with missing nonce
 	// (3) discard a new Tx if the new Tx does not have a missing nonce
 	// (4) discard underpriced transactions
-	if uint64(len(pool.all)) >= pool.config.ExecSlotsAll+pool.config.NonExecSlotsAll {
+	if uint64(len(pool.all)) >= pool.config.ExecSlotsAll+pool.config.NonExecSlotsAll && !pool.hasReservedSlot(tx) {
 		// (1) discard a new Tx if there is no room for the account of the Tx
 		from, _ := types.Sender(pool.signer, tx)
 		if pool.queue[from] == nil {
@@ -781,6 +1174,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 		if maxTx != tx {
 			// (2) remove an old Tx with the largest nonce from queue to make a room for a new Tx with missing nonce
 			pool.removeTx(maxTx.Hash(), true)
+			pool.notifyDroppedTxs(types.Transactions{maxTx}, TxDropReasonPoolFull)
 			logger.Trace("Removing an old Tx with the max nonce to insert a new Tx with missing nonce, because TxPool is full", "account", from, "new nonce(previously missing)", tx.Nonce(), "removed max nonce", maxTx.Nonce())
 		} else {
 			// (3) discard a new Tx if the new Tx does not have a missing nonce
@@ -803,6 +1197,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 			underpricedTxCounter.Inc(1)
 			pool.removeTx(tx.Hash(), false)
 		}
+		pool.notifyDroppedTxs(drop, TxDropReasonUnderpriced)
 	}
 	// If the transaction is replacing an already pending one, do directly
 	from, _ := types.Sender(pool.signer, tx) // already validated
@@ -815,11 +1210,12 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 		}
 		// New transaction is better, replace old one
 		if old != nil {
-			delete(pool.all, old.Hash())
+			pool.removeFromAll(old.Hash())
 			pool.priced.Removed()
 			pendingReplaceCounter.Inc(1)
+			pool.notifyReplacedTx(old, tx)
 		}
-		pool.all[tx.Hash()] = tx
+		pool.addToAll(tx)
 		pool.priced.Put(tx)
 		pool.journalTx(from, tx)
 
@@ -862,12 +1258,13 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction) (bool, er
 	}
 	// Discard any previous transaction and mark this
 	if old != nil {
-		delete(pool.all, old.Hash())
+		pool.removeFromAll(old.Hash())
 		pool.priced.Removed()
 		queuedReplaceCounter.Inc(1)
+		pool.notifyReplacedTx(old, tx)
 	}
 	if pool.all[hash] == nil {
-		pool.all[hash] = tx
+		pool.addToAll(tx)
 		pool.priced.Put(tx)
 	}
 
@@ -901,7 +1298,7 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 	inserted, old := list.Add(tx, pool.config.PriceBump)
 	if !inserted {
 		// An older transaction was better, discard this
-		delete(pool.all, hash)
+		pool.removeFromAll(hash)
 		pool.priced.Removed()
 
 		pendingDiscardCounter.Inc(1)
@@ -909,14 +1306,15 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 	}
 	// Otherwise discard any previous transaction and mark this
 	if old != nil {
-		delete(pool.all, old.Hash())
+		pool.removeFromAll(old.Hash())
 		pool.priced.Removed()
 
 		pendingReplaceCounter.Inc(1)
+		pool.notifyReplacedTx(old, tx)
 	}
 	// Failsafe to work around direct pending inserts (tests)
 	if pool.all[hash] == nil {
-		pool.all[hash] = tx
+		pool.addToAll(tx)
 		pool.priced.Put(tx)
 	}
 	// Set the potentially new pending nonce and notify any subsystems of the new tx
@@ -956,7 +1354,7 @@ func (pool *TxPool) AddLocal(tx *types.Transaction) error {
 	}
 
 	poolSize := uint64(len(pool.all))
-	if poolSize >= pool.config.ExecSlotsAll+pool.config.NonExecSlotsAll {
+	if poolSize >= pool.capacity() {
 		return fmt.Errorf("txpool is full: %d", poolSize)
 	}
 	return pool.addTx(tx, !pool.config.NoLocals)
@@ -988,7 +1386,7 @@ func (pool *TxPool) AddRemotes(txs []*types.Transaction) []error {
 // so it can fit into TxPool's capacity.
 func (pool *TxPool) checkAndAddTxs(txs []*types.Transaction, local bool) []error {
 	poolSize := uint64(len(pool.all))
-	poolCapacity := int(pool.config.ExecSlotsAll + pool.config.NonExecSlotsAll - poolSize)
+	poolCapacity := int(pool.capacity() - poolSize)
 	numTxs := len(txs)
 
 	if poolCapacity < numTxs {
@@ -1093,6 +1491,112 @@ func (pool *TxPool) Get(hash common.Hash) *types.Transaction {
 	return pool.all[hash]
 }
 
+// ReplaceLocal replaces a transaction in the pool with the given local transaction
+// having the same sender and nonce. Unlike AddLocal, the existing transaction is
+// replaced regardless of its gas price, since the gas price can not be bumped with
+// the fixed unit price. ErrNoTxToReplace is returned if there is no transaction to replace.
+func (pool *TxPool) ReplaceLocal(tx *types.Transaction) error {
+	if pool.config.NoLocals {
+		return errNotAllowedReplaceTx
+	}
+	if tx.Type().IsChainDataAnchoring() && !pool.config.AllowLocalAnchorTx {
+		return errNotAllowedAnchoringTx
+	}
+	senderCacher.recover(pool.signer, []*types.Transaction{tx})
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	hash := tx.Hash()
+	if pool.all[hash] != nil {
+		return fmt.Errorf("known transaction: %x", hash)
+	}
+	// Validate the new transaction first not to lose the old one with an invalid replacement
+	if err := pool.validateTx(tx); err != nil {
+		logger.Trace("Discarding invalid replacement transaction", "hash", hash, "err", err)
+		invalidTxCounter.Inc(1)
+		return err
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+
+	var old *types.Transaction
+	if list := pool.pending[from]; list != nil {
+		old = list.txs.Get(tx.Nonce())
+	}
+	if list := pool.queue[from]; old == nil && list != nil {
+		old = list.txs.Get(tx.Nonce())
+	}
+	if old == nil {
+		return ErrNoTxToReplace
+	}
+
+	// Remove the old one, which moves the subsequent pending transactions back to the queue,
+	// and put the new one into the queue to be promoted with them.
+	pool.removeTx(old.Hash(), true)
+	// If the new one can't be added though it is valid, e.g. when the pool is full,
+	// restore the old one not to leave a nonce gap behind.
+	if _, err := pool.add(tx, true); err != nil {
+		logger.Error("Failed to add the replacement transaction", "hash", hash, "err", err)
+		// The old one is put back to the slot it has just released, bypassing the pool limits
+		if _, rerr := pool.enqueueTx(old.Hash(), old); rerr != nil {
+			logger.Error("Failed to restore the replaced transaction", "hash", old.Hash(), "err", rerr)
+		}
+		pool.promoteExecutables([]common.Address{from})
+		return err
+	}
+	pool.promoteExecutables([]common.Address{from})
+	pool.notifyReplacedTx(old, tx)
+
+	// Rotate the journal not to restore the replaced transaction after restart
+	if pool.journal != nil {
+		if err := pool.journal.rotate(pool.local()); err != nil {
+			logger.Error("Failed to rotate local tx journal", "err", err)
+		}
+	}
+	logger.Debug("Replaced transaction", "old", old.Hash(), "new", hash, "from", from, "nonce", tx.Nonce())
+	return nil
+}
+
+// Remove removes a transaction from the pool, and returns the removed transaction.
+// If the transaction is pending, the subsequent transactions of the sender are moved
+// back to the queue until the nonce gap is filled.
+func (pool *TxPool) Remove(hash common.Hash) *types.Transaction {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	tx := pool.all[hash]
+	if tx == nil {
+		return nil
+	}
+	pool.removeTx(hash, true)
+	pool.notifyDroppedTxs(types.Transactions{tx}, TxDropReasonRemoved)
+
+	return tx
+}
+
+// RemoveSender removes all transactions of the sender from the pool, and returns
+// the removed transactions.
+func (pool *TxPool) RemoveSender(addr common.Address) types.Transactions {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	var removed types.Transactions
+	if list := pool.pending[addr]; list != nil {
+		removed = append(removed, list.Flatten()...)
+	}
+	if list := pool.queue[addr]; list != nil {
+		removed = append(removed, list.Flatten()...)
+	}
+	// Remove the transactions in the reverse order not to move the pending ones back to the queue
+	for i := len(removed) - 1; i >= 0; i-- {
+		pool.removeTx(removed[i].Hash(), true)
+	}
+	delete(pool.beats, addr)
+	pool.notifyDroppedTxs(removed, TxDropReasonRemoved)
+
+	return removed
+}
+
 // checkAndSetBeat sets the beat of the account if there is no beat of the account.
 func (pool *TxPool) checkAndSetBeat(addr common.Address) {
 	_, exist := pool.beats[addr]
@@ -1113,7 +1617,7 @@ func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) {
 	addr, _ := types.Sender(pool.signer, tx) // already validated during insertion
 
 	// Remove it from the list of known transactions
-	delete(pool.all, hash)
+	pool.removeFromAll(hash)
 	if outofbound {
 		pool.priced.Removed()
 	}
@@ -1168,7 +1672,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 		for _, tx := range list.Forward(pool.getNonce(addr)) {
 			hash := tx.Hash()
 			logger.Trace("Removed old queued transaction", "hash", hash)
-			delete(pool.all, hash)
+			pool.removeFromAll(hash)
 			pool.priced.Removed()
 		}
 		// Drop all transactions that are too costly (low balance)
@@ -1176,10 +1680,11 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 		for _, tx := range drops {
 			hash := tx.Hash()
 			logger.Trace("Removed unpayable queued transaction", "hash", hash)
-			delete(pool.all, hash)
+			pool.removeFromAll(hash)
 			pool.priced.Removed()
 			queuedNofundsCounter.Inc(1)
 		}
+		pool.notifyDroppedTxs(drops, TxDropReasonUnexecutable)
 
 		// Gather all executable transactions and promote them
 		for _, tx := range list.Ready(pool.getPendingNonce(addr)) {
@@ -1190,14 +1695,16 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 			}
 		}
 		// Drop all transactions over the allowed limit
-		if !pool.locals.contains(addr) {
-			for _, tx := range list.Cap(int(pool.config.NonExecSlotsAccount)) {
+		if !pool.locals.contains(addr) && !pool.priority.isSender(addr) {
+			caps := list.Cap(int(pool.config.NonExecSlotsAccount))
+			for _, tx := range caps {
 				hash := tx.Hash()
-				delete(pool.all, hash)
+				pool.removeFromAll(hash)
 				pool.priced.Removed()
 				queuedRateLimitCounter.Inc(1)
 				logger.Trace("Removed cap-exceeding queued transaction", "hash", hash)
 			}
+			pool.notifyDroppedTxs(caps, TxDropReasonRateLimit)
 		}
 		// Delete the entire queue entry if it became empty.
 		if list.Empty() {
@@ -1213,6 +1720,17 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 	for _, list := range pool.pending {
 		pending += uint64(list.Len())
 	}
+	// Exclude the priority transactions in the reserved slots
+	if pending > pool.config.ExecSlotsAll && pool.priority.reserved > 0 {
+		counts, _ := pool.priorityStats()
+		for i, class := range pool.priority.classes {
+			if uint64(counts[i]) < class.Slots {
+				pending -= uint64(counts[i])
+			} else {
+				pending -= class.Slots
+			}
+		}
+	}
 
 	if pending > pool.config.ExecSlotsAll {
 		pendingBeforeCap := pending
@@ -1220,11 +1738,12 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 		spammers := prque.New()
 		for addr, list := range pool.pending {
 			// Only evict transactions from high rollers
-			if !pool.locals.contains(addr) && uint64(list.Len()) > pool.config.ExecSlotsAccount {
+			if !pool.locals.contains(addr) && !pool.priority.isSender(addr) && uint64(list.Len()) > pool.config.ExecSlotsAccount {
 				spammers.Push(addr, float32(list.Len()))
 			}
 		}
 		// Gradually drop transactions from offenders
+		var dropped types.Transactions
 		offenders := []common.Address{}
 		for pending > pool.config.ExecSlotsAll && !spammers.Empty() {
 			// Retrieve the next offender if not local address
@@ -1243,8 +1762,9 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 						for _, tx := range list.Cap(list.Len() - 1) {
 							// Drop the transaction from the global pools too
 							hash := tx.Hash()
-							delete(pool.all, hash)
+							pool.removeFromAll(hash)
 							pool.priced.Removed()
+							dropped = append(dropped, tx)
 
 							// Update the account nonce to the dropped transaction
 							pool.updatePendingNonce(offenders[i], tx.Nonce())
@@ -1263,8 +1783,9 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 					for _, tx := range list.Cap(list.Len() - 1) {
 						// Drop the transaction from the global pools too
 						hash := tx.Hash()
-						delete(pool.all, hash)
+						pool.removeFromAll(hash)
 						pool.priced.Removed()
+						dropped = append(dropped, tx)
 
 						// Update the account nonce to the dropped transaction
 						pool.updatePendingNonce(addr, tx.Nonce())
@@ -1275,6 +1796,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 			}
 		}
 		pendingRateLimitCounter.Inc(int64(pendingBeforeCap - pending))
+		pool.notifyDroppedTxs(dropped, TxDropReasonRateLimit)
 	}
 	// If we've queued more transactions than the hard limit, drop oldest ones
 	queued := uint64(0)
@@ -1286,13 +1808,14 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 		// Sort all accounts with queued transactions by heartbeat
 		addresses := make(addresssByHeartbeat, 0, len(pool.queue))
 		for addr := range pool.queue {
-			if !pool.locals.contains(addr) { // don't drop locals
+			if !pool.locals.contains(addr) && !pool.priority.isSender(addr) { // don't drop locals and priority senders
 				addresses = append(addresses, addressByHeartbeat{addr, pool.beats[addr]})
 			}
 		}
 		sort.Sort(addresses)
 
 		// Drop transactions until the total is below the limit or only locals remain
+		var dropped types.Transactions
 		for drop := queued - pool.config.NonExecSlotsAll; drop > 0 && len(addresses) > 0; {
 			addr := addresses[len(addresses)-1]
 			list := pool.queue[addr.address]
@@ -1303,6 +1826,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 			if size := uint64(list.Len()); size <= drop {
 				for _, tx := range list.Flatten() {
 					pool.removeTx(tx.Hash(), true)
+					dropped = append(dropped, tx)
 				}
 				drop -= size
 				queuedRateLimitCounter.Inc(int64(size))
@@ -1312,10 +1836,12 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 			txs := list.Flatten()
 			for i := len(txs) - 1; i >= 0 && drop > 0; i-- {
 				pool.removeTx(txs[i].Hash(), true)
+				dropped = append(dropped, txs[i])
 				drop--
 				queuedRateLimitCounter.Inc(1)
 			}
 		}
+		pool.notifyDroppedTxs(dropped, TxDropReasonPoolFull)
 	}
 }
 
@@ -1337,7 +1863,7 @@ func (pool *TxPool) demoteUnexecutables() {
 		for _, tx := range list.Forward(nonce) {
 			hash := tx.Hash()
 			logger.Trace("Removed old pending transaction", "hash", hash)
-			delete(pool.all, hash)
+			pool.removeFromAll(hash)
 			pool.priced.Removed()
 		}
 
@@ -1347,17 +1873,18 @@ func (pool *TxPool) demoteUnexecutables() {
 			cnt += list.Len()
 			drops, invalids = list.Filter(pool.getBalance(addr), pool)
 		} else {
-			drops, invalids = list.FilterUnexecutable()
+			drops, invalids = list.FilterUnexecutable(pool.baseFee)
 		}
 
 		// Drop all transactions that are unexecutable, and queue any invalids back for later
 		for _, tx := range drops {
 			hash := tx.Hash()
 			logger.Trace("Removed unexecutable pending transaction", "hash", hash)
-			delete(pool.all, hash)
+			pool.removeFromAll(hash)
 			pool.priced.Removed()
 			pendingNofundsCounter.Inc(1)
 		}
+		pool.notifyDroppedTxs(drops, TxDropReasonUnexecutable)
 
 		for _, tx := range invalids {
 			hash := tx.Hash()
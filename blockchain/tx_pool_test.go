@@ -35,6 +35,7 @@ import (
 	"math/big"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -115,6 +116,13 @@ func validateTxPoolInternals(pool *TxPool) error {
 	if priced := pool.priced.items.Len() - pool.priced.stales; priced != pending+queued {
 		return fmt.Errorf("total priced transaction count %d != %d pending + %d queued", priced, pending, queued)
 	}
+	// Ensure the counts of the priority classes are consistent with pending + queued
+	pendingCounts, queuedCounts := pool.priorityStats()
+	for i, count := range pool.priority.counts {
+		if count != pendingCounts[i]+queuedCounts[i] {
+			return fmt.Errorf("priority class %d count %d != %d pending + %d queued", i, count, pendingCounts[i], queuedCounts[i])
+		}
+	}
 	// Ensure the next nonce to assign is the correct one
 	for addr, txs := range pool.pending {
 		// Find the last transaction
@@ -278,6 +286,110 @@ func TestInvalidTransactions(t *testing.T) {
 	}
 }
 
+// TestInvalidTransactionsWithBaseFee tests that the transactions which can not pay
+// the base fee are rejected after the dynamic fee fork.
+func TestInvalidTransactionsWithBaseFee(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(0xffffffffffffff))
+
+	pool.mu.Lock()
+	pool.baseFee = big.NewInt(10)
+	pool.mu.Unlock()
+
+	assert.Equal(t, big.NewInt(10), pool.GasPrice())
+
+	if err := pool.AddRemote(pricedTransaction(0, 100000, big.NewInt(9), key)); err != ErrGasPriceBelowBaseFee {
+		t.Error("expected", ErrGasPriceBelowBaseFee, "got", err)
+	}
+	// The gas price is allowed to be higher than the base fee.
+	if err := pool.AddRemote(pricedTransaction(0, 100000, big.NewInt(20), key)); err != nil {
+		t.Error("expected", nil, "got", err)
+	}
+	// The unit price update does not drop the transactions after the fork.
+	pool.SetGasPrice(big.NewInt(1000))
+	if pending, queued := pool.Stats(); pending+queued != 1 {
+		t.Errorf("transaction count mismatch: have %d, want %d", pending+queued, 1)
+	}
+}
+
+func TestInvalidTransactionsWithAdmissionLimits(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(0xffffffffffffff))
+
+	pool.mu.Lock()
+	pool.baseFee = big.NewInt(10)
+	pool.mu.Unlock()
+
+	// The transactions under the minimum price are dropped even if they pay the base fee.
+	pool.SetMinimumPrice(big.NewInt(20))
+	assert.Equal(t, big.NewInt(20), pool.MinimumPrice())
+	if err := pool.AddRemote(pricedTransaction(0, 100000, big.NewInt(15), key)); err != ErrUnderpriced {
+		t.Error("expected", ErrUnderpriced, "got", err)
+	}
+
+	tx := pricedTransaction(0, 100000, big.NewInt(20), key)
+	pool.SetMaxTxSize(uint64(tx.Size()) - 1)
+	if err := pool.AddRemote(tx); err != ErrOversizedData {
+		t.Error("expected", ErrOversizedData, "got", err)
+	}
+	pool.SetMaxTxSize(uint64(tx.Size()))
+	if err := pool.AddRemote(tx); err != nil {
+		t.Error("expected", nil, "got", err)
+	}
+}
+
+// TestTransactionsBelowNewBaseFee tests that the transactions which can not pay
+// the base fee of a new head are removed from the pool.
+func TestTransactionsBelowNewBaseFee(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(0xffffffffffffff))
+	pool.lockedReset(nil, &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10)})
+
+	// Two pending transactions and a queued one with a nonce gap
+	txs := types.Transactions{
+		pricedTransaction(0, 100000, big.NewInt(30), key),
+		pricedTransaction(1, 100000, big.NewInt(20), key),
+		pricedTransaction(3, 100000, big.NewInt(20), key),
+	}
+	for _, tx := range txs {
+		if err := pool.AddRemote(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if pending, queued := pool.Stats(); pending != 2 || queued != 1 {
+		t.Fatalf("transaction count mismatch: have %d/%d, want %d/%d", pending, queued, 2, 1)
+	}
+
+	// The transactions priced below the new base fee are dropped
+	pool.lockedReset(nil, &types.Header{Number: big.NewInt(2), BaseFee: big.NewInt(25)})
+	pool.promoteExecutables(nil)
+
+	if pending, queued := pool.Stats(); pending != 1 || queued != 0 {
+		t.Errorf("transaction count mismatch: have %d/%d, want %d/%d", pending, queued, 1, 0)
+	}
+	if _, ok := pool.all[txs[0].Hash()]; !ok {
+		t.Errorf("transaction paying the base fee is dropped")
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Error(err)
+	}
+}
+
 func genAnchorTx(nonce uint64) *types.Transaction {
 	key, _ := crypto.HexToECDSA("45a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8")
 	from := crypto.PubkeyToAddress(key.PublicKey)
@@ -1782,6 +1894,79 @@ func testTransactionJournaling(t *testing.T, nolocals bool) {
 	pool.Stop()
 }
 
+// Tests that all transactions in the pool, both executable and non-executable,
+// survive node restarts with the snapshot, and invalidated ones are dropped.
+func TestTransactionSnapshot(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "txpool-snapshot")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.NoLocals = true
+	config.Snapshot = filepath.Join(dir, "txpool.rlp")
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key1.PublicKey), big.NewInt(1000000000))
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key2.PublicKey), big.NewInt(1000000000))
+
+	// Add three pending and a queued transactions
+	txs := types.Transactions{
+		transaction(0, 100000, key1),
+		transaction(1, 100000, key1),
+		transaction(0, 100000, key2),
+		transaction(2, 100000, key2),
+	}
+	// The transactions of the second account arrived an hour ago
+	arrival := time.Now().Add(-time.Hour)
+	txs[2].SetTime(arrival)
+	txs[3].SetTime(arrival.Add(time.Second))
+	for _, err := range pool.AddRemotes(txs) {
+		if err != nil {
+			t.Fatalf("failed to add remote transaction: %v", err)
+		}
+	}
+	pending, queued := pool.Stats()
+	assert.Equal(t, 3, pending)
+	assert.Equal(t, 1, queued)
+
+	// Terminate the old pool, bump the nonce of the first account, and ensure the valid transactions survive
+	pool.Stop()
+	statedb.SetNonce(crypto.PubkeyToAddress(key1.PublicKey), 1)
+	blockchain = &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	pending, queued = pool.Stats()
+	assert.Equal(t, 2, pending)
+	assert.Equal(t, 1, queued)
+	assert.Nil(t, pool.Get(txs[0].Hash()))
+	// The arrival time of each transaction is restored to keep the order of arrival
+	for _, tx := range txs[1:] {
+		if restored := pool.Get(tx.Hash()); assert.NotNil(t, restored) {
+			assert.True(t, tx.Time().Equal(restored.Time()), "have %v, want %v", restored.Time(), tx.Time())
+		}
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+
+	// The heartbeat of the sender is restored to its latest arrival time to keep the eviction schedule
+	pool.mu.RLock()
+	assert.True(t, txs[3].Time().Equal(pool.beats[crypto.PubkeyToAddress(key2.PublicKey)]))
+	pool.mu.RUnlock()
+}
+
 // TestTransactionStatusCheck tests that the pool can correctly retrieve the
 // pending status of individual transactions.
 func TestTransactionStatusCheck(t *testing.T) {
@@ -1934,3 +2119,283 @@ func benchmarkPoolBatchInsert(b *testing.B, size int) {
 		pool.AddRemotes(batch)
 	}
 }
+
+// TestReplaceLocal tests that a local transaction in the pool can be replaced by a
+// transaction with the same nonce, and the replacement is notified.
+func TestReplaceLocal(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(1000000000))
+
+	replaced := make(chan ReplacedTxEvent, 1)
+	sub := pool.SubscribeReplacedTxEvent(replaced)
+	defer sub.Unsubscribe()
+
+	txs := types.Transactions{transaction(0, 100000, key), transaction(1, 100000, key), transaction(2, 100000, key)}
+	for _, tx := range txs {
+		assert.NoError(t, pool.AddLocal(tx))
+	}
+
+	// The transaction with the same nonce is not accepted by AddLocal
+	newTx := transaction(1, 200000, key)
+	assert.Equal(t, ErrAlreadyNonceExistInPool, pool.AddLocal(newTx))
+
+	// ReplaceLocal replaces the pending transaction and keeps the subsequent one pending
+	assert.NoError(t, pool.ReplaceLocal(newTx))
+	assert.Nil(t, pool.Get(txs[1].Hash()))
+	assert.Equal(t, newTx, pool.Get(newTx.Hash()))
+
+	pending, queued := pool.Stats()
+	assert.Equal(t, 3, pending)
+	assert.Equal(t, 0, queued)
+	assert.NoError(t, validateTxPoolInternals(pool))
+
+	select {
+	case ev := <-replaced:
+		assert.Equal(t, txs[1].Hash(), ev.Old.Hash())
+		assert.Equal(t, newTx.Hash(), ev.New.Hash())
+	case <-time.After(time.Second):
+		t.Fatal("replaced tx event not fired")
+	}
+
+	// Nothing to replace
+	assert.Equal(t, ErrNoTxToReplace, pool.ReplaceLocal(transaction(5, 100000, key)))
+}
+
+// TestReplaceLocalRestore tests that the replaced transaction is restored if the
+// replacement can't be added to the pool.
+func TestReplaceLocalRestore(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(1000000000))
+
+	txs := types.Transactions{transaction(0, 100000, key), transaction(1, 100000, key)}
+	for _, tx := range txs {
+		assert.NoError(t, pool.AddLocal(tx))
+	}
+
+	// Make the pool full, so that the replacement is rejected after the old one is removed
+	pool.mu.Lock()
+	pool.config.ExecSlotsAll, pool.config.NonExecSlotsAll = 1, 0
+	pool.mu.Unlock()
+
+	newTx := transaction(1, 200000, key)
+	assert.Error(t, pool.ReplaceLocal(newTx))
+	assert.Nil(t, pool.Get(newTx.Hash()))
+	assert.Equal(t, txs[1], pool.Get(txs[1].Hash()))
+
+	pending, queued := pool.Stats()
+	assert.Equal(t, 2, pending)
+	assert.Equal(t, 0, queued)
+	assert.NoError(t, validateTxPoolInternals(pool))
+}
+
+// TestRemove tests that transactions are removed from the pool by the hash or
+// by the sender, and the removal is notified.
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(1000000000))
+
+	dropped := make(chan DroppedTxsEvent, 2)
+	sub := pool.SubscribeDroppedTxsEvent(dropped)
+	defer sub.Unsubscribe()
+
+	txs := types.Transactions{transaction(0, 100000, key), transaction(1, 100000, key), transaction(2, 100000, key), transaction(4, 100000, key)}
+	for _, tx := range txs {
+		assert.NoError(t, pool.AddRemote(tx))
+	}
+
+	// Removing a pending transaction moves the subsequent ones back to the queue
+	assert.Equal(t, txs[1], pool.Remove(txs[1].Hash()))
+	assert.Nil(t, pool.Remove(txs[1].Hash()))
+
+	pending, queued := pool.Stats()
+	assert.Equal(t, 1, pending)
+	assert.Equal(t, 2, queued)
+	assert.NoError(t, validateTxPoolInternals(pool))
+
+	select {
+	case ev := <-dropped:
+		assert.Equal(t, TxDropReasonRemoved, ev.Reason)
+		assert.Equal(t, 1, len(ev.Txs))
+	case <-time.After(time.Second):
+		t.Fatal("dropped txs event not fired")
+	}
+
+	// Removing the sender removes all transactions of the sender
+	assert.Equal(t, 3, len(pool.RemoveSender(from)))
+
+	pending, queued = pool.Stats()
+	assert.Equal(t, 0, pending)
+	assert.Equal(t, 0, queued)
+	assert.NoError(t, validateTxPoolInternals(pool))
+
+	select {
+	case ev := <-dropped:
+		assert.Equal(t, TxDropReasonRemoved, ev.Reason)
+		assert.Equal(t, 3, len(ev.Txs))
+	case <-time.After(time.Second):
+		t.Fatal("dropped txs event not fired")
+	}
+}
+
+// TestPriorityClassReservedSlots tests that the transactions of a priority class
+// are accepted into the reserved slots even if the pool is full.
+func TestPriorityClassReservedSlots(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	normal, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	critical, _ := crypto.GenerateKey()
+
+	config := testTxPoolConfig
+	config.ExecSlotsAll = 2
+	config.NonExecSlotsAll = 2
+	config.PriorityClasses = []TxPriorityClass{
+		{Name: "critical", Senders: []common.Address{crypto.PubkeyToAddress(critical.PublicKey)}, Slots: 2},
+	}
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	for _, key := range []*ecdsa.PrivateKey{normal, other, critical} {
+		pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+	}
+
+	// Fill the pool up with the normal transactions
+	for i := uint64(0); i < 4; i++ {
+		assert.NoError(t, pool.AddRemote(transaction(i, 100000, normal)))
+	}
+	assert.Error(t, pool.AddRemote(transaction(0, 100000, other)))
+
+	// The priority transactions are accepted up to the reserved slots
+	assert.NoError(t, pool.AddRemote(transaction(0, 100000, critical)))
+	assert.NoError(t, pool.AddRemote(transaction(1, 100000, critical)))
+	assert.Error(t, pool.AddRemote(transaction(2, 100000, critical)))
+
+	pending, queued := pool.Stats()
+	assert.Equal(t, 6, pending)
+	assert.Equal(t, 0, queued)
+	assert.NoError(t, validateTxPoolInternals(pool))
+
+	assert.Equal(t, 0, pool.TxPriority(transaction(0, 100000, critical)))
+	assert.Equal(t, 1, pool.TxPriority(transaction(0, 100000, normal)))
+	assert.Equal(t, []TxPriorityClassStatus{{Name: "critical", Slots: 2, Pending: 2, Queued: 0}}, pool.PriorityStatus())
+
+	// A reserved slot is released when the priority transaction is removed
+	assert.NotNil(t, pool.Remove(transaction(1, 100000, critical).Hash()))
+	assert.Equal(t, 1, pool.priority.counts[0])
+	assert.NoError(t, pool.AddRemote(transaction(1, 100000, critical)))
+	assert.Equal(t, 2, pool.priority.counts[0])
+	assert.NoError(t, validateTxPoolInternals(pool))
+}
+
+// TestReadTxPriorityClasses tests that the priority classes are read from a JSON file.
+func TestReadTxPriorityClasses(t *testing.T) {
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary file: %v", err)
+	}
+	defer os.Remove(file.Name())
+
+	write := func(data string) {
+		assert.NoError(t, ioutil.WriteFile(file.Name(), []byte(data), 0644))
+	}
+
+	write(`[{"Name": "bridge", "Senders": ["0x0000000000000000000000000000000000000001"], "Slots": 10},
+		{"Name": "service", "To": ["0x0000000000000000000000000000000000000002"], "Slots": 20}]`)
+	classes, err := ReadTxPriorityClasses(file.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, []TxPriorityClass{
+		{Name: "bridge", Senders: []common.Address{common.HexToAddress("0x1")}, Slots: 10},
+		{Name: "service", To: []common.Address{common.HexToAddress("0x2")}, Slots: 20},
+	}, classes)
+
+	write(`[{"Senders": ["0x0000000000000000000000000000000000000001"], "Slots": 10}]`)
+	_, err = ReadTxPriorityClasses(file.Name())
+	assert.Equal(t, errNoTxPriorityClassName, err)
+
+	write(`{"Name": "bridge"}`)
+	_, err = ReadTxPriorityClasses(file.Name())
+	assert.Error(t, err)
+}
+
+// TestContentFromAndNonExecutableReasons tests that the transactions are retrieved
+// by the sender or by the fee payer, and the reasons why the queued transactions
+// can not be executed are reported.
+func TestContentFromAndNonExecutableReasons(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(1000000000))
+
+	txs := types.Transactions{transaction(0, 100000, key), transaction(1, 100000, key), transaction(3, 100000, key)}
+	for _, tx := range txs {
+		assert.NoError(t, pool.AddRemote(tx))
+	}
+
+	pending, queued := pool.ContentFrom(from)
+	assert.Equal(t, types.Transactions{txs[0], txs[1]}, pending)
+	assert.Equal(t, types.Transactions{txs[2]}, queued)
+	assert.Equal(t, []string{TxNonExecReasonNonceGap}, pool.NonExecutableReasons(queued))
+
+	// A fee-delegated transaction waiting for the preceding nonce
+	senderKey, _ := crypto.GenerateKey()
+	feePayerKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	feePayer := crypto.PubkeyToAddress(feePayerKey.PublicKey)
+	pool.currentState.AddBalance(sender, big.NewInt(100))
+	pool.currentState.AddBalance(feePayer, big.NewInt(1000000000))
+
+	signer := types.NewEIP155Signer(params.TestChainConfig.ChainID)
+	fdTx, err := types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(1),
+		types.TxValueKeyTo:       common.HexToAddress("0xAAAA"),
+		types.TxValueKeyAmount:   big.NewInt(100),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(1),
+		types.TxValueKeyFrom:     sender,
+		types.TxValueKeyFeePayer: feePayer,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, fdTx.Sign(signer, senderKey))
+	assert.NoError(t, fdTx.SignFeePayer(signer, feePayerKey))
+	assert.NoError(t, pool.AddRemote(fdTx))
+
+	pendingByFeePayer, queuedByFeePayer := pool.ContentByFeePayer(feePayer)
+	assert.Equal(t, 0, len(pendingByFeePayer))
+	assert.Equal(t, types.Transactions{fdTx}, queuedByFeePayer[sender])
+	assert.Equal(t, []string{TxNonExecReasonNonceGap}, pool.NonExecutableReasons(types.Transactions{fdTx}))
+
+	// Once the nonce gap is filled, the balances decide the executability
+	pool.currentState.SetNonce(sender, 1)
+	assert.Equal(t, []string{""}, pool.NonExecutableReasons(types.Transactions{fdTx}))
+
+	pool.currentState.SetBalance(feePayer, big.NewInt(0))
+	assert.Equal(t, []string{TxNonExecReasonInsufficientFeePayerBalance}, pool.NonExecutableReasons(types.Transactions{fdTx}))
+
+	pool.currentState.SetBalance(sender, big.NewInt(0))
+	assert.Equal(t, []string{TxNonExecReasonInsufficientBalance}, pool.NonExecutableReasons(types.Transactions{fdTx}))
+
+	// The transactions not in the queue have no reason
+	assert.Equal(t, []string{""}, pool.NonExecutableReasons(types.Transactions{txs[0]}))
+}
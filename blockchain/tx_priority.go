@@ -0,0 +1,160 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/rcrowley/go-metrics"
+	"io/ioutil"
+)
+
+var errNoTxPriorityClassName = errors.New("priority class without a name")
+
+// TxPriorityClass is a class of transactions which have reserved slots in the
+// transaction pool and are executed ahead of the other transactions. A transaction
+// belongs to the class if its sender, fee payer or recipient is listed in the class.
+// If a transaction belongs to several classes, the class listed first is applied.
+type TxPriorityClass struct {
+	Name      string
+	Senders   []common.Address
+	FeePayers []common.Address
+	To        []common.Address
+	Slots     uint64 // Number of slots reserved for the class on top of ExecSlotsAll and NonExecSlotsAll
+}
+
+// ReadTxPriorityClasses reads the priority classes from the JSON file of the given path,
+// which is a list of the classes in the order of priority, e.g.
+// [{"Name": "bridge", "Senders": ["0x..."], "Slots": 1024}].
+func ReadTxPriorityClasses(path string) ([]TxPriorityClass, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var classes []TxPriorityClass
+	if err := json.Unmarshal(data, &classes); err != nil {
+		return nil, err
+	}
+	for _, class := range classes {
+		if class.Name == "" {
+			return nil, errNoTxPriorityClassName
+		}
+	}
+	return classes, nil
+}
+
+// TxPriorityClassStatus is the occupancy of a priority class in the transaction pool.
+type TxPriorityClassStatus struct {
+	Name    string
+	Slots   uint64
+	Pending int
+	Queued  int
+}
+
+// txPriority classifies transactions into the priority classes.
+type txPriority struct {
+	classes   []TxPriorityClass
+	reserved  uint64 // Total number of reserved slots of all classes
+	senders   map[common.Address]int
+	feePayers map[common.Address]int
+	to        map[common.Address]int
+	counts    []int // Number of transactions of each class in the pool
+
+	pendingGauges []metrics.Gauge
+	queuedGauges  []metrics.Gauge
+}
+
+func newTxPriority(classes []TxPriorityClass) *txPriority {
+	p := &txPriority{
+		classes:   classes,
+		senders:   make(map[common.Address]int),
+		feePayers: make(map[common.Address]int),
+		to:        make(map[common.Address]int),
+		counts:    make([]int, len(classes)),
+	}
+	put := func(m map[common.Address]int, addrs []common.Address, class int) {
+		for _, addr := range addrs {
+			if _, exist := m[addr]; !exist {
+				m[addr] = class
+			}
+		}
+	}
+	for i, class := range classes {
+		put(p.senders, class.Senders, i)
+		put(p.feePayers, class.FeePayers, i)
+		put(p.to, class.To, i)
+		p.reserved += class.Slots
+
+		p.pendingGauges = append(p.pendingGauges, metrics.GetOrRegisterGauge(fmt.Sprintf("txpool/priority/%s/pending", class.Name), nil))
+		p.queuedGauges = append(p.queuedGauges, metrics.GetOrRegisterGauge(fmt.Sprintf("txpool/priority/%s/queued", class.Name), nil))
+	}
+	return p
+}
+
+// none returns the class value of the transactions not belonging to any priority class.
+func (p *txPriority) none() int {
+	return len(p.classes)
+}
+
+// classOf returns the index of the priority class the transaction belongs to.
+// A lower value means a higher priority, and none() is returned for the others.
+func (p *txPriority) classOf(signer types.Signer, tx *types.Transaction) int {
+	class := p.none()
+	if class == 0 {
+		return class
+	}
+	if from, err := types.Sender(signer, tx); err == nil {
+		if c, exist := p.senders[from]; exist && c < class {
+			class = c
+		}
+	}
+	if tx.IsFeeDelegatedTransaction() {
+		if feePayer, err := tx.FeePayer(); err == nil {
+			if c, exist := p.feePayers[feePayer]; exist && c < class {
+				class = c
+			}
+		}
+	}
+	if to := tx.To(); to != nil {
+		if c, exist := p.to[*to]; exist && c < class {
+			class = c
+		}
+	}
+	return class
+}
+
+// count adds delta to the number of transactions of the class in the pool.
+// The transactions not belonging to any priority class are not counted.
+func (p *txPriority) count(class int, delta int) {
+	if class < len(p.counts) {
+		p.counts[class] += delta
+	}
+}
+
+// resetCounts clears the number of transactions of each class in the pool.
+func (p *txPriority) resetCounts() {
+	p.counts = make([]int, len(p.classes))
+}
+
+// isSender returns true if the account is a sender of any priority class.
+func (p *txPriority) isSender(addr common.Address) bool {
+	_, exist := p.senders[addr]
+	return exist
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"io"
+	"os"
+)
+
+// txSnapshotEntry is a transaction stored in the snapshot with the time when
+// it is first seen in the pool, which is restored to keep the order of arrival.
+type txSnapshotEntry struct {
+	Tx   *types.Transaction
+	Time uint64 // Unix time in nanoseconds when the transaction is first seen in the pool
+}
+
+// txSnapshot is a disk snapshot of all transactions in the pool, both executable
+// and non-executable, with the aim of allowing remote transactions to survive
+// node restarts. Unlike txJournal, it is overwritten as a whole every time.
+type txSnapshot struct {
+	path string // Filesystem path to store the transactions at
+}
+
+// newTxSnapshot creates a new transaction pool snapshot.
+func newTxSnapshot(path string) *txSnapshot {
+	return &txSnapshot{
+		path: path,
+	}
+}
+
+// load parses a transaction pool snapshot from disk.
+func (snapshot *txSnapshot) load() ([]txSnapshotEntry, error) {
+	// Skip the parsing if the snapshot file doesn't exist at all
+	if _, err := os.Stat(snapshot.path); os.IsNotExist(err) {
+		return nil, nil
+	}
+	input, err := os.Open(snapshot.path)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	var (
+		entries []txSnapshotEntry
+		stream  = rlp.NewStream(input, 0)
+	)
+	for {
+		var entry txSnapshotEntry
+		if err := stream.Decode(&entry); err != nil {
+			if err != io.EOF {
+				return entries, err
+			}
+			return entries, nil
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// save writes the given transactions to the snapshot, replacing the previous one.
+func (snapshot *txSnapshot) save(entries []txSnapshotEntry) error {
+	// Write a new snapshot first not to lose the previous one on failure
+	replacement, err := os.OpenFile(snapshot.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		if err = rlp.Encode(replacement, &entries[i]); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	if err = replacement.Close(); err != nil {
+		return err
+	}
+	return os.Rename(snapshot.path+".new", snapshot.path)
+}
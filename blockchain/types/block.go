@@ -64,6 +64,9 @@ type Header struct {
 	Extra      []byte `json:"extraData"        gencodec:"required"`
 	Governance []byte `json:"governanceData"        gencodec:"required"`
 	Vote       []byte `json:"voteData,omitempty"`
+
+	// BaseFee is the price of gas charged in the block, which is set after the dynamic fee fork.
+	BaseFee *big.Int `json:"baseFeePerGas,omitempty" rlp:"optional"`
 }
 
 // field type overrides for gencodec
@@ -77,6 +80,7 @@ type headerMarshaling struct {
 	Hash       common.Hash `json:"hash"` // adds call to Hash() in MarshalJSON
 	Governance hexutil.Bytes
 	Vote       hexutil.Bytes
+	BaseFee    *hexutil.Big
 }
 
 // Hash returns the block hash of the header, which is simply the keccak256 hash of its
@@ -219,6 +223,9 @@ func CopyHeader(h *Header) *Header {
 		cpy.Vote = make([]byte, len(h.Vote))
 		copy(cpy.Vote, h.Vote)
 	}
+	if h.BaseFee != nil {
+		cpy.BaseFee = new(big.Int).Set(h.BaseFee)
+	}
 	return &cpy
 }
 
@@ -259,6 +266,14 @@ func (b *Block) BlockScore() *big.Int { return new(big.Int).Set(b.header.BlockSc
 func (b *Block) Time() *big.Int       { return new(big.Int).Set(b.header.Time) }
 func (b *Block) TimeFoS() uint8       { return b.header.TimeFoS }
 
+// BaseFee returns the base fee of the block, or nil if the block is made before the dynamic fee fork.
+func (b *Block) BaseFee() *big.Int {
+	if b.header.BaseFee == nil {
+		return nil
+	}
+	return new(big.Int).Set(b.header.BaseFee)
+}
+
 func (b *Block) NumberU64() uint64          { return b.header.Number.Uint64() }
 func (b *Block) Bloom() Bloom               { return b.header.Bloom }
 func (b *Block) Rewardbase() common.Address { return b.header.Rewardbase }
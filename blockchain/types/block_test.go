@@ -56,6 +56,38 @@ func genBlock() *Block {
 	}
 }
 
+// TestHeaderEncodingWithBaseFee tests that the base fee is encoded only if it is set,
+// so that the hash of a header before the dynamic fee fork is not changed.
+func TestHeaderEncodingWithBaseFee(t *testing.T) {
+	header := genHeader()
+	hash := header.Hash()
+
+	header.BaseFee = big.NewInt(25000000000)
+	if header.Hash() == hash {
+		t.Fatal("header hash is not changed by the base fee")
+	}
+
+	enc, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		t.Fatal("encode error: ", err)
+	}
+	var decoded Header
+	if err := rlp.DecodeBytes(enc, &decoded); err != nil {
+		t.Fatal("decode error: ", err)
+	}
+	if decoded.BaseFee == nil || decoded.BaseFee.Cmp(header.BaseFee) != 0 {
+		t.Errorf("base fee mismatch: got %v, want %v", decoded.BaseFee, header.BaseFee)
+	}
+	if decoded.Hash() != header.Hash() {
+		t.Errorf("hash mismatch: got %x, want %x", decoded.Hash(), header.Hash())
+	}
+
+	header.BaseFee = nil
+	if header.Hash() != hash {
+		t.Errorf("hash mismatch: got %x, want %x", header.Hash(), hash)
+	}
+}
+
 func TestBlockEncoding(t *testing.T) {
 	b := genBlock()
 
@@ -98,6 +130,7 @@ func TestBlockEncoding(t *testing.T) {
 		header.Extra,
 		header.Governance,
 		header.Vote,
+		header.BaseFee,
 	}
 
 	resHash := rlpHash(fHeader)
@@ -30,6 +30,7 @@ func (h Header) MarshalJSON() ([]byte, error) {
 		Extra       hexutil.Bytes  `json:"extraData"        gencodec:"required"`
 		Governance  hexutil.Bytes  `json:"governanceData"        gencodec:"required"`
 		Vote        hexutil.Bytes  `json:"voteData,omitempty"`
+		BaseFee     *hexutil.Big   `json:"baseFeePerGas,omitempty" rlp:"optional"`
 		Hash        common.Hash    `json:"hash"`
 	}
 	var enc Header
@@ -47,6 +48,7 @@ func (h Header) MarshalJSON() ([]byte, error) {
 	enc.Extra = h.Extra
 	enc.Governance = h.Governance
 	enc.Vote = h.Vote
+	enc.BaseFee = (*hexutil.Big)(h.BaseFee)
 	enc.Hash = h.Hash()
 	return json.Marshal(&enc)
 }
@@ -68,6 +70,7 @@ func (h *Header) UnmarshalJSON(input []byte) error {
 		Extra       *hexutil.Bytes  `json:"extraData"        gencodec:"required"`
 		Governance  *hexutil.Bytes  `json:"governanceData"        gencodec:"required"`
 		Vote        *hexutil.Bytes  `json:"voteData,omitempty"`
+		BaseFee     *hexutil.Big    `json:"baseFeePerGas,omitempty" rlp:"optional"`
 	}
 	var dec Header
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -128,5 +131,8 @@ func (h *Header) UnmarshalJSON(input []byte) error {
 	if dec.Vote != nil {
 		h.Vote = *dec.Vote
 	}
+	if dec.BaseFee != nil {
+		h.BaseFee = (*big.Int)(dec.BaseFee)
+	}
 	return nil
 }
@@ -21,6 +21,7 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 		TxHash          common.Hash    `json:"transactionHash" gencodec:"required"`
 		ContractAddress common.Address `json:"contractAddress"`
 		GasUsed         hexutil.Uint64 `json:"gasUsed" gencodec:"required"`
+		RevertReason    hexutil.Bytes  `json:"revertReason,omitempty"`
 	}
 	var enc Receipt
 	enc.Status = hexutil.Uint(r.Status)
@@ -29,6 +30,7 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.TxHash = r.TxHash
 	enc.ContractAddress = r.ContractAddress
 	enc.GasUsed = hexutil.Uint64(r.GasUsed)
+	enc.RevertReason = r.RevertReason
 	return json.Marshal(&enc)
 }
 
@@ -41,6 +43,7 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		TxHash          *common.Hash    `json:"transactionHash" gencodec:"required"`
 		ContractAddress *common.Address `json:"contractAddress"`
 		GasUsed         *hexutil.Uint64 `json:"gasUsed" gencodec:"required"`
+		RevertReason    *hexutil.Bytes  `json:"revertReason,omitempty"`
 	}
 	var dec Receipt
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -68,5 +71,8 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		return errors.New("missing required field 'gasUsed' for Receipt")
 	}
 	r.GasUsed = uint64(*dec.GasUsed)
+	if dec.RevertReason != nil {
+		r.RevertReason = *dec.RevertReason
+	}
 	return nil
 }
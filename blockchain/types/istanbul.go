@@ -43,28 +43,52 @@ type IstanbulExtra struct {
 	Validators    []common.Address
 	Seal          []byte
 	CommittedSeal [][]byte
+
+	// Committed seals of BLS signatures, aggregated into one signature.
+	// The bitmap indicates the signers in the validator list of the parent block sorted by address.
+	AggregatedSeal []byte
+	SealBitmap     []byte
+
+	// BLS public key of the proposer and its proof of possession, to register the key.
+	BLSPublicKey []byte
+	BLSProof     []byte
 }
 
 // EncodeRLP serializes the istanbul fields into the Klaytn RLP format.
+// The BLS fields are encoded only if they are not empty, so the encoding of
+// an extra without them is the same as before the BLS fork.
 func (ist *IstanbulExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
+	fields := []interface{}{
 		ist.Validators,
 		ist.Seal,
 		ist.CommittedSeal,
-	})
+	}
+	if len(ist.AggregatedSeal) > 0 || len(ist.SealBitmap) > 0 || len(ist.BLSPublicKey) > 0 || len(ist.BLSProof) > 0 {
+		fields = append(fields, ist.AggregatedSeal, ist.SealBitmap)
+	}
+	if len(ist.BLSPublicKey) > 0 || len(ist.BLSProof) > 0 {
+		fields = append(fields, ist.BLSPublicKey, ist.BLSProof)
+	}
+	return rlp.Encode(w, fields)
 }
 
 // DecodeRLP implements rlp.Decoder, and load the istanbul fields from a RLP stream.
 func (ist *IstanbulExtra) DecodeRLP(s *rlp.Stream) error {
 	var istanbulExtra struct {
-		Validators    []common.Address
-		Seal          []byte
-		CommittedSeal [][]byte
+		Validators     []common.Address
+		Seal           []byte
+		CommittedSeal  [][]byte
+		AggregatedSeal []byte `rlp:"optional"`
+		SealBitmap     []byte `rlp:"optional"`
+		BLSPublicKey   []byte `rlp:"optional"`
+		BLSProof       []byte `rlp:"optional"`
 	}
 	if err := s.Decode(&istanbulExtra); err != nil {
 		return err
 	}
 	ist.Validators, ist.Seal, ist.CommittedSeal = istanbulExtra.Validators, istanbulExtra.Seal, istanbulExtra.CommittedSeal
+	ist.AggregatedSeal, ist.SealBitmap = istanbulExtra.AggregatedSeal, istanbulExtra.SealBitmap
+	ist.BLSPublicKey, ist.BLSProof = istanbulExtra.BLSPublicKey, istanbulExtra.BLSProof
 	return nil
 }
 
@@ -84,7 +108,7 @@ func ExtractIstanbulExtra(h *Header) (*IstanbulExtra, error) {
 	return istanbulExtra, nil
 }
 
-// IstanbulFilteredHeader returns a filtered header which some information (like seal, committed seals)
+// IstanbulFilteredHeader returns a filtered header which some information (like seal, committed seals, aggregated seal)
 // are clean to fulfill the Istanbul hash rules. It returns nil if the extra-data cannot be
 // decoded/encoded by rlp.
 func IstanbulFilteredHeader(h *Header, keepSeal bool) *Header {
@@ -98,6 +122,8 @@ func IstanbulFilteredHeader(h *Header, keepSeal bool) *Header {
 		istanbulExtra.Seal = []byte{}
 	}
 	istanbulExtra.CommittedSeal = [][]byte{}
+	istanbulExtra.AggregatedSeal = []byte{}
+	istanbulExtra.SealBitmap = []byte{}
 
 	payload, err := rlp.EncodeToBytes(&istanbulExtra)
 	if err != nil {
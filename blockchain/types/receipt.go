@@ -100,11 +100,16 @@ type Receipt struct {
 	TxHash          common.Hash    `json:"transactionHash" gencodec:"required"`
 	ContractAddress common.Address `json:"contractAddress"`
 	GasUsed         uint64         `json:"gasUsed" gencodec:"required"`
+
+	// RevertReason is the revert payload of a reverted execution. It is neither
+	// part of the consensus nor the storage encoding of a receipt.
+	RevertReason []byte `json:"revertReason,omitempty"`
 }
 
 type receiptMarshaling struct {
-	Status  hexutil.Uint
-	GasUsed hexutil.Uint64
+	Status       hexutil.Uint
+	GasUsed      hexutil.Uint64
+	RevertReason hexutil.Bytes
 }
 
 // receiptRLP is the consensus encoding of a receipt.
@@ -33,6 +33,7 @@ import (
 	"io"
 	"math/big"
 	"sync/atomic"
+	"time"
 )
 
 var (
@@ -55,6 +56,7 @@ func deriveSigner(V *big.Int) Signer {
 type Transaction struct {
 	data TxInternalData
 	// caches
+	time         atomic.Value // Time first seen in the local tx pool
 	hash         atomic.Value
 	size         atomic.Value
 	from         atomic.Value
@@ -126,6 +128,20 @@ func newTransaction(nonce uint64, to *common.Address, amount *big.Int, gasLimit
 	return &Transaction{data: &d}
 }
 
+// Time returns the time when the transaction is first seen in the local tx pool.
+// A zero time is returned if the transaction has not been in the pool.
+func (tx *Transaction) Time() time.Time {
+	if t := tx.time.Load(); t != nil {
+		return t.(time.Time)
+	}
+	return time.Time{}
+}
+
+// SetTime sets the time when the transaction is first seen in the local tx pool.
+func (tx *Transaction) SetTime(t time.Time) {
+	tx.time.Store(t)
+}
+
 // ChainId returns which chain id this transaction was signed for (if at all)
 func (tx *Transaction) ChainId() *big.Int {
 	return tx.data.ChainId()
@@ -708,12 +724,31 @@ func (s *TxByPrice) Pop() interface{} {
 	return x
 }
 
+// TxPriorityFunc returns the priority of a transaction. A transaction with a lower
+// value is ordered ahead of the others regardless of the price.
+type TxPriorityFunc func(tx *Transaction) int
+
+// txByPriorityAndPrice is a heap of transactions sorted by the priority first and then by the price.
+type txByPriorityAndPrice struct {
+	TxByPrice
+	priority TxPriorityFunc
+}
+
+func (s txByPriorityAndPrice) Less(i, j int) bool {
+	if s.priority != nil {
+		if pi, pj := s.priority(s.TxByPrice[i]), s.priority(s.TxByPrice[j]); pi != pj {
+			return pi < pj
+		}
+	}
+	return s.TxByPrice.Less(i, j)
+}
+
 // TransactionsByPriceAndNonce represents a set of transactions that can return
 // transactions in a profit-maximizing sorted order, while supporting removing
 // entire batches of transactions for non-executable accounts.
 type TransactionsByPriceAndNonce struct {
 	txs    map[common.Address]Transactions // Per account nonce-sorted list of transactions
-	heads  TxByPrice                       // Next transaction for each unique account (price heap)
+	heads  txByPriorityAndPrice            // Next transaction for each unique account (priority and price heap)
 	signer Signer                          // Signer for the set of transactions
 }
 
@@ -738,10 +773,17 @@ func (t *TransactionsByPriceAndNonce) Txs() map[common.Address]Transactions {
 // Note, the input map is reowned so the caller should not interact any more with
 // if after providing it to the constructor.
 func NewTransactionsByPriceAndNonce(signer Signer, txs map[common.Address]Transactions) *TransactionsByPriceAndNonce {
-	// Initialize a price based heap with the head transactions
-	heads := make(TxByPrice, 0, len(txs))
+	return NewTransactionsByPriceAndNonceWithPriority(signer, txs, nil)
+}
+
+// NewTransactionsByPriceAndNonceWithPriority creates a transaction set like
+// NewTransactionsByPriceAndNonce, but the head transactions of higher priority
+// accounts are retrieved first regardless of the price.
+func NewTransactionsByPriceAndNonceWithPriority(signer Signer, txs map[common.Address]Transactions, priority TxPriorityFunc) *TransactionsByPriceAndNonce {
+	// Initialize a priority and price based heap with the head transactions
+	heads := txByPriorityAndPrice{TxByPrice: make(TxByPrice, 0, len(txs)), priority: priority}
 	for _, accTxs := range txs {
-		heads = append(heads, accTxs[0])
+		heads.TxByPrice = append(heads.TxByPrice, accTxs[0])
 		// Ensure the sender address is from the signer
 		acc, _ := Sender(signer, accTxs[0])
 		txs[acc] = accTxs[1:]
@@ -758,17 +800,17 @@ func NewTransactionsByPriceAndNonce(signer Signer, txs map[common.Address]Transa
 
 // Peek returns the next transaction by price.
 func (t *TransactionsByPriceAndNonce) Peek() *Transaction {
-	if len(t.heads) == 0 {
+	if t.heads.Len() == 0 {
 		return nil
 	}
-	return t.heads[0]
+	return t.heads.TxByPrice[0]
 }
 
 // Shift replaces the current best head with the next one from the same account.
 func (t *TransactionsByPriceAndNonce) Shift() {
-	acc, _ := Sender(t.signer, t.heads[0])
+	acc, _ := Sender(t.signer, t.heads.TxByPrice[0])
 	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
-		t.heads[0], t.txs[acc] = txs[0], txs[1:]
+		t.heads.TxByPrice[0], t.txs[acc] = txs[0], txs[1:]
 		heap.Fix(&t.heads, 0)
 	} else {
 		heap.Pop(&t.heads)
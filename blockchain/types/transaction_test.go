@@ -187,6 +187,55 @@ func TestTransactionPriceNonceSort(t *testing.T) {
 	}
 }
 
+// Tests that transactions of higher priority are retrieved first regardless of the
+// price, and the transactions of the same priority are sorted by the price.
+func TestTransactionPriorityPriceNonceSort(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 5)
+	for i := 0; i < len(keys); i++ {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	signer := NewEIP155Signer(common.Big1)
+
+	// The first two accounts have the lowest prices, but higher priorities
+	groups := map[common.Address]Transactions{}
+	priorities := map[common.Address]int{}
+	for i, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		for nonce := 0; nonce < 3; nonce++ {
+			tx, _ := SignTx(NewTransaction(uint64(nonce), common.Address{}, big.NewInt(100), 100, big.NewInt(int64(i+1)), nil), signer, key)
+			groups[addr] = append(groups[addr], tx)
+		}
+		priorities[addr] = 2
+		if i < 2 {
+			priorities[addr] = i
+		}
+	}
+	priority := func(tx *Transaction) int {
+		from, _ := Sender(signer, tx)
+		return priorities[from]
+	}
+	txset := NewTransactionsByPriceAndNonceWithPriority(signer, groups, priority)
+
+	var txs Transactions
+	for tx := txset.Peek(); tx != nil; tx = txset.Peek() {
+		txs = append(txs, tx)
+		txset.Shift()
+	}
+	assert.Equal(t, 15, len(txs))
+	for i := 0; i+1 < len(txs); i++ {
+		pi, pj := priority(txs[i]), priority(txs[i+1])
+		assert.True(t, pi <= pj, "invalid priority ordering at %d", i)
+		if pi == pj && pi == 2 {
+			assert.True(t, txs[i].GasPrice().Cmp(txs[i+1].GasPrice()) >= 0, "invalid price ordering at %d", i)
+		}
+	}
+	// The transactions of the highest priority come first in the nonce order
+	for nonce := 0; nonce < 3; nonce++ {
+		assert.Equal(t, 0, priority(txs[nonce]))
+		assert.Equal(t, uint64(nonce), txs[nonce].Nonce())
+	}
+}
+
 func TestGasOverflow(t *testing.T) {
 	// AccountCreation
 	// calculate gas for account creation
@@ -23,6 +23,7 @@ package vm
 import (
 	"crypto/ecdsa"
 	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 	"github.com/klaytn/klaytn/api/debug"
 	"github.com/klaytn/klaytn/blockchain/types"
@@ -30,6 +31,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/math"
 	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/crypto/blake2b"
 	"github.com/klaytn/klaytn/crypto/bn256"
 	"github.com/klaytn/klaytn/kerrors"
 	"github.com/klaytn/klaytn/log"
@@ -67,6 +69,10 @@ var feePayerAddress = common.BytesToAddress([]byte{10})
 // validateSenderAddress is the address of precompiled contract ValidateSender.
 var validateSenderAddress = common.BytesToAddress([]byte{11})
 
+// blake2FAddress is the address of precompiled contract blake2F.
+// It is not deployed at 0x09 of Ethereum since the address is already taken by vmLog.
+var blake2FAddress = common.BytesToAddress([]byte{12})
+
 // PrecompiledContractsCypress contains the default set of pre-compiled contracts.
 var PrecompiledContractsCypress = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{1}): &ecrecover{},
@@ -82,6 +88,31 @@ var PrecompiledContractsCypress = map[common.Address]PrecompiledContract{
 	validateSenderAddress:            &precompiledValidateSender{},
 }
 
+// PrecompiledContractsIstanbul contains the default set of pre-compiled contracts
+// since the istanbul fork.
+var PrecompiledContractsIstanbul = map[common.Address]PrecompiledContract{
+	common.BytesToAddress([]byte{1}): &ecrecover{},
+	common.BytesToAddress([]byte{2}): &sha256hash{},
+	common.BytesToAddress([]byte{3}): &ripemd160hash{},
+	common.BytesToAddress([]byte{4}): &dataCopy{},
+	common.BytesToAddress([]byte{5}): &bigModExp{},
+	common.BytesToAddress([]byte{6}): &bn256Add{},
+	common.BytesToAddress([]byte{7}): &bn256ScalarMul{},
+	common.BytesToAddress([]byte{8}): &bn256Pairing{},
+	vmLogAddress:                     &vmLog{},
+	feePayerAddress:                  &feePayer{},
+	validateSenderAddress:            &precompiledValidateSender{},
+	blake2FAddress:                   &blake2F{},
+}
+
+// PrecompiledContracts returns the precompiled contracts enabled by the given chain rules.
+func PrecompiledContracts(rules params.Rules) map[common.Address]PrecompiledContract {
+	if rules.IsIstanbul {
+		return PrecompiledContractsIstanbul
+	}
+	return PrecompiledContractsCypress
+}
+
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
 func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contract) (ret []byte, computationCost uint64, err error) {
 	gas, computationCost := p.GetRequiredGasAndComputationCost(input)
@@ -400,6 +431,72 @@ func (c *bn256Pairing) Run(input []byte) ([]byte, error) {
 	return false32Byte, nil
 }
 
+// blake2F implements the BLAKE2b compression function F as a native contract (EIP-152).
+type blake2F struct{}
+
+const (
+	blake2FInputLength        = 213
+	blake2FFinalBlockBytes    = byte(1)
+	blake2FNonFinalBlockBytes = byte(0)
+)
+
+var (
+	errBlake2FInvalidInputLength = errors.New("invalid input length")
+	errBlake2FInvalidFinalFlag   = errors.New("invalid final flag")
+)
+
+// GetRequiredGasAndComputationCost returns the gas required to execute the pre-compiled contract
+// and the computation cost of the precompiled contract.
+func (c *blake2F) GetRequiredGasAndComputationCost(input []byte) (uint64, uint64) {
+	// If the input is malformed, we can't calculate the gas, return 0 and let the
+	// actual call choke and fault.
+	if len(input) != blake2FInputLength {
+		return 0, 0
+	}
+	rounds := uint64(binary.BigEndian.Uint32(input[0:4]))
+	return rounds * params.Blake2bFRoundGas,
+		params.Blake2bFBaseComputationCost + rounds*params.Blake2bFPerRoundComputationCost
+}
+
+func (c *blake2F) Run(input []byte) ([]byte, error) {
+	// Make sure the input is valid (correct length and final flag)
+	if len(input) != blake2FInputLength {
+		return nil, errBlake2FInvalidInputLength
+	}
+	if input[212] != blake2FNonFinalBlockBytes && input[212] != blake2FFinalBlockBytes {
+		return nil, errBlake2FInvalidFinalFlag
+	}
+	// Parse the input into the Blake2b call parameters
+	var (
+		rounds = binary.BigEndian.Uint32(input[0:4])
+		final  = input[212] == blake2FFinalBlockBytes
+
+		h [8]uint64
+		m [16]uint64
+		t [2]uint64
+	)
+	for i := 0; i < 8; i++ {
+		offset := 4 + i*8
+		h[i] = binary.LittleEndian.Uint64(input[offset : offset+8])
+	}
+	for i := 0; i < 16; i++ {
+		offset := 68 + i*8
+		m[i] = binary.LittleEndian.Uint64(input[offset : offset+8])
+	}
+	t[0] = binary.LittleEndian.Uint64(input[196:204])
+	t[1] = binary.LittleEndian.Uint64(input[204:212])
+
+	// Execute the compression function, extract and return the result
+	blake2b.F(&h, m, t, final, rounds)
+
+	output := make([]byte, 64)
+	for i := 0; i < 8; i++ {
+		offset := i * 8
+		binary.LittleEndian.PutUint64(output[offset:offset+8], h[i])
+	}
+	return output, nil
+}
+
 // vmLog implemented as a native contract.
 type vmLog struct{}
 
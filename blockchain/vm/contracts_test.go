@@ -508,6 +508,48 @@ func BenchmarkPrecompiledModExp(bench *testing.B) {
 	}
 }
 
+// blake2FTests are the test vectors of EIP-152 for the blake2F precompiled contract.
+var blake2FTests = []precompiledTest{
+	{
+		input:    "0000000048c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000001",
+		expected: "08c9bcf367e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d282e6ad7f520e511f6c3e2b8c68059b9442be0454267ce079217e1319cde05b",
+		name:     "vector 4",
+	},
+	{
+		input:    "0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000001",
+		expected: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+		name:     "vector 5",
+	},
+	{
+		input:    "0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000",
+		expected: "75ab69d3190a562c51aef8d88f1c2775876944407270c42c9844252c26d2875298743e7f6d5ea2f2d3e8d226039cd31b4e426ac4f2d3d666a610c2116fde4735",
+		name:     "vector 6",
+	},
+}
+
+// Tests the sample inputs of EIP-152 for the blake2F precompiled contract.
+func TestPrecompiledBlake2F(t *testing.T) {
+	p := PrecompiledContractsIstanbul[common.HexToAddress("0c")]
+	for _, test := range blake2FTests {
+		in := common.Hex2Bytes(test.input)
+		reqGas, _ := p.GetRequiredGasAndComputationCost(in)
+		contract := NewContract(AccountRef(common.HexToAddress("1337")), nil, new(big.Int), reqGas)
+
+		res, _, err := RunPrecompiledContract(p, in, contract)
+		require.NoError(t, err, test.name)
+		require.Equal(t, test.expected, common.Bytes2Hex(res), test.name)
+	}
+
+	// Malformed inputs are rejected.
+	in := common.Hex2Bytes(blake2FTests[0].input)
+	_, err := p.Run(in[:len(in)-1])
+	require.Equal(t, errBlake2FInvalidInputLength, err)
+
+	in[len(in)-1] = 2
+	_, err = p.Run(in)
+	require.Equal(t, errBlake2FInvalidFinalFlag, err)
+}
+
 // Tests the sample inputs from the elliptic curve addition EIP 213.
 func TestPrecompiledBn256Add(t *testing.T) {
 	for _, test := range bn256AddTests {
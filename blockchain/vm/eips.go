@@ -0,0 +1,53 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"github.com/klaytn/klaytn/params"
+)
+
+// enable1884 applies EIP-1884 to the given jump table:
+// - Define SELFBALANCE, with cost GasFastStep (5)
+// The repricing of SLOAD, BALANCE and EXTCODEHASH is applied by params.GasTableIstanbul.
+func enable1884(jt *[256]operation) {
+	jt[SELFBALANCE] = operation{
+		execute:         opSelfBalance,
+		constantGas:     GasFastStep,
+		minStack:        minStack(0, 1),
+		maxStack:        maxStack(0, 1),
+		valid:           true,
+		computationCost: params.SelfBalanceComputationCost,
+	}
+}
+
+// enable1344 applies EIP-1344 (ChainID Opcode)
+// - Adds an opcode that returns the current chain’s EIP-155 unique identifier
+func enable1344(jt *[256]operation) {
+	jt[CHAINID] = operation{
+		execute:         opChainID,
+		constantGas:     GasQuickStep,
+		minStack:        minStack(0, 1),
+		maxStack:        maxStack(0, 1),
+		valid:           true,
+		computationCost: params.ChainIDComputationCost,
+	}
+}
+
+// enable2200 applies EIP-2200 (Rebalance net-metered SSTORE)
+func enable2200(jt *[256]operation) {
+	jt[SSTORE].dynamicGas = gasSStoreEIP2200
+}
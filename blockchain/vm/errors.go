@@ -20,11 +20,7 @@
 
 package vm
 
-import (
-	"errors"
-	"fmt"
-	"github.com/klaytn/klaytn/params"
-)
+import "errors"
 
 // List execution errors
 var (
@@ -34,7 +30,7 @@ var (
 	ErrInsufficientBalance               = errors.New("insufficient balance for transfer")
 	ErrContractAddressCollision          = errors.New("contract address collision")
 	ErrTotalTimeLimitReached             = errors.New("reached the total execution time limit for txs in a block")
-	ErrOpcodeComputationCostLimitReached = errors.New(fmt.Sprintf("reached the opcode computation cost limit (%d) for tx", params.OpcodeComputationCostLimit))
+	ErrOpcodeComputationCostLimitReached = errors.New("reached the opcode computation cost limit for tx")
 	ErrFailedOnSetCode                   = errors.New("failed on setting code to an account")
 
 	// EVM internal errors
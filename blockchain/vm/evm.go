@@ -54,15 +54,15 @@ type (
 // isProgramAccount returns true if the address is one of the following:
 // - an address of precompiled contracts
 // - an address of program accounts
-func isProgramAccount(addr common.Address, db StateDB) bool {
-	_, exists := PrecompiledContractsCypress[addr]
+func isProgramAccount(evm *EVM, addr common.Address, db StateDB) bool {
+	_, exists := evm.precompiledContracts()[addr]
 	return exists || db.IsProgramAccount(addr)
 }
 
 // run runs the given contract and takes care of running precompiles with a fallback to the byte code interpreter.
 func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 	if contract.CodeAddr != nil {
-		precompiles := PrecompiledContractsCypress
+		precompiles := evm.precompiledContracts()
 		if p := precompiles[*contract.CodeAddr]; p != nil {
 			var (
 				ret             []byte
@@ -76,12 +76,12 @@ func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 			//	startTime = time.Now()
 			//}
 			///////////////////////////////////////////////////////
-			switch *contract.CodeAddr {
-			case vmLogAddress:
+			switch p.(type) {
+			case *vmLog:
 				ret, computationCost, err = RunVMLogContract(p, input, contract, evm)
-			case feePayerAddress:
+			case *feePayer:
 				ret, computationCost, err = RunFeePayerContract(p, input, contract)
-			case validateSenderAddress:
+			case *precompiledValidateSender:
 				ret, computationCost, err = RunValidateSenderContract(p, input, contract, evm.StateDB)
 			default:
 				ret, computationCost, err = RunPrecompiledContract(p, input, contract) // TODO-Klaytn-Issue615
@@ -124,6 +124,7 @@ type Context struct {
 	BlockNumber *big.Int       // Provides information for NUMBER
 	Time        *big.Int       // Provides information for TIME
 	BlockScore  *big.Int       // Provides information for DIFFICULTY
+	BaseFee     *big.Int       // Base fee of the block, nil before the dynamic fee fork
 }
 
 // EVM is the Ethereum Virtual Machine base object and provides
@@ -190,6 +191,11 @@ func NewEVM(ctx Context, statedb StateDB, chainConfig *params.ChainConfig, vmCon
 	return evm
 }
 
+// precompiledContracts returns the precompiled contracts enabled by the chain rules.
+func (evm *EVM) precompiledContracts() map[common.Address]PrecompiledContract {
+	return PrecompiledContracts(evm.chainRules)
+}
+
 // Cancel cancels any running EVM operation. This may be called concurrently and
 // it's safe to be called multiple times.
 func (evm *EVM) Cancel(reason int32) {
@@ -227,7 +233,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 
 	// Filter out invalid precompiled address calls, and create a precompiled contract object if it is not exist.
 	if common.IsPrecompiledContractAddress(addr) {
-		precompiles := PrecompiledContractsCypress
+		precompiles := evm.precompiledContracts()
 		if precompiles[addr] == nil || value.Sign() != 0 {
 			// Return an error if an enabled precompiled address is called or a value is transferred to a precompiled address.
 			if evm.vmConfig.Debug && evm.depth == 0 {
@@ -258,7 +264,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 	}
 	evm.Transfer(evm.StateDB, caller.Address(), to.Address(), value)
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr, evm.StateDB) {
 		return ret, gas, nil
 	}
 
@@ -312,7 +318,7 @@ func (evm *EVM) CallCode(caller types.ContractRef, addr common.Address, input []
 		return nil, gas, ErrInsufficientBalance // TODO-Klaytn-Issue615
 	}
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr, evm.StateDB) {
 		logger.Info("Returning since the addr is not a program account", "addr", addr)
 		return nil, gas, nil
 	}
@@ -350,7 +356,7 @@ func (evm *EVM) DelegateCall(caller types.ContractRef, addr common.Address, inpu
 		return nil, gas, ErrDepth // TODO-Klaytn-Issue615
 	}
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr, evm.StateDB) {
 		logger.Info("Returning since the addr is not a program account", "addr", addr)
 		return nil, gas, nil
 	}
@@ -394,7 +400,7 @@ func (evm *EVM) StaticCall(caller types.ContractRef, addr common.Address, input
 		defer func() { evm.interpreter.readOnly = false }()
 	}
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr, evm.StateDB) {
 		logger.Info("Returning since the addr is not a program account", "addr", addr)
 		return nil, gas, nil
 	}
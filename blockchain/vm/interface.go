@@ -38,6 +38,8 @@ type StateDB interface {
 	SubBalance(common.Address, *big.Int)
 	AddBalance(common.Address, *big.Int)
 	GetBalance(common.Address) *big.Int
+	// AddFee adds the transaction fee to the balance of the given account.
+	AddFee(common.Address, *big.Int)
 
 	GetNonce(common.Address) uint64
 	IncNonce(common.Address)
@@ -49,8 +51,10 @@ type StateDB interface {
 	GetCodeSize(common.Address) int
 
 	AddRefund(uint64)
+	SubRefund(uint64)
 	GetRefund() uint64
 
+	GetCommittedState(common.Address, common.Hash) common.Hash
 	GetState(common.Address, common.Hash) common.Hash
 	SetState(common.Address, common.Hash, common.Hash)
 
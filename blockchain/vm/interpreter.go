@@ -52,8 +52,16 @@ type Config struct {
 	// UseOpcodeComputationCost is to enable applying the opcode computation cost limit.
 	UseOpcodeComputationCost bool
 
+	// ComputationCostLimit is the opcode computation cost limit of a transaction.
+	// Zero means params.OpcodeComputationCostLimit.
+	ComputationCostLimit uint64
+
 	// Enables collecting internal transaction data during processing a block
 	EnableInternalTxTracing bool
+
+	// ComputationCostHook is called with the opcode computation cost used by
+	// a transaction when the transaction is applied successfully.
+	ComputationCostHook func(cost uint64)
 }
 
 // keccakState wraps sha3.state. In addition to the usual hash methods, it also supports
@@ -80,6 +88,8 @@ type Interpreter struct {
 
 	readOnly   bool   // Whether to throw on stateful modifications
 	returnData []byte // Last CALL's return data for subsequent reuse
+
+	computationCostLimit uint64 // Opcode computation cost limit of a transaction
 }
 
 // NewInterpreter returns a new instance of the Interpreter.
@@ -88,13 +98,24 @@ func NewInterpreter(evm *EVM, cfg *Config) *Interpreter {
 	// the jump table was initialised. If it was not
 	// we'll set the default jump table.
 	if !cfg.JumpTable[STOP].valid {
-		cfg.JumpTable = ConstantinopleInstructionSet
+		switch {
+		case evm.chainRules.IsIstanbul:
+			cfg.JumpTable = IstanbulInstructionSet
+		default:
+			cfg.JumpTable = ConstantinopleInstructionSet
+		}
+	}
+
+	computationCostLimit := cfg.ComputationCostLimit
+	if computationCostLimit == 0 {
+		computationCostLimit = params.OpcodeComputationCostLimit
 	}
 
 	return &Interpreter{
-		evm:      evm,
-		cfg:      cfg,
-		gasTable: evm.ChainConfig().GasTable(evm.BlockNumber),
+		evm:                  evm,
+		cfg:                  cfg,
+		gasTable:             evm.ChainConfig().GasTable(evm.BlockNumber),
+		computationCostLimit: computationCostLimit,
 	}
 }
 
@@ -256,7 +277,7 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 			//globalTimer = time.Now()
 			///////////////////////////////////////////////////////
 			in.evm.opcodeComputationCostSum += operation.computationCost
-			if in.evm.opcodeComputationCostSum > params.OpcodeComputationCostLimit {
+			if in.evm.opcodeComputationCostSum > in.computationCostLimit {
 				return nil, ErrOpcodeComputationCostLimitReached
 			}
 		}
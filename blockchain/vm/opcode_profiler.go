@@ -0,0 +1,330 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+// OpcodeProfiler is a tracer aggregating the gas and the computation cost spent
+// by each contract, each program counter of a contract and each opcode.
+//
+// The gas of a step is measured by the difference of the remaining gas between the
+// step and the next step at the same call depth, so that memory expansion and the
+// gas consumed by precompiled contracts are included. The gas spent by inner calls
+// is excluded from the calling step and is attributed to the steps of the callee.
+// The computation cost of a step is the computation cost of the opcode defined in
+// the jump table, which is limited by params.OpcodeComputationCostLimit.
+type OpcodeProfiler struct {
+	profile *OpcodeProfile
+	frames  []*profilerFrame
+
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// profilerFrame keeps the step waiting for its gas to be measured in a call depth.
+type profilerFrame struct {
+	pending *profilerStep
+	total   uint64 // Gas attributed to the steps of this frame and its inner frames
+}
+
+type profilerStep struct {
+	contract        common.Address
+	pc              uint64
+	op              OpCode
+	gas             uint64 // Remaining gas before the step
+	cost            uint64 // Static and dynamic gas cost of the step
+	computationCost uint64
+	innerGas        uint64 // Gas attributed to the inner call frames of the step
+}
+
+// NewOpcodeProfiler returns a new OpcodeProfiler.
+func NewOpcodeProfiler() *OpcodeProfiler {
+	return &OpcodeProfiler{profile: NewOpcodeProfile()}
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (p *OpcodeProfiler) Stop(err error) {
+	p.reason = err
+	atomic.StoreUint32(&p.interrupt, 1)
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (p *OpcodeProfiler) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	p.profile.Txs++
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (p *OpcodeProfiler) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	if atomic.LoadUint32(&p.interrupt) > 0 {
+		env.Cancel(CancelByCtxDone)
+		return nil
+	}
+	// Finish the frames of the calls which have returned
+	for len(p.frames) > depth {
+		p.popFrame()
+	}
+	for len(p.frames) < depth {
+		p.frames = append(p.frames, &profilerFrame{})
+	}
+	frame := p.frames[depth-1]
+	if frame.pending != nil {
+		used := frame.pending.cost
+		if spent := frame.pending.gas - gas; frame.pending.gas >= gas && spent >= frame.pending.innerGas {
+			used = spent - frame.pending.innerGas
+		}
+		p.record(frame, used)
+	}
+	if err != nil {
+		// The step failed before being executed, so nothing more is spent at this depth.
+		return nil
+	}
+	operation := env.interpreter.cfg.JumpTable[op]
+	codeAddr := contract.Address()
+	if contract.CodeAddr != nil {
+		codeAddr = *contract.CodeAddr
+	}
+	frame.pending = &profilerStep{
+		contract:        codeAddr,
+		pc:              pc,
+		op:              op,
+		gas:             gas,
+		cost:            operation.constantGas + cost,
+		computationCost: operation.computationCost,
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (p *OpcodeProfiler) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (p *OpcodeProfiler) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	for len(p.frames) > 0 {
+		p.popFrame()
+	}
+	p.profile.GasUsed += gasUsed
+	return nil
+}
+
+// popFrame records the last step of the innermost frame and passes the gas spent by
+// the frame to the step of the outer frame which made the call.
+func (p *OpcodeProfiler) popFrame() {
+	frame := p.frames[len(p.frames)-1]
+	if frame.pending != nil {
+		p.record(frame, frame.pending.cost)
+	}
+	p.frames = p.frames[:len(p.frames)-1]
+	if len(p.frames) > 0 {
+		outer := p.frames[len(p.frames)-1]
+		if outer.pending != nil {
+			outer.pending.innerGas += frame.total
+		}
+		outer.total += frame.total
+	}
+}
+
+func (p *OpcodeProfiler) record(frame *profilerFrame, gas uint64) {
+	step := frame.pending
+	frame.pending = nil
+	frame.total += gas
+	p.profile.add(step.contract, step.pc, step.op, gas, step.computationCost)
+}
+
+// GetResult returns the profile aggregated so far.
+func (p *OpcodeProfiler) GetResult() (*OpcodeProfile, error) {
+	if atomic.LoadUint32(&p.interrupt) > 0 {
+		return nil, p.reason
+	}
+	return p.profile, nil
+}
+
+// OpcodeStat is the aggregated cost of executions of an opcode or a program counter.
+type OpcodeStat struct {
+	Count           uint64 `json:"count"`
+	Gas             uint64 `json:"gas"`
+	ComputationCost uint64 `json:"computationCost"`
+}
+
+func (s *OpcodeStat) add(count, gas, computationCost uint64) {
+	s.Count += count
+	s.Gas += gas
+	s.ComputationCost += computationCost
+}
+
+type pcKey struct {
+	pc uint64
+	op OpCode
+}
+
+type contractProfile struct {
+	OpcodeStat
+	pcs map[pcKey]*OpcodeStat
+}
+
+// OpcodeProfile holds the gas and the computation cost aggregated per contract,
+// per program counter and per opcode. Profiles of several transactions can be
+// merged, and the profile is encoded to JSON sorted by the computation cost.
+type OpcodeProfile struct {
+	Txs       uint64
+	GasUsed   uint64
+	contracts map[common.Address]*contractProfile
+	opcodes   map[OpCode]*OpcodeStat
+}
+
+// NewOpcodeProfile returns an empty OpcodeProfile.
+func NewOpcodeProfile() *OpcodeProfile {
+	return &OpcodeProfile{
+		contracts: make(map[common.Address]*contractProfile),
+		opcodes:   make(map[OpCode]*OpcodeStat),
+	}
+}
+
+func (p *OpcodeProfile) add(contract common.Address, pc uint64, op OpCode, gas, computationCost uint64) {
+	p.addStat(contract, pcKey{pc, op}, OpcodeStat{1, gas, computationCost})
+}
+
+func (p *OpcodeProfile) addStat(contract common.Address, key pcKey, stat OpcodeStat) {
+	cp, ok := p.contracts[contract]
+	if !ok {
+		cp = &contractProfile{pcs: make(map[pcKey]*OpcodeStat)}
+		p.contracts[contract] = cp
+	}
+	cp.add(stat.Count, stat.Gas, stat.ComputationCost)
+
+	pcStat, ok := cp.pcs[key]
+	if !ok {
+		pcStat = &OpcodeStat{}
+		cp.pcs[key] = pcStat
+	}
+	pcStat.add(stat.Count, stat.Gas, stat.ComputationCost)
+
+	opStat, ok := p.opcodes[key.op]
+	if !ok {
+		opStat = &OpcodeStat{}
+		p.opcodes[key.op] = opStat
+	}
+	opStat.add(stat.Count, stat.Gas, stat.ComputationCost)
+}
+
+// Merge adds the given profile to the profile.
+func (p *OpcodeProfile) Merge(other *OpcodeProfile) {
+	p.Txs += other.Txs
+	p.GasUsed += other.GasUsed
+	for addr, cp := range other.contracts {
+		for key, stat := range cp.pcs {
+			p.addStat(addr, key, *stat)
+		}
+	}
+}
+
+// ContractProfile is the profile of a contract in OpcodeProfileResult.
+type ContractProfile struct {
+	Address common.Address `json:"address"`
+	OpcodeStat
+	PCs []*PCProfile `json:"pcs"`
+}
+
+// PCProfile is the profile of a program counter in OpcodeProfileResult.
+type PCProfile struct {
+	PC uint64 `json:"pc"`
+	Op string `json:"op"`
+	OpcodeStat
+}
+
+// OpProfile is the profile of an opcode in OpcodeProfileResult.
+type OpProfile struct {
+	Op string `json:"op"`
+	OpcodeStat
+}
+
+// OpcodeProfileResult is the sorted form of OpcodeProfile returned to users.
+// Contracts, program counters and opcodes are sorted by the computation cost
+// and then by the gas in descending order.
+type OpcodeProfileResult struct {
+	Txs             uint64             `json:"txs"`
+	GasUsed         uint64             `json:"gasUsed"`
+	ComputationCost uint64             `json:"computationCost"`
+	Contracts       []*ContractProfile `json:"contracts"`
+	Opcodes         []*OpProfile       `json:"opcodes"`
+}
+
+func statLess(a, b *OpcodeStat) bool {
+	if a.ComputationCost != b.ComputationCost {
+		return a.ComputationCost > b.ComputationCost
+	}
+	return a.Gas > b.Gas
+}
+
+// Result returns the sorted form of the profile.
+func (p *OpcodeProfile) Result() *OpcodeProfileResult {
+	result := &OpcodeProfileResult{
+		Txs:       p.Txs,
+		GasUsed:   p.GasUsed,
+		Contracts: make([]*ContractProfile, 0, len(p.contracts)),
+		Opcodes:   make([]*OpProfile, 0, len(p.opcodes)),
+	}
+	for addr, cp := range p.contracts {
+		contract := &ContractProfile{Address: addr, OpcodeStat: cp.OpcodeStat, PCs: make([]*PCProfile, 0, len(cp.pcs))}
+		for key, stat := range cp.pcs {
+			contract.PCs = append(contract.PCs, &PCProfile{PC: key.pc, Op: key.op.String(), OpcodeStat: *stat})
+		}
+		sort.Slice(contract.PCs, func(i, j int) bool {
+			a, b := contract.PCs[i], contract.PCs[j]
+			if a.OpcodeStat != b.OpcodeStat {
+				return statLess(&a.OpcodeStat, &b.OpcodeStat)
+			}
+			return a.PC < b.PC
+		})
+		result.Contracts = append(result.Contracts, contract)
+		result.ComputationCost += cp.ComputationCost
+	}
+	sort.Slice(result.Contracts, func(i, j int) bool {
+		a, b := result.Contracts[i], result.Contracts[j]
+		if a.OpcodeStat != b.OpcodeStat {
+			return statLess(&a.OpcodeStat, &b.OpcodeStat)
+		}
+		return a.Address.Hex() < b.Address.Hex()
+	})
+	for op, stat := range p.opcodes {
+		result.Opcodes = append(result.Opcodes, &OpProfile{Op: op.String(), OpcodeStat: *stat})
+	}
+	sort.Slice(result.Opcodes, func(i, j int) bool {
+		a, b := result.Opcodes[i], result.Opcodes[j]
+		if a.OpcodeStat != b.OpcodeStat {
+			return statLess(&a.OpcodeStat, &b.OpcodeStat)
+		}
+		return a.Op < b.Op
+	})
+	return result
+}
+
+// MarshalJSON encodes the sorted form of the profile.
+func (p *OpcodeProfile) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Result())
+}
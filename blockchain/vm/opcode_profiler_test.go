@@ -0,0 +1,63 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpcodeProfile_Merge(t *testing.T) {
+	addr1, addr2 := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+
+	p1 := NewOpcodeProfile()
+	p1.Txs, p1.GasUsed = 1, 100
+	p1.add(addr1, 0, PUSH1, 3, 10)
+	p1.add(addr1, 2, SSTORE, 20000, 500)
+
+	p2 := NewOpcodeProfile()
+	p2.Txs, p2.GasUsed = 1, 50
+	p2.add(addr1, 0, PUSH1, 3, 10)
+	p2.add(addr2, 0, SLOAD, 200, 800)
+
+	p1.Merge(p2)
+	result := p1.Result()
+
+	assert.Equal(t, uint64(2), result.Txs)
+	assert.Equal(t, uint64(150), result.GasUsed)
+	assert.Equal(t, uint64(1320), result.ComputationCost)
+
+	// Contracts, program counters and opcodes are sorted by computation cost.
+	assert.Equal(t, addr2, result.Contracts[0].Address)
+	assert.Equal(t, addr1, result.Contracts[1].Address)
+	assert.Equal(t, OpcodeStat{Count: 3, Gas: 20006, ComputationCost: 520}, result.Contracts[1].OpcodeStat)
+	assert.Equal(t, "SSTORE", result.Contracts[1].PCs[0].Op)
+	assert.Equal(t, &PCProfile{PC: 0, Op: "PUSH1", OpcodeStat: OpcodeStat{2, 6, 20}}, result.Contracts[1].PCs[1])
+	assert.Equal(t, []*OpProfile{
+		{"SLOAD", OpcodeStat{1, 200, 800}},
+		{"SSTORE", OpcodeStat{1, 20000, 500}},
+		{"PUSH1", OpcodeStat{2, 6, 20}},
+	}, result.Opcodes)
+
+	encoded, err := json.Marshal(p1)
+	assert.NoError(t, err)
+	expected, _ := json.Marshal(result)
+	assert.Equal(t, expected, encoded)
+}
@@ -57,7 +57,8 @@ type Config struct {
 func setDefaults(cfg *Config) {
 	if cfg.ChainConfig == nil {
 		cfg.ChainConfig = &params.ChainConfig{
-			ChainID: big.NewInt(1),
+			ChainID:                 big.NewInt(1),
+			IstanbulCompatibleBlock: new(big.Int),
 		}
 	}
 
@@ -171,3 +172,22 @@ func Call(address common.Address, input []byte, cfg *Config) ([]byte, uint64, er
 
 	return ret, leftOverGas, err
 }
+
+// Profile executes the code like Execute with the opcode profiler attached and
+// returns the gas and the computation cost spent per contract, per program
+// counter and per opcode. It is useful for benchmarking contracts locally.
+func Profile(code, input []byte, cfg *Config) ([]byte, *vm.OpcodeProfile, error) {
+	if cfg == nil {
+		cfg = new(Config)
+	}
+	profiler := vm.NewOpcodeProfiler()
+	cfg.EVMConfig.Debug = true
+	cfg.EVMConfig.Tracer = profiler
+
+	ret, _, err := Execute(code, input, cfg)
+	if err != nil {
+		return ret, nil, err
+	}
+	profile, err := profiler.GetResult()
+	return ret, profile, err
+}
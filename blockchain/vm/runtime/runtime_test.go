@@ -195,3 +195,42 @@ func BenchmarkEVM_CREATE2_1200(bench *testing.B) {
 	// initcode size 1200K, repeatedly calls CREATE2 and then modifies the mem contents
 	benchmarkEVM_Create(bench, "5b5862124f80600080f5600152600056")
 }
+
+func TestProfile(t *testing.T) {
+	code := []byte{
+		byte(vm.PUSH1), 1,
+		byte(vm.PUSH1), 2,
+		byte(vm.ADD),
+		byte(vm.PUSH1), 0,
+		byte(vm.MSTORE),
+		byte(vm.PUSH1), 32,
+		byte(vm.PUSH1), 0,
+		byte(vm.RETURN),
+	}
+	ret, profile, err := Profile(code, nil, nil)
+	if err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+	if num := new(big.Int).SetBytes(ret); num.Cmp(big.NewInt(3)) != 0 {
+		t.Fatal("Expected 3, got", num)
+	}
+
+	result := profile.Result()
+	if len(result.Contracts) != 1 {
+		t.Fatalf("expected 1 contract, got %d", len(result.Contracts))
+	}
+	if len(result.Contracts[0].PCs) != 8 {
+		t.Fatalf("expected 8 program counters, got %d", len(result.Contracts[0].PCs))
+	}
+	if result.GasUsed != result.Contracts[0].Gas {
+		t.Errorf("expected the contract gas %d to be the gas used %d", result.Contracts[0].Gas, result.GasUsed)
+	}
+	if result.ComputationCost == 0 {
+		t.Error("expected non-zero computation cost")
+	}
+	for i := 1; i < len(result.Opcodes); i++ {
+		if result.Opcodes[i-1].ComputationCost < result.Opcodes[i].ComputationCost {
+			t.Errorf("opcodes are not sorted by computation cost: %v", result.Opcodes)
+		}
+	}
+}
@@ -145,6 +145,30 @@ func Istanbul(config *params.IstanbulConfig) Option {
 	}
 }
 
+func IstanbulCompatibleBlock(num *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Config.IstanbulCompatibleBlock = num
+	}
+}
+
+func DynamicFeeCompatibleBlock(num *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Config.DynamicFeeCompatibleBlock = num
+	}
+}
+
+func BLSCompatibleBlock(num *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Config.BLSCompatibleBlock = num
+	}
+}
+
+func ScheduledGovernanceCompatibleBlock(num *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Config.ScheduledGovernanceCompatibleBlock = num
+	}
+}
+
 func DeriveShaImpl(impl int) Option {
 	return func(genesis *blockchain.Genesis) {
 		genesis.Config.DeriveShaImpl = impl
@@ -124,6 +124,10 @@ Args :
 			istSubGroupFlag,
 			cliqueEpochFlag,
 			cliquePeriodFlag,
+			istanbulCompatibleBlockNumberFlag,
+			dynamicFeeCompatibleBlockNumberFlag,
+			blsCompatibleBlockNumberFlag,
+			scheduledGovernanceCompatibleBlockNumberFlag,
 		},
 		ArgsUsage: "type",
 	}
@@ -228,6 +232,24 @@ func genCliqueConfig(ctx *cli.Context) *params.CliqueConfig {
 	}
 }
 
+// genForkOptions returns the options scheduling the hard forks given by the flags.
+func genForkOptions(ctx *cli.Context) []genesis.Option {
+	var options []genesis.Option
+	if num := ctx.Int64(istanbulCompatibleBlockNumberFlag.Name); num >= 0 {
+		options = append(options, genesis.IstanbulCompatibleBlock(big.NewInt(num)))
+	}
+	if num := ctx.Int64(dynamicFeeCompatibleBlockNumberFlag.Name); num >= 0 {
+		options = append(options, genesis.DynamicFeeCompatibleBlock(big.NewInt(num)))
+	}
+	if num := ctx.Int64(blsCompatibleBlockNumberFlag.Name); num >= 0 {
+		options = append(options, genesis.BLSCompatibleBlock(big.NewInt(num)))
+	}
+	if num := ctx.Int64(scheduledGovernanceCompatibleBlockNumberFlag.Name); num >= 0 {
+		options = append(options, genesis.ScheduledGovernanceCompatibleBlock(big.NewInt(num)))
+	}
+	return options
+}
+
 func genIstanbulGenesis(ctx *cli.Context, nodeAddrs, testAddrs []common.Address, chainId uint64) *blockchain.Genesis {
 	unitPrice := ctx.Uint64(unitPriceFlag.Name)
 	chainID := new(big.Int).SetUint64(chainId)
@@ -250,6 +272,7 @@ func genIstanbulGenesis(ctx *cli.Context, nodeAddrs, testAddrs []common.Address,
 		options = append(options, genesis.Governance(config))
 	}
 	options = append(options, genesis.Istanbul(genIstanbulConfig(ctx)))
+	options = append(options, genForkOptions(ctx)...)
 
 	return genesis.New(options...)
 }
@@ -263,13 +286,16 @@ func genCliqueGenesis(ctx *cli.Context, nodeAddrs, testAddrs []common.Address, c
 		log.Fatalf("Currently, governance is not supported for clique consensus", "--governance", ok)
 	}
 
-	genesisJson := genesis.NewClique(
+	options := []genesis.Option{
 		genesis.ValidatorsOfClique(nodeAddrs...),
 		genesis.Alloc(append(nodeAddrs, testAddrs...), new(big.Int).Exp(big.NewInt(10), big.NewInt(50), nil)),
 		genesis.UnitPrice(unitPrice),
 		genesis.ChainID(chainID),
 		genesis.Clique(config),
-	)
+	}
+	options = append(options, genForkOptions(ctx)...)
+
+	genesisJson := genesis.NewClique(options...)
 	return genesisJson
 }
 
@@ -323,4 +323,28 @@ var (
 		Usage: "clique period",
 		Value: params.DefaultPeriod,
 	}
+
+	istanbulCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "istanbul-compatible-blocknumber",
+		Usage: "istanbulCompatible blockNumber (negative value disables the fork)",
+		Value: 0,
+	}
+
+	dynamicFeeCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "dynamic-fee-compatible-blocknumber",
+		Usage: "dynamicFeeCompatible blockNumber (negative value disables the fork)",
+		Value: -1,
+	}
+
+	blsCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "bls-compatible-blocknumber",
+		Usage: "blsCompatible blockNumber (negative value disables the fork)",
+		Value: -1,
+	}
+
+	scheduledGovernanceCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "scheduled-governance-compatible-blocknumber",
+		Usage: "scheduledGovernanceCompatible blockNumber (negative value disables the fork)",
+		Value: -1,
+	}
 )
@@ -46,6 +46,8 @@ var FlagGroups = []FlagGroup{
 			LightKDFFlag,
 			SrvTypeFlag,
 			ExtraDataFlag,
+			TxOrderingPolicyFlag,
+			TxOrderingSenderCapFlag,
 			ConfigFileFlag,
 			OverwriteGenesisFlag,
 		},
@@ -64,6 +66,8 @@ var FlagGroups = []FlagGroup{
 			TxPoolAllowLocalAnchorTxFlag,
 			TxPoolJournalFlag,
 			TxPoolJournalIntervalFlag,
+			TxPoolSnapshotFlag,
+			TxPoolSnapshotIntervalFlag,
 			TxPoolPriceLimitFlag,
 			TxPoolPriceBumpFlag,
 			TxPoolExecSlotsAccountFlag,
@@ -72,6 +76,7 @@ var FlagGroups = []FlagGroup{
 			TxPoolNonExecSlotsAllFlag,
 			TxPoolLifetimeFlag,
 			TxPoolKeepLocalsFlag,
+			TxPoolPriorityClassesFlag,
 			TxResendIntervalFlag,
 			TxResendCountFlag,
 			TxResendUseLegacyFlag,
@@ -92,6 +97,7 @@ var FlagGroups = []FlagGroup{
 			DynamoDBWriteCapacityFlag,
 			NoParallelDBWriteFlag,
 			SenderTxHashIndexingFlag,
+			RevertReasonIndexingFlag,
 		},
 	},
 	{
@@ -198,6 +204,18 @@ var FlagGroups = []FlagGroup{
 			VMEnableDebugFlag,
 			VMLogTargetFlag,
 			VMTraceInternalTxFlag,
+			VMSlowTxThresholdFlag,
+			VMParallelTxExecutionFlag,
+		},
+	},
+	{
+		Name: "TRACE CACHE",
+		Flags: []cli.Flag{
+			TraceCacheFlag,
+			TraceCacheSizeFlag,
+			TraceCacheMaxBlockAgeFlag,
+			TraceCachePrefetchFlag,
+			TraceCachePrefetchTracerFlag,
 		},
 	},
 	{
@@ -52,6 +52,7 @@ import (
 	"github.com/klaytn/klaytn/node/sc"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
+	"github.com/klaytn/klaytn/work"
 	"gopkg.in/urfave/cli.v1"
 )
 
@@ -154,6 +155,16 @@ var (
 		Usage: "Time interval to regenerate the local transaction journal",
 		Value: blockchain.DefaultTxPoolConfig.JournalInterval,
 	}
+	TxPoolSnapshotFlag = cli.StringFlag{
+		Name:  "txpool.snapshot",
+		Usage: "Disk snapshot of all transactions in the pool to survive node restarts (disabled if empty)",
+		Value: blockchain.DefaultTxPoolConfig.Snapshot,
+	}
+	TxPoolSnapshotIntervalFlag = cli.DurationFlag{
+		Name:  "txpool.snapshot-interval",
+		Usage: "Time interval to write the snapshot of all transactions in the pool",
+		Value: blockchain.DefaultTxPoolConfig.SnapshotInterval,
+	}
 	TxPoolPriceLimitFlag = cli.Uint64Flag{
 		Name:  "txpool.pricelimit",
 		Usage: "Minimum gas price limit to enforce for acceptance into the pool",
@@ -193,6 +204,10 @@ var (
 		Usage: "Maximum amount of time non-executable transaction are queued",
 		Value: cn.GetDefaultConfig().TxPool.Lifetime,
 	}
+	TxPoolPriorityClassesFlag = cli.StringFlag{
+		Name:  "txpool.priority-classes",
+		Usage: "JSON file of the priority classes of transactions which have reserved slots and are executed first",
+	}
 	// Performance tuning settings
 	StateDBCachingFlag = cli.BoolFlag{
 		Name:  "statedb.use-cache",
@@ -316,6 +331,10 @@ var (
 		Name:  "sendertxhashindexing",
 		Usage: "Enables storing mapping information of senderTxHash to txHash",
 	}
+	RevertReasonIndexingFlag = cli.BoolFlag{
+		Name:  "revertreasonindexing",
+		Usage: "Enables storing revert reasons of reverted transactions",
+	}
 	ChildChainIndexingFlag = cli.BoolFlag{
 		Name:  "childchainindexing",
 		Usage: "Enables storing transaction hash of child chain transaction for fast access to child chain data",
@@ -339,6 +358,16 @@ var (
 		Name:  "extradata",
 		Usage: "Block extra data set by the work (default = client version)",
 	}
+	TxOrderingPolicyFlag = cli.StringFlag{
+		Name:  "txordering.policy",
+		Usage: "Order of the transactions in a new block (price, fifo, roundrobin)",
+		Value: string(work.DefaultTxOrderingConfig.Policy),
+	}
+	TxOrderingSenderCapFlag = cli.IntFlag{
+		Name:  "txordering.sender-cap",
+		Usage: "Maximum number of transactions of a sender in a new block (0 = unlimited)",
+		Value: work.DefaultTxOrderingConfig.SenderCap,
+	}
 
 	TxResendIntervalFlag = cli.Uint64Flag{
 		Name:  "txresend.interval",
@@ -380,6 +409,38 @@ var (
 		Name:  "vm.internaltx",
 		Usage: "Collect internal transaction data while processing a block",
 	}
+	VMSlowTxThresholdFlag = cli.DurationFlag{
+		Name:  "vm.slowtx-threshold",
+		Usage: "Execution time over which a transaction is logged as slow while building or importing a block (0 = disabled)",
+		Value: 0,
+	}
+	VMParallelTxExecutionFlag = cli.BoolFlag{
+		Name:  "vm.parallel",
+		Usage: "Apply the transactions of an imported block in parallel, re-applying the conflicting ones in order",
+	}
+	TraceCacheFlag = cli.BoolFlag{
+		Name:  "tracecache",
+		Usage: "Cache trace results of canonical blocks on disk",
+	}
+	TraceCacheSizeFlag = cli.IntFlag{
+		Name:  "tracecache.size",
+		Usage: "Maximum size of the trace cache in MB (0 = unlimited)",
+		Value: 1024,
+	}
+	TraceCacheMaxBlockAgeFlag = cli.Uint64Flag{
+		Name:  "tracecache.maxage",
+		Usage: "Number of blocks behind the head to keep cached trace results (0 = unlimited)",
+		Value: 0,
+	}
+	TraceCachePrefetchFlag = cli.BoolFlag{
+		Name:  "tracecache.prefetch",
+		Usage: "Trace transactions of every new canonical block in the background and cache the results",
+	}
+	TraceCachePrefetchTracerFlag = cli.StringFlag{
+		Name:  "tracecache.prefetch.tracer",
+		Usage: "Tracer used to prefetch trace results",
+		Value: "fastCallTracer",
+	}
 
 	// Logging and debug settings
 	MetricsEnabledFlag = cli.BoolFlag{
@@ -1261,6 +1322,12 @@ func setTxPool(ctx *cli.Context, cfg *blockchain.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolJournalIntervalFlag.Name) {
 		cfg.JournalInterval = ctx.GlobalDuration(TxPoolJournalIntervalFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolSnapshotFlag.Name) {
+		cfg.Snapshot = ctx.GlobalString(TxPoolSnapshotFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolSnapshotIntervalFlag.Name) {
+		cfg.SnapshotInterval = ctx.GlobalDuration(TxPoolSnapshotIntervalFlag.Name)
+	}
 	if ctx.GlobalIsSet(TxPoolPriceLimitFlag.Name) {
 		cfg.PriceLimit = ctx.GlobalUint64(TxPoolPriceLimitFlag.Name)
 	}
@@ -1285,6 +1352,13 @@ func setTxPool(ctx *cli.Context, cfg *blockchain.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.GlobalDuration(TxPoolLifetimeFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolPriorityClassesFlag.Name) {
+		classes, err := blockchain.ReadTxPriorityClasses(ctx.GlobalString(TxPoolPriorityClassesFlag.Name))
+		if err != nil {
+			log.Fatalf("Option %q: %v", TxPoolPriorityClassesFlag.Name, err)
+		}
+		cfg.PriorityClasses = classes
+	}
 }
 
 // checkExclusive verifies that only a single instance of the provided flags was
@@ -1410,8 +1484,18 @@ func SetKlayConfig(ctx *cli.Context, stack *node.Node, cfg *cn.Config) {
 	if ctx.GlobalIsSet(ExtraDataFlag.Name) {
 		cfg.ExtraData = []byte(ctx.GlobalString(ExtraDataFlag.Name))
 	}
+	if ctx.GlobalIsSet(TxOrderingPolicyFlag.Name) {
+		cfg.TxOrdering.Policy = work.TxOrderingPolicy(ctx.GlobalString(TxOrderingPolicyFlag.Name))
+	}
+	if ctx.GlobalIsSet(TxOrderingSenderCapFlag.Name) {
+		cfg.TxOrdering.SenderCap = ctx.GlobalInt(TxOrderingSenderCapFlag.Name)
+	}
+	if err := cfg.TxOrdering.Validate(); err != nil {
+		log.Fatalf("Option %q or %q: %v", TxOrderingPolicyFlag.Name, TxOrderingSenderCapFlag.Name, err)
+	}
 
 	cfg.SenderTxHashIndexing = ctx.GlobalIsSet(SenderTxHashIndexingFlag.Name)
+	cfg.RevertReasonIndexing = ctx.GlobalIsSet(RevertReasonIndexingFlag.Name)
 	cfg.ParallelDBWrite = !ctx.GlobalIsSet(NoParallelDBWriteFlag.Name)
 	cfg.StateDBCaching = ctx.GlobalIsSet(StateDBCachingFlag.Name)
 	cfg.TrieNodeCacheConfig = statedb.TrieNodeCacheConfig{
@@ -1432,6 +1516,16 @@ func SetKlayConfig(ctx *cli.Context, stack *node.Node, cfg *cn.Config) {
 		}
 	}
 	cfg.EnableInternalTxTracing = ctx.GlobalIsSet(VMTraceInternalTxFlag.Name)
+	cfg.SlowTxThreshold = ctx.GlobalDuration(VMSlowTxThresholdFlag.Name)
+	cfg.ParallelTxExecution = ctx.GlobalBool(VMParallelTxExecutionFlag.Name)
+
+	cfg.TraceCache = cn.TraceCacheConfig{
+		Enabled:        ctx.GlobalIsSet(TraceCacheFlag.Name),
+		SizeMB:         ctx.GlobalInt(TraceCacheSizeFlag.Name),
+		MaxBlockAge:    ctx.GlobalUint64(TraceCacheMaxBlockAgeFlag.Name),
+		Prefetch:       ctx.GlobalIsSet(TraceCachePrefetchFlag.Name),
+		PrefetchTracer: ctx.GlobalString(TraceCachePrefetchTracerFlag.Name),
+	}
 
 	cfg.AutoRestartFlag = ctx.GlobalBool(AutoRestartFlag.Name)
 	cfg.RestartTimeOutFlag = ctx.GlobalDuration(RestartTimeOutFlag.Name)
@@ -195,6 +195,28 @@ func getGovernanceItemsFromGenesis(genesis *blockchain.Genesis) governance.Gover
 		if err := g.SetValue(params.ProposerRefreshInterval, governance.Reward.ProposerUpdateInterval); err != nil {
 			writeFailLog(params.ProposerRefreshInterval, err)
 		}
+		if governance.Reward.StakingReward {
+			if err := g.SetValue(params.StakingReward, governance.Reward.StakingReward); err != nil {
+				writeFailLog(params.StakingReward, err)
+			}
+		}
+		if dynamicFee := governance.DynamicFee; dynamicFee != nil {
+			if err := g.SetValue(params.LowerBoundBaseFee, dynamicFee.LowerBoundBaseFee); err != nil {
+				writeFailLog(params.LowerBoundBaseFee, err)
+			}
+			if err := g.SetValue(params.UpperBoundBaseFee, dynamicFee.UpperBoundBaseFee); err != nil {
+				writeFailLog(params.UpperBoundBaseFee, err)
+			}
+			if err := g.SetValue(params.GasTarget, dynamicFee.GasTarget); err != nil {
+				writeFailLog(params.GasTarget, err)
+			}
+			if err := g.SetValue(params.BaseFeeDenominator, dynamicFee.BaseFeeDenominator); err != nil {
+				writeFailLog(params.BaseFeeDenominator, err)
+			}
+			if err := g.SetValue(params.BurnRatio, dynamicFee.BurnRatio); err != nil {
+				writeFailLog(params.BurnRatio, err)
+			}
+		}
 	}
 
 	if genesis.Config.Istanbul != nil {
@@ -41,6 +41,8 @@ var CommonNodeFlags = []cli.Flag{
 	utils.TxPoolAllowLocalAnchorTxFlag,
 	utils.TxPoolJournalFlag,
 	utils.TxPoolJournalIntervalFlag,
+	utils.TxPoolSnapshotFlag,
+	utils.TxPoolSnapshotIntervalFlag,
 	utils.TxPoolPriceLimitFlag,
 	utils.TxPoolPriceBumpFlag,
 	utils.TxPoolExecSlotsAccountFlag,
@@ -49,6 +51,7 @@ var CommonNodeFlags = []cli.Flag{
 	utils.TxPoolNonExecSlotsAllFlag,
 	utils.TxPoolLifetimeFlag,
 	utils.TxPoolKeepLocalsFlag,
+	utils.TxPoolPriorityClassesFlag,
 	utils.SyncModeFlag,
 	utils.GCModeFlag,
 	utils.LightKDFFlag,
@@ -66,6 +69,7 @@ var CommonNodeFlags = []cli.Flag{
 	utils.LevelDBCacheSizeFlag,
 	utils.NoParallelDBWriteFlag,
 	utils.SenderTxHashIndexingFlag,
+	utils.RevertReasonIndexingFlag,
 	utils.TrieMemoryCacheSizeFlag,
 	utils.TrieBlockIntervalFlag,
 	utils.TriesInMemoryFlag,
@@ -96,6 +100,13 @@ var CommonNodeFlags = []cli.Flag{
 	utils.VMEnableDebugFlag,
 	utils.VMLogTargetFlag,
 	utils.VMTraceInternalTxFlag,
+	utils.VMSlowTxThresholdFlag,
+	utils.VMParallelTxExecutionFlag,
+	utils.TraceCacheFlag,
+	utils.TraceCacheSizeFlag,
+	utils.TraceCacheMaxBlockAgeFlag,
+	utils.TraceCachePrefetchFlag,
+	utils.TraceCachePrefetchTracerFlag,
 	utils.NetworkIdFlag,
 	utils.RPCCORSDomainFlag,
 	utils.RPCVirtualHostsFlag,
@@ -130,6 +141,8 @@ var CommonRPCFlags = []cli.Flag{
 
 var KCNFlags = []cli.Flag{
 	utils.RewardbaseFlag,
+	utils.TxOrderingPolicyFlag,
+	utils.TxOrderingSenderCapFlag,
 	utils.CypressFlag,
 	utils.BaobabFlag,
 }
@@ -190,6 +203,8 @@ var KENFlags = []cli.Flag{
 
 var KSCNFlags = []cli.Flag{
 	utils.ServiceChainSignerFlag,
+	utils.TxOrderingPolicyFlag,
+	utils.TxOrderingSenderCapFlag,
 	utils.AnchoringPeriodFlag,
 	utils.SentChainTxsLimit,
 	utils.MainBridgeFlag,
@@ -49,7 +49,8 @@ type Backend interface {
 
 	// Commit delivers an approved proposal to backend.
 	// The delivered proposal will be put into blockchain.
-	Commit(proposal Proposal, seals [][]byte) error
+	// The committers are the addresses of the validators who signed the seals in the same order.
+	Commit(proposal Proposal, seals [][]byte, committers []common.Address) error
 
 	// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
 	// the time difference of the proposal and current time is also returned.
@@ -58,6 +59,10 @@ type Backend interface {
 	// Sign signs input data with the backend's private key
 	Sign([]byte) ([]byte, error)
 
+	// SignCommittedSeal signs the committed seal of the proposal with the backend's private key,
+	// or with the backend's BLS key if it is registered before the proposal.
+	SignCommittedSeal(proposal Proposal) ([]byte, error)
+
 	// CheckSignature verifies the signature by checking if it's signed by
 	// the given validator
 	CheckSignature(data []byte, addr common.Address, sig []byte) error
@@ -30,6 +30,7 @@ import (
 	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/consensus/istanbul"
 	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/reward"
 	"math/big"
 	"reflect"
 )
@@ -128,6 +129,57 @@ func (api *API) Discard(address common.Address) {
 	delete(api.istanbul.candidates, address)
 }
 
+// GetParticipation returns how the given validator took part in making the blocks from fromBlock to toBlock:
+// the committed seals it signed or missed as a committee member, and the blocks it proposed or missed in its turn.
+func (api *API) GetParticipation(address common.Address, fromBlock rpc.BlockNumber, toBlock rpc.BlockNumber) (*ParticipationSummary, error) {
+	current := api.chain.CurrentHeader().Number.Int64()
+	if fromBlock == rpc.LatestBlockNumber {
+		fromBlock = rpc.BlockNumber(current)
+	}
+	if toBlock == rpc.LatestBlockNumber {
+		toBlock = rpc.BlockNumber(current)
+	}
+
+	s, e := fromBlock.Int64(), toBlock.Int64()
+	if s < 0 || e < 0 {
+		return nil, errPendingNotAllowed
+	}
+	if e > current {
+		return nil, errEndLargetThanLatest
+	}
+	if s > e {
+		return nil, errStartLargerThanEnd
+	}
+	if e-s >= maxParticipationRange {
+		return nil, errParticipationRangeLarge
+	}
+
+	summary := &ParticipationSummary{
+		Address:              address,
+		FromBlock:            uint64(s),
+		ToBlock:              uint64(e),
+		MissedSealBlocks:     []uint64{},
+		MissedProposalBlocks: []uint64{},
+	}
+	for i := s; i <= e; i++ {
+		// The genesis block has no proposer and committed seals
+		if i == 0 {
+			continue
+		}
+		header := api.chain.GetHeaderByNumber(uint64(i))
+		if header == nil {
+			return nil, errNoBlockExist
+		}
+		p, err := api.istanbul.participation(api.chain, header)
+		if err != nil {
+			logger.Error("Failed to get the participation of the block.", "number", i, "err", err)
+			return nil, errInternalError
+		}
+		summary.add(p)
+	}
+	return summary, nil
+}
+
 // API extended by Klaytn developers
 type APIExtension struct {
 	chain    consensus.ChainReader
@@ -145,6 +197,16 @@ var (
 	errExtractIstanbulExtra    = errors.New("extract Istanbul Extra from block header of the given block number")
 	errNoBlockExist            = errors.New("block with the given block number is not existed")
 	errNoBlockNumber           = errors.New("block number is not assigned")
+	errNoRewardAtGenesis       = errors.New("the genesis block has no block reward")
+	errRewardsRangeTooLarge    = fmt.Errorf("number of requested blocks should not be larger than %d", maxRewardsRange)
+	errParticipationRangeLarge = fmt.Errorf("number of requested blocks should not be larger than %d", maxParticipationRange)
+)
+
+const (
+	// maxRewardsRange is the maximum number of blocks whose rewards are accumulated at once.
+	maxRewardsRange = 10000
+	// maxParticipationRange is the maximum number of blocks whose participations are summarized at once.
+	maxParticipationRange = 1000
 )
 
 // GetCouncil retrieves the list of authorized validators at the specified block.
@@ -425,6 +487,80 @@ func (api *APIExtension) GetBlockWithConsensusInfoByHash(blockHash common.Hash)
 	return api.makeRPCOutput(block, proposer, committee, block.Transactions(), receipts), nil
 }
 
+// GetRewards returns the block reward of the given block, calculated in the same way as it is distributed.
+func (api *APIExtension) GetRewards(number *rpc.BlockNumber) (*reward.RewardSpec, error) {
+	var header *types.Header
+	if number == nil || *number == rpc.LatestBlockNumber {
+		header = api.chain.CurrentHeader()
+	} else if *number == rpc.PendingBlockNumber {
+		logger.Trace("Cannot get rewards of the pending block.", "number", number)
+		return nil, errPendingNotAllowed
+	} else {
+		header = api.chain.GetHeaderByNumber(uint64(number.Int64()))
+	}
+
+	if header == nil {
+		return nil, errNoBlockExist
+	}
+	return api.getRewards(header)
+}
+
+// GetRewardsAccumulated returns the sum of the block rewards from the lower block to the upper block.
+// The reward config of the result is not set as it may differ between the blocks.
+func (api *APIExtension) GetRewardsAccumulated(lower rpc.BlockNumber, upper rpc.BlockNumber) (*reward.RewardSpec, error) {
+	current := api.chain.CurrentHeader().Number.Int64()
+	if lower == rpc.LatestBlockNumber {
+		lower = rpc.BlockNumber(current)
+	}
+	if upper == rpc.LatestBlockNumber {
+		upper = rpc.BlockNumber(current)
+	}
+
+	s, e := lower.Int64(), upper.Int64()
+	if s < 0 || e < 0 {
+		return nil, errPendingNotAllowed
+	}
+	if e > current {
+		return nil, errEndLargetThanLatest
+	}
+	if s > e {
+		return nil, errStartLargerThanEnd
+	}
+	if e-s >= maxRewardsRange {
+		return nil, errRewardsRangeTooLarge
+	}
+
+	accumulated := reward.NewEmptyRewardSpec()
+	for i := s; i <= e; i++ {
+		// The genesis block has no block reward
+		if i == 0 {
+			continue
+		}
+		header := api.chain.GetHeaderByNumber(uint64(i))
+		if header == nil {
+			return nil, errNoBlockExist
+		}
+		spec, err := api.getRewards(header)
+		if err != nil {
+			return nil, err
+		}
+		accumulated.Add(spec)
+	}
+	return accumulated, nil
+}
+
+func (api *APIExtension) getRewards(header *types.Header) (*reward.RewardSpec, error) {
+	if header.Number.Sign() == 0 {
+		return nil, errNoRewardAtGenesis
+	}
+	spec, err := api.istanbul.calcBlockReward(header)
+	if err != nil {
+		logger.Error("Failed to calculate the block reward.", "number", header.Number, "err", err)
+		return nil, errInternalError
+	}
+	return spec, nil
+}
+
 func (api *API) GetTimeout() uint64 {
 	return istanbul.DefaultConfig.Timeout
 }
@@ -31,6 +31,7 @@ import (
 	istanbulCore "github.com/klaytn/klaytn/consensus/istanbul/core"
 	"github.com/klaytn/klaytn/consensus/istanbul/validator"
 	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/crypto/bls"
 	"github.com/klaytn/klaytn/event"
 	"github.com/klaytn/klaytn/governance"
 	"github.com/klaytn/klaytn/log"
@@ -54,11 +55,17 @@ func New(rewardbase common.Address, config *istanbul.Config, privateKey *ecdsa.P
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
+	// the BLS key is derived from the node key, so it doesn't need to be managed separately
+	blsKey := bls.DeriveKey(crypto.FromECDSA(privateKey))
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
 	backend := &backend{
 		config:            config,
 		istanbulEventMux:  new(event.TypeMux),
 		privateKey:        privateKey,
-		address:           crypto.PubkeyToAddress(privateKey.PublicKey),
+		address:           address,
+		blsKey:            blsKey,
+		blsPublicKey:      blsKey.PublicKey().Bytes(),
+		blsProof:          blsKey.ProvePossession(address.Bytes()).Bytes(),
 		logger:            logger.NewWith(),
 		db:                db,
 		commitCh:          make(chan *types.Result, 1),
@@ -84,6 +91,9 @@ type backend struct {
 	istanbulEventMux *event.TypeMux
 	privateKey       *ecdsa.PrivateKey
 	address          common.Address
+	blsKey           *bls.SecretKey
+	blsPublicKey     []byte // the compressed public key of blsKey
+	blsProof         []byte // the proof of possession of blsKey bound to the address
 	core             istanbulCore.Engine
 	logger           log.Logger
 	db               database.DBManager
@@ -274,7 +284,7 @@ func (sb *backend) GossipSubPeer(prevHash common.Hash, valSet istanbul.Validator
 }
 
 // Commit implements istanbul.Backend.Commit
-func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
+func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte, committers []common.Address) error {
 	// Check if the proposal is a valid block
 	block, ok := proposal.(*types.Block)
 	if !ok {
@@ -285,7 +295,12 @@ func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
 	round := sb.currentView.Load().(*istanbul.View).Round.Int64()
 	h = types.SetRoundToHeader(h, round)
 	// Append seals into extra-data
-	err := writeCommittedSeals(h, seals)
+	var err error
+	if sb.chain != nil && sb.chain.Config().IsBLSForkEnabled(h.Number) {
+		err = sb.writeMixedCommittedSeals(h, seals, committers)
+	} else {
+		err = writeCommittedSeals(h, seals)
+	}
 	if err != nil {
 		return err
 	}
@@ -353,6 +368,15 @@ func (sb *backend) Sign(data []byte) ([]byte, error) {
 	return crypto.Sign(hashData, sb.privateKey)
 }
 
+// SignCommittedSeal implements istanbul.Backend.SignCommittedSeal
+func (sb *backend) SignCommittedSeal(proposal istanbul.Proposal) ([]byte, error) {
+	seal := istanbulCore.PrepareCommittedSeal(proposal.Hash())
+	if sb.isBLSKeyRegistered(proposal.Number(), proposal.ParentHash()) {
+		return sb.blsKey.Sign(seal).Bytes(), nil
+	}
+	return sb.Sign(seal)
+}
+
 // CheckSignature implements istanbul.Backend.CheckSignature
 func (sb *backend) CheckSignature(data []byte, address common.Address, sig []byte) error {
 	signer, err := istanbul.GetSignatureAddress(data, sig)
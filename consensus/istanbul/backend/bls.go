@@ -0,0 +1,270 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/hashicorp/golang-lru"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus"
+	istanbulCore "github.com/klaytn/klaytn/consensus/istanbul/core"
+	"github.com/klaytn/klaytn/crypto/bls"
+	"github.com/klaytn/klaytn/ser/rlp"
+)
+
+var (
+	inmemoryBLSPublicKeys  = 256 // Number of recent BLS public keys decoded from snapshots
+	recentBLSPublicKeys, _ = lru.NewARC(inmemoryBLSPublicKeys)
+)
+
+// isBLSKeyRegistered returns whether the BLS public key of the backend is registered before the block
+// of the given number and parent hash, so that its committed seal can be signed with the BLS key.
+func (sb *backend) isBLSKeyRegistered(number *big.Int, parentHash common.Hash) bool {
+	if sb.chain == nil || !sb.chain.Config().IsBLSForkEnabled(number) {
+		return false
+	}
+	snap, err := sb.snapshot(sb.chain, number.Uint64()-1, parentHash, nil)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(snap.BLSPublicKeys[sb.address], sb.blsPublicKey)
+}
+
+// verifyBLSRegistration checks whether the BLS public key registered in the header is a valid key
+// whose possession is proven by the proposer of the header.
+func verifyBLSRegistration(chain consensus.ChainReader, header *types.Header) error {
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return err
+	}
+	if len(extra.BLSPublicKey) == 0 && len(extra.BLSProof) == 0 {
+		return nil
+	}
+	if !chain.Config().IsBLSForkEnabled(header.Number) {
+		return errInvalidBLSRegistration
+	}
+
+	proposer, err := ecrecover(header)
+	if err != nil {
+		return err
+	}
+	pk, err := bls.PublicKeyFromBytes(extra.BLSPublicKey)
+	if err != nil {
+		return errInvalidBLSRegistration
+	}
+	proof, err := bls.SignatureFromBytes(extra.BLSProof)
+	if err != nil {
+		return errInvalidBLSRegistration
+	}
+	// The proof is bound to the proposer, so that the key of a validator can't be registered by another one.
+	if !pk.VerifyPossession(proof, proposer.Bytes()) {
+		return errInvalidBLSRegistration
+	}
+	return nil
+}
+
+// verifyAggregatedSeal checks whether the aggregated seal of the header is signed by all the validators
+// marked in the seal bitmap, and returns them.
+func verifyAggregatedSeal(chain consensus.ChainReader, snap *Snapshot, header *types.Header, extra *types.IstanbulExtra) ([]common.Address, error) {
+	if !chain.Config().IsBLSForkEnabled(header.Number) {
+		return nil, errInvalidAggregatedSeal
+	}
+	signers, err := snap.aggregatedSealSigners(extra.SealBitmap)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, errInvalidAggregatedSeal
+	}
+
+	pks := make([]*bls.PublicKey, len(signers))
+	for i, addr := range signers {
+		if pks[i], err = snap.blsPublicKey(addr); err != nil {
+			return nil, errInvalidAggregatedSeal
+		}
+	}
+	sig, err := bls.SignatureFromBytes(extra.AggregatedSeal)
+	if err != nil {
+		return nil, errInvalidAggregatedSeal
+	}
+	if !bls.FastAggregateVerify(pks, istanbulCore.PrepareCommittedSeal(header.Hash()), sig) {
+		return nil, errInvalidAggregatedSeal
+	}
+	return signers, nil
+}
+
+// writeMixedCommittedSeals writes the extra-data field of a block header with the given committed seals
+// after the BLS fork. The BLS seals are aggregated into one seal with the seal bitmap of their committers,
+// while the ECDSA seals of the validators without registered BLS keys are written as before.
+// A BLS seal which is not signed by the registered key of its committer is dropped.
+func (sb *backend) writeMixedCommittedSeals(h *types.Header, seals [][]byte, committers []common.Address) error {
+	if len(seals) != len(committers) {
+		return errInvalidCommittedSeals
+	}
+	snap, err := sb.snapshot(sb.chain, h.Number.Uint64()-1, h.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+
+	proposalSeal := istanbulCore.PrepareCommittedSeal(h.Hash())
+	var ecdsaSeals [][]byte
+	var blsSeals []*bls.Signature
+	var blsSigners []common.Address
+	for i, seal := range seals {
+		if len(seal) != bls.SignatureLength {
+			ecdsaSeals = append(ecdsaSeals, seal)
+			continue
+		}
+		sig, err := bls.SignatureFromBytes(seal)
+		if err != nil {
+			sb.logger.Warn("Dropped an invalid BLS committed seal", "committer", committers[i], "err", err)
+			continue
+		}
+		pk, err := snap.blsPublicKey(committers[i])
+		if err != nil || !bls.Verify(pk, proposalSeal, sig) {
+			sb.logger.Warn("Dropped a BLS committed seal not signed by its committer", "committer", committers[i])
+			continue
+		}
+		blsSeals = append(blsSeals, sig)
+		blsSigners = append(blsSigners, committers[i])
+	}
+
+	if len(blsSeals) > 0 {
+		aggregated, err := bls.AggregateSignatures(blsSeals)
+		if err != nil {
+			return err
+		}
+		if err := writeAggregatedSeal(h, aggregated.Bytes(), snap.sealBitmap(blsSigners)); err != nil {
+			return err
+		}
+	}
+	if len(ecdsaSeals) > 0 || len(blsSeals) == 0 {
+		return writeCommittedSeals(h, ecdsaSeals)
+	}
+	return nil
+}
+
+// writeAggregatedSeal writes the extra-data field of a block header with the given aggregated seal and its bitmap.
+func writeAggregatedSeal(h *types.Header, seal []byte, bitmap []byte) error {
+	if len(seal) != bls.SignatureLength || len(bitmap) == 0 {
+		return errInvalidAggregatedSeal
+	}
+
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+
+	istanbulExtra.AggregatedSeal = seal
+	istanbulExtra.SealBitmap = bitmap
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
+
+// writeBLSRegistration writes the extra-data field of a block header with the BLS public key of
+// the proposer and its proof of possession.
+func writeBLSRegistration(h *types.Header, publicKey []byte, proof []byte) error {
+	if len(publicKey) != bls.PublicKeyLength || len(proof) != bls.SignatureLength {
+		return errInvalidBLSRegistration
+	}
+
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+
+	istanbulExtra.BLSPublicKey = publicKey
+	istanbulExtra.BLSProof = proof
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
+
+// blsPublicKey returns the decoded BLS public key registered by the given validator.
+func (s *Snapshot) blsPublicKey(addr common.Address) (*bls.PublicKey, error) {
+	key, ok := s.BLSPublicKeys[addr]
+	if !ok {
+		return nil, bls.ErrInvalidPublicKey
+	}
+	if pk, ok := recentBLSPublicKeys.Get(string(key)); ok {
+		return pk.(*bls.PublicKey), nil
+	}
+	pk, err := bls.PublicKeyFromBytes(key)
+	if err != nil {
+		return nil, err
+	}
+	recentBLSPublicKeys.Add(string(key), pk)
+	return pk, nil
+}
+
+// sealBitmap returns the bitmap of the given signers in the validators sorted by address.
+// The most significant bit of the first byte is for the first validator.
+func (s *Snapshot) sealBitmap(signers []common.Address) []byte {
+	validators := s.validators()
+	bitmap := make([]byte, (len(validators)+7)/8)
+	for _, signer := range signers {
+		for i, addr := range validators {
+			if addr == signer {
+				bitmap[i/8] |= 0x80 >> uint(i%8)
+				break
+			}
+		}
+	}
+	return bitmap
+}
+
+// aggregatedSealSigners returns the validators marked in the given seal bitmap.
+// It returns an error if the length of the bitmap doesn't match the number of validators,
+// or if a bit out of the validators is set.
+func (s *Snapshot) aggregatedSealSigners(bitmap []byte) ([]common.Address, error) {
+	validators := s.validators()
+	if len(bitmap) != (len(validators)+7)/8 {
+		return nil, errInvalidAggregatedSeal
+	}
+
+	var signers []common.Address
+	for i := 0; i < len(bitmap)*8; i++ {
+		if bitmap[i/8]&(0x80>>uint(i%8)) == 0 {
+			continue
+		}
+		if i >= len(validators) {
+			return nil, errInvalidAggregatedSeal
+		}
+		signers = append(signers, validators[i])
+	}
+	return signers, nil
+}
+
+// blsCommitters returns the validators which signed the committed seals of the header with their BLS keys.
+func (s *Snapshot) blsCommitters(extra *types.IstanbulExtra) ([]common.Address, error) {
+	if len(extra.AggregatedSeal) == 0 && len(extra.SealBitmap) == 0 {
+		return nil, nil
+	}
+	return s.aggregatedSealSigners(extra.SealBitmap)
+}
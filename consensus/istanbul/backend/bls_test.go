@@ -0,0 +1,317 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	istanbulCore "github.com/klaytn/klaytn/consensus/istanbul/core"
+	"github.com/klaytn/klaytn/consensus/istanbul/validator"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/crypto/bls"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/ser/rlp"
+)
+
+func newBLSBlockChain(n int) (*blockchain.BlockChain, *backend) {
+	config := *params.TestChainConfig
+	config.BLSCompatibleBlock = big.NewInt(0)
+	return newBlockChainWithConfig(n, &config)
+}
+
+// insertBLSBlocks inserts two blocks: the first one registers the BLS key of the engine
+// with an ECDSA committed seal, and the second one has the aggregated seal of the key.
+func insertBLSBlocks(t *testing.T, chain *blockchain.BlockChain, engine *backend) (*types.Block, *types.Block) {
+	block1 := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block1}); err != nil {
+		t.Fatalf("failed to insert the registration block: %v", err)
+	}
+	block2 := makeBlock(chain, engine, block1)
+	if _, err := chain.InsertChain(types.Blocks{block2}); err != nil {
+		t.Fatalf("failed to insert the aggregated seal block: %v", err)
+	}
+	return block1, block2
+}
+
+func TestBLSCommittedSeals(t *testing.T) {
+	chain, engine := newBLSBlockChain(1)
+	defer engine.Stop()
+
+	block1, block2 := insertBLSBlocks(t, chain, engine)
+
+	extra1, err := types.ExtractIstanbulExtra(block1.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(extra1.BLSPublicKey, engine.blsPublicKey) || !bytes.Equal(extra1.BLSProof, engine.blsProof) {
+		t.Errorf("BLS key is not registered in the first block")
+	}
+	if len(extra1.CommittedSeal) != 1 || len(extra1.AggregatedSeal) != 0 {
+		t.Errorf("committed seals mismatch: have %v ECDSA seals and %v aggregated seal, want 1 and 0", len(extra1.CommittedSeal), len(extra1.AggregatedSeal))
+	}
+
+	extra2, err := types.ExtractIstanbulExtra(block2.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(extra2.BLSPublicKey) != 0 || len(extra2.BLSProof) != 0 {
+		t.Errorf("BLS key is registered again")
+	}
+	if len(extra2.CommittedSeal) != 0 || len(extra2.AggregatedSeal) != bls.SignatureLength {
+		t.Errorf("committed seals mismatch: have %v ECDSA seals and %v bytes of aggregated seal, want 0 and %v", len(extra2.CommittedSeal), len(extra2.AggregatedSeal), bls.SignatureLength)
+	}
+	if !bytes.Equal(extra2.SealBitmap, []byte{0x80}) {
+		t.Errorf("seal bitmap mismatch: have %x, want 80", extra2.SealBitmap)
+	}
+
+	// The committed seal of the next block is signed with the registered BLS key
+	block3 := makeBlockWithoutSeal(chain, engine, block2)
+	seal, err := engine.SignCommittedSeal(block3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seal) != bls.SignatureLength {
+		t.Errorf("committed seal length mismatch: have %v, want %v", len(seal), bls.SignatureLength)
+	}
+
+	// The aggregated seal signers are the participants of the block
+	p, err := engine.participation(chain, block2.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(p.Signers, []common.Address{engine.address}) {
+		t.Errorf("signers mismatch: have %v, want %v", p.Signers, []common.Address{engine.address})
+	}
+}
+
+func TestVerifyAggregatedSeal(t *testing.T) {
+	chain, engine := newBLSBlockChain(1)
+	defer engine.Stop()
+
+	block1, block2 := insertBLSBlocks(t, chain, engine)
+	extra, err := types.ExtractIstanbulExtra(block2.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaSeal, err := engine.Sign(istanbulCore.PrepareCommittedSeal(block2.Hash()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		modify      func(extra *types.IstanbulExtra)
+		expectedErr error
+	}{
+		{
+			func(extra *types.IstanbulExtra) {},
+			nil,
+		},
+		{
+			// a seal of another block
+			func(extra *types.IstanbulExtra) {
+				extra.AggregatedSeal = engine.blsKey.Sign(istanbulCore.PrepareCommittedSeal(block1.Hash())).Bytes()
+			},
+			errInvalidAggregatedSeal,
+		},
+		{
+			// a malformed seal
+			func(extra *types.IstanbulExtra) { extra.AggregatedSeal = extra.AggregatedSeal[1:] },
+			errInvalidAggregatedSeal,
+		},
+		{
+			// a bitmap without signers
+			func(extra *types.IstanbulExtra) { extra.SealBitmap = []byte{0x00} },
+			errInvalidAggregatedSeal,
+		},
+		{
+			// a bitmap with a signer out of the validators
+			func(extra *types.IstanbulExtra) { extra.SealBitmap = []byte{0xc0} },
+			errInvalidAggregatedSeal,
+		},
+		{
+			// a bitmap of a wrong length
+			func(extra *types.IstanbulExtra) { extra.SealBitmap = []byte{0x80, 0x00} },
+			errInvalidAggregatedSeal,
+		},
+		{
+			// a bitmap without a seal
+			func(extra *types.IstanbulExtra) { extra.AggregatedSeal = nil },
+			errInvalidAggregatedSeal,
+		},
+		{
+			// a validator with both of ECDSA and BLS seals
+			func(extra *types.IstanbulExtra) { extra.CommittedSeal = [][]byte{ecdsaSeal} },
+			errInvalidCommittedSeals,
+		},
+		{
+			// ECDSA seals are still valid
+			func(extra *types.IstanbulExtra) {
+				extra.AggregatedSeal, extra.SealBitmap = nil, nil
+				extra.CommittedSeal = [][]byte{ecdsaSeal}
+			},
+			nil,
+		},
+		{
+			func(extra *types.IstanbulExtra) {
+				extra.AggregatedSeal, extra.SealBitmap, extra.CommittedSeal = nil, nil, nil
+			},
+			errEmptyCommittedSeals,
+		},
+	}
+	for i, tc := range testCases {
+		modified := *extra
+		tc.modify(&modified)
+		header := block2.Header()
+		payload, err := rlp.EncodeToBytes(&modified)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header.Extra = append(header.Extra[:types.IstanbulExtraVanity], payload...)
+
+		if err := engine.verifyCommittedSeals(chain, header, nil); err != tc.expectedErr {
+			t.Errorf("case %d: error mismatch: have %v, want %v", i, err, tc.expectedErr)
+		}
+	}
+
+	// Aggregated seals are not allowed before the BLS fork
+	nonBLSChain, nonBLSEngine := newBlockChain(1)
+	defer nonBLSEngine.Stop()
+	header := makeBlockWithoutSeal(nonBLSChain, nonBLSEngine, nonBLSChain.Genesis()).Header()
+	if err := writeAggregatedSeal(header, extra.AggregatedSeal, []byte{0x80}); err != nil {
+		t.Fatal(err)
+	}
+	if err := nonBLSEngine.verifyCommittedSeals(nonBLSChain, header, nil); err != errInvalidAggregatedSeal {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidAggregatedSeal)
+	}
+}
+
+func TestVerifyBLSRegistration(t *testing.T) {
+	chain, engine := newBLSBlockChain(1)
+	defer engine.Stop()
+
+	block1, _ := insertBLSBlocks(t, chain, engine)
+	if err := verifyBLSRegistration(chain, block1.Header()); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	// The snapshot has the registered key, which is kept through the JSON encoding
+	snap, err := engine.snapshot(chain, block1.NumberU64(), block1.Hash(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(snap.BLSPublicKeys[engine.address], engine.blsPublicKey) {
+		t.Errorf("registered key mismatch: have %x, want %x", snap.BLSPublicKeys[engine.address], engine.blsPublicKey)
+	}
+	blob, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(Snapshot)
+	if err := json.Unmarshal(blob, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.BLSPublicKeys, snap.BLSPublicKeys) {
+		t.Errorf("registered keys mismatch: have %v, want %v", decoded.BLSPublicKeys, snap.BLSPublicKeys)
+	}
+
+	// Re-sign the header after replacing the registration
+	resign := func(header *types.Header, publicKey []byte, proof []byte) *types.Header {
+		if err := writeBLSRegistration(header, publicKey, proof); err != nil {
+			t.Fatal(err)
+		}
+		seal, err := engine.Sign(sigHash(header).Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := writeSeal(header, seal); err != nil {
+			t.Fatal(err)
+		}
+		return header
+	}
+
+	// The proof of another address can't be replayed
+	otherKey, _ := crypto.GenerateKey()
+	otherAddr := crypto.PubkeyToAddress(otherKey.PublicKey)
+	replayed := resign(block1.Header(), engine.blsPublicKey, engine.blsKey.ProvePossession(otherAddr.Bytes()).Bytes())
+	if err := verifyBLSRegistration(chain, replayed); err != errInvalidBLSRegistration {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidBLSRegistration)
+	}
+
+	// The proof of another key is not valid
+	otherBLSKey := bls.DeriveKey([]byte("other"))
+	mismatched := resign(block1.Header(), otherBLSKey.PublicKey().Bytes(), engine.blsProof)
+	if err := verifyBLSRegistration(chain, mismatched); err != errInvalidBLSRegistration {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidBLSRegistration)
+	}
+
+	// Registrations are not allowed before the BLS fork
+	nonBLSChain, nonBLSEngine := newBlockChain(1)
+	defer nonBLSEngine.Stop()
+	header := makeBlockWithoutSeal(nonBLSChain, nonBLSEngine, nonBLSChain.Genesis()).Header()
+	if err := writeBLSRegistration(header, nonBLSEngine.blsPublicKey, nonBLSEngine.blsProof); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyBLSRegistration(nonBLSChain, header); err != errInvalidBLSRegistration {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidBLSRegistration)
+	}
+}
+
+func TestSealBitmap(t *testing.T) {
+	numValidators := 10
+	addrs := make([]common.Address, numValidators)
+	for i := range addrs {
+		key, _ := crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+
+	snap := &Snapshot{ValSet: validator.NewWeightedCouncil(addrs, nil, getTestVotingPowers(numValidators), nil, istanbul.WeightedRandom, 21, 0, 0, nil)}
+
+	signers := []common.Address{addrs[0], addrs[3], addrs[8], addrs[9]}
+	bitmap := snap.sealBitmap([]common.Address{addrs[9], addrs[3], addrs[0], addrs[8]})
+	if !bytes.Equal(bitmap, []byte{0x90, 0xc0}) {
+		t.Errorf("bitmap mismatch: have %x, want 90c0", bitmap)
+	}
+	decoded, err := snap.aggregatedSealSigners(bitmap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, signers) {
+		t.Errorf("signers mismatch: have %v, want %v", decoded, signers)
+	}
+
+	// bits out of the validators
+	if _, err := snap.aggregatedSealSigners([]byte{0x90, 0xe0}); err != errInvalidAggregatedSeal {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidAggregatedSeal)
+	}
+	// wrong lengths
+	if _, err := snap.aggregatedSealSigners([]byte{0x90}); err != errInvalidAggregatedSeal {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidAggregatedSeal)
+	}
+	if _, err := snap.aggregatedSealSigners([]byte{0x90, 0xc0, 0x00}); err != errInvalidAggregatedSeal {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidAggregatedSeal)
+	}
+}
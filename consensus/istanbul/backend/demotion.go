@@ -0,0 +1,160 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	"github.com/klaytn/klaytn/consensus/istanbul/validator"
+	"sort"
+)
+
+// maxDemotionShift limits the exclusion of a validator demoted repeatedly to 2^maxDemotionShift epochs.
+const maxDemotionShift = 10
+
+// validatorDuties is the number of duties of a validator in an epoch, which are the proposer turns at round 0 and
+// the committee memberships, and the number of them missed by the validator.
+type validatorDuties struct {
+	Duties uint64 `json:"duties"`
+	Misses uint64 `json:"misses"`
+}
+
+// demotion is the record of a validator excluded from the proposers and the committees due to missed duties.
+type demotion struct {
+	Until uint64 `json:"until"` // The block from which the validator is put on probation
+	Count uint64 `json:"count"` // The number of consecutive demotions, which doubles the epochs to be excluded
+}
+
+// demotionCandidate is a validator who missed more than the threshold percentage of its duties in an epoch.
+type demotionCandidate struct {
+	addr   common.Address
+	duties *validatorDuties
+	prev   *demotion
+}
+
+// countDuties reflects the duties of the validators in the block of the given participation to the snapshot.
+func (s *Snapshot) countDuties(p *Participation) {
+	if (p.ExpectedProposer != common.Address{}) {
+		s.countDuty(p.ExpectedProposer, p.Proposer != p.ExpectedProposer)
+	}
+	for _, member := range p.Committee {
+		s.countDuty(member, !p.hasSigned(member))
+	}
+}
+
+func (s *Snapshot) countDuty(addr common.Address, missed bool) {
+	d, ok := s.Duties[addr]
+	if !ok {
+		d = &validatorDuties{}
+		s.Duties[addr] = d
+	}
+	d.Duties++
+	if missed {
+		d.Misses++
+	}
+}
+
+// updateDemotions updates the validators excluded from the proposers and the committees with the duties counted
+// in the epoch ending at the given block, and starts counting the duties of the next epoch.
+// The proposers excluding the demoted validators are calculated at the next proposer update block.
+func (s *Snapshot) updateDemotions(number uint64, threshold uint64) {
+	duties := s.Duties
+	s.Duties = make(map[common.Address]*validatorDuties)
+	if threshold == 0 || s.ValSet.Policy() != istanbul.WeightedRandom {
+		s.Demotions = make(map[common.Address]*demotion)
+	} else {
+		s.Demotions = calcDemotions(s.ValSet, s.Demotions, duties, number, s.Epoch, threshold)
+	}
+
+	var demoted []common.Address
+	for _, val := range s.ValSet.List() {
+		if d, ok := s.Demotions[val.Address()]; ok && d.Until > number {
+			demoted = append(demoted, val.Address())
+		}
+	}
+	if len(demoted) > 0 {
+		logger.Info("Validators are demoted due to missed duties", "number", number, "demoted", demoted)
+	}
+	validator.SetDemotedValidators(s.ValSet, demoted)
+}
+
+// calcDemotions returns the demotions of the validators after the epoch ending at the given block.
+// A validator who missed more than the threshold percentage of its duties, which are the proposer turns and
+// the committed seals to sign as committee members, is excluded for the next epoch.
+// When its exclusion expires, it is put on probation and has duties again to show its liveness.
+// A validator missing too many duties on probation is excluded again for twice as many epochs as before,
+// so a validator which stays offline is excluded for most of the time.
+// At most (n-1)/3 validators are excluded at once in the order of the ratio of missed duties.
+func calcDemotions(valSet istanbul.ValidatorSet, demotions map[common.Address]*demotion, duties map[common.Address]*validatorDuties, number uint64, epoch uint64, threshold uint64) map[common.Address]*demotion {
+	result := make(map[common.Address]*demotion)
+	excluded := 0
+
+	var candidates []*demotionCandidate
+	for _, val := range valSet.List() {
+		addr := val.Address()
+		prev, wasDemoted := demotions[addr]
+		if wasDemoted && prev.Until > number {
+			// still excluded
+			result[addr] = &demotion{Until: prev.Until, Count: prev.Count}
+			excluded++
+			continue
+		}
+
+		d, ok := duties[addr]
+		if !ok || d.Duties == 0 {
+			// A validator on probation without any duty yet keeps its record.
+			if wasDemoted {
+				result[addr] = &demotion{Until: prev.Until, Count: prev.Count}
+			}
+			continue
+		}
+		if d.Misses*100 > threshold*d.Duties {
+			candidates = append(candidates, &demotionCandidate{addr: addr, duties: d, prev: prev})
+		}
+		// A validator who performed its duties is cleared of its demotions.
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		di, dj := candidates[i].duties, candidates[j].duties
+		ri, rj := di.Misses*dj.Duties, dj.Misses*di.Duties
+		if ri != rj {
+			return ri > rj
+		}
+		return bytes.Compare(candidates[i].addr.Bytes(), candidates[j].addr.Bytes()) < 0
+	})
+
+	maxDemoted := (int(valSet.Size())-1)/3 - excluded
+	for i, c := range candidates {
+		if i >= maxDemoted {
+			// It is not excluded due to the limit, but stays on probation.
+			if c.prev != nil {
+				result[c.addr] = &demotion{Until: c.prev.Until, Count: c.prev.Count}
+			}
+			continue
+		}
+		count := uint64(1)
+		if c.prev != nil {
+			count = c.prev.Count + 1
+		}
+		shift := count - 1
+		if shift > maxDemotionShift {
+			shift = maxDemotionShift
+		}
+		result[c.addr] = &demotion{Until: number + epoch<<shift, Count: count}
+	}
+	return result
+}
@@ -0,0 +1,179 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"encoding/json"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	"github.com/klaytn/klaytn/consensus/istanbul/validator"
+	"github.com/klaytn/klaytn/crypto"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// testDuties is the duties of a validator in an epoch.
+type testDuties struct {
+	duties, misses uint64
+}
+
+func makeTestValidators(n int) (istanbul.ValidatorSet, []common.Address) {
+	nodeKeys := make(keys, n)
+	addrs := make([]common.Address, n)
+	for i := range nodeKeys {
+		nodeKeys[i], _ = crypto.GenerateKey()
+	}
+	sort.Sort(nodeKeys)
+	for i, key := range nodeKeys {
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return validator.NewWeightedCouncil(addrs, nil, getTestVotingPowers(n), nil, istanbul.WeightedRandom, 21, 0, 0, nil), addrs
+}
+
+func makeTestDuties(addrs []common.Address, duties []testDuties) map[common.Address]*validatorDuties {
+	result := make(map[common.Address]*validatorDuties)
+	for i, d := range duties {
+		result[addrs[i]] = &validatorDuties{Duties: d.duties, Misses: d.misses}
+	}
+	return result
+}
+
+func TestCalcDemotions(t *testing.T) {
+	valSet, addrs := makeTestValidators(7)
+	const (
+		number = uint64(200)
+		epoch  = uint64(100)
+	)
+	perfect := testDuties{10, 0}
+
+	testCases := []struct {
+		duties    []testDuties
+		demotions map[int]demotion // the demotions before the epoch
+		threshold uint64
+		expected  map[int]demotion
+	}{
+		// addrs[6] missed all of its duties, and addrs[5] missed 30% of them
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, {10, 3}, {10, 10}}, nil, 100, map[int]demotion{}},
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, {10, 3}, {10, 10}}, nil, 50, map[int]demotion{6: {300, 1}}},
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, {10, 3}, {10, 10}}, nil, 20, map[int]demotion{6: {300, 1}, 5: {300, 1}}},
+		// validators without any duty are not demoted
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, perfect, {0, 0}}, nil, 50, map[int]demotion{}},
+		// a demoted validator stays excluded until its demotion expires
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, perfect, {0, 0}}, map[int]demotion{6: {400, 2}}, 50, map[int]demotion{6: {400, 2}}},
+		// a validator whose demotion expires is put on probation
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, perfect, {0, 0}}, map[int]demotion{6: {200, 2}}, 50, map[int]demotion{6: {200, 2}}},
+		// a validator missing too many duties on probation is excluded for twice as many epochs
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, perfect, {10, 6}}, map[int]demotion{6: {100, 2}}, 50, map[int]demotion{6: {600, 3}}},
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, perfect, {10, 6}}, map[int]demotion{6: {100, 20}}, 50, map[int]demotion{6: {200 + 100<<maxDemotionShift, 21}}},
+		// a validator performing its duties on probation is cleared
+		{[]testDuties{perfect, perfect, perfect, perfect, perfect, perfect, {10, 5}}, map[int]demotion{6: {100, 2}}, 50, map[int]demotion{}},
+		// at most (n-1)/3 validators are excluded in the order of the ratio of missed duties, then of the addresses
+		{[]testDuties{perfect, {10, 10}, {10, 5}, {10, 10}, {10, 10}, perfect, perfect}, nil, 40, map[int]demotion{1: {300, 1}, 3: {300, 1}}},
+		{[]testDuties{perfect, perfect, perfect, {10, 10}, perfect, {10, 6}, {0, 0}}, map[int]demotion{6: {300, 1}}, 50, map[int]demotion{6: {300, 1}, 3: {300, 1}}},
+		// a validator on probation not excluded due to the limit stays on probation
+		{[]testDuties{perfect, perfect, perfect, {10, 10}, perfect, {10, 6}, {0, 0}}, map[int]demotion{6: {300, 1}, 5: {200, 1}}, 50, map[int]demotion{6: {300, 1}, 3: {300, 1}, 5: {200, 1}}},
+	}
+	for i, tc := range testCases {
+		demotions := make(map[common.Address]*demotion)
+		for idx, d := range tc.demotions {
+			demotions[addrs[idx]] = &demotion{Until: d.Until, Count: d.Count}
+		}
+		expected := make(map[common.Address]*demotion)
+		for idx, d := range tc.expected {
+			expected[addrs[idx]] = &demotion{Until: d.Until, Count: d.Count}
+		}
+
+		result := calcDemotions(valSet, demotions, makeTestDuties(addrs, tc.duties), number, epoch, tc.threshold)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("test %d: demotions mismatch: have %v, want %v", i, result, expected)
+		}
+	}
+}
+
+func TestSnapshot_updateDemotions(t *testing.T) {
+	valSet, addrs := makeTestValidators(4)
+	snap := &Snapshot{
+		Epoch:     100,
+		ValSet:    valSet,
+		Policy:    uint64(istanbul.WeightedRandom),
+		Duties:    make(map[common.Address]*validatorDuties),
+		Demotions: make(map[common.Address]*demotion),
+	}
+
+	// addrs[3] is the expected proposer but another validator proposes the block, and it does not sign the block either
+	for n := uint64(1); n <= 10; n++ {
+		snap.countDuties(&Participation{
+			Number:           n,
+			Proposer:         addrs[0],
+			ExpectedProposer: addrs[3],
+			Committee:        addrs,
+			Signers:          addrs[:3],
+		})
+	}
+	expectedDuties := makeTestDuties(addrs, []testDuties{{10, 0}, {10, 0}, {10, 0}, {20, 20}})
+	if !reflect.DeepEqual(snap.Duties, expectedDuties) {
+		t.Errorf("duties mismatch: have %v, want %v", snap.Duties, expectedDuties)
+	}
+
+	// addrs[3] is excluded for the next epoch and the duties are counted again
+	snap.updateDemotions(100, 50)
+	if demoted := validator.GetDemotedValidators(snap.ValSet); !reflect.DeepEqual(demoted, []common.Address{addrs[3]}) {
+		t.Errorf("demoted validators mismatch: have %v, want %v", demoted, addrs[3:])
+	}
+	if len(snap.Duties) != 0 {
+		t.Errorf("duties are not reset: %v", snap.Duties)
+	}
+
+	// addrs[3] is put on probation after the next epoch
+	snap.updateDemotions(200, 50)
+	if demoted := validator.GetDemotedValidators(snap.ValSet); len(demoted) != 0 {
+		t.Errorf("demoted validators mismatch: have %v, want none", demoted)
+	}
+	if d := snap.Demotions[addrs[3]]; d == nil || d.Count != 1 {
+		t.Errorf("probation mismatch: have %v", d)
+	}
+
+	// the demotions are cleared if the demotion is disabled
+	snap.updateDemotions(300, 0)
+	if len(snap.Demotions) != 0 {
+		t.Errorf("demotions are not cleared: %v", snap.Demotions)
+	}
+
+	// the duties and the demotions are kept in a snapshot copy and its JSON
+	snap.countDuties(&Participation{Number: 301, Proposer: addrs[0], ExpectedProposer: addrs[0], Committee: addrs[:1], Signers: addrs[:1]})
+	snap.Demotions[addrs[1]] = &demotion{Until: 400, Count: 1}
+	validator.SetDemotedValidators(snap.ValSet, addrs[1:2])
+	cpy := snap.copy()
+	if !reflect.DeepEqual(cpy.Duties, snap.Duties) || !reflect.DeepEqual(cpy.Demotions, snap.Demotions) {
+		t.Errorf("copy mismatch: have %v %v, want %v %v", cpy.Duties, cpy.Demotions, snap.Duties, snap.Demotions)
+	}
+	blob, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(Snapshot)
+	if err := json.Unmarshal(blob, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Duties, snap.Duties) || !reflect.DeepEqual(decoded.Demotions, snap.Demotions) {
+		t.Errorf("JSON mismatch: have %v %v, want %v %v", decoded.Duties, decoded.Demotions, snap.Duties, snap.Demotions)
+	}
+	if demoted := validator.GetDemotedValidators(decoded.ValSet); !reflect.DeepEqual(demoted, addrs[1:2]) {
+		t.Errorf("demoted validators mismatch: have %v, want %v", demoted, addrs[1:2])
+	}
+}
@@ -24,8 +24,11 @@ Source Files
 Implementation of Backend interface and APIs are included in this package
  - `api.go`: Implements APIs which provide the states of Istanbul
  - `backend.go`: Defines backend struct which implements Backend interface working as a backbone of the consensus engine
+ - `bls.go`: Registers BLS keys of validators, and aggregates and verifies BLS committed seals after the BLS fork
+ - `demotion.go`: Counts the duties of validators in each epoch and demotes the ones missing them from proposers and committees
  - `engine.go`: Implements various backend methods especially for verifying and building header information
  - `handler.go`: Implements backend methods for handling messages and broadcaster
+ - `participation.go`: Indexes who proposed and signed each block in the database, and updates the metrics about the liveness of validators
  - `snapshot.go`: Defines snapshot struct which handles votes from nodes and makes governance changes
 
 */
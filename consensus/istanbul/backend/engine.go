@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/golang-lru"
+	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/state"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
@@ -74,6 +75,8 @@ var (
 	errInvalidExtraDataFormat = errors.New("invalid extra data format")
 	// errInvalidTimestamp is returned if the timestamp of a block is lower than the previous block's timestamp + the minimum block period.
 	errInvalidTimestamp = errors.New("invalid timestamp")
+	// errInvalidGovernance is returned if the governance data of a block is not the one read from the governance contract.
+	errInvalidGovernance = errors.New("invalid governance data")
 	// errInvalidVotingChain is returned if an authorization list is attempted to
 	// be modified via out-of-range or non-contiguous headers.
 	errInvalidVotingChain = errors.New("invalid voting chain")
@@ -82,10 +85,18 @@ var (
 	errInvalidVote = errors.New("vote nonce not 0x00..0 or 0xff..f")
 	// errInvalidCommittedSeals is returned if the committed seal is not signed by any of parent validators.
 	errInvalidCommittedSeals = errors.New("invalid committed seals")
+	// errInvalidAggregatedSeal is returned if the aggregated seal is not signed by the validators of the seal bitmap.
+	errInvalidAggregatedSeal = errors.New("invalid aggregated seal")
+	// errInvalidBLSRegistration is returned if the BLS public key registered in a header is invalid,
+	// or if its possession is not proven by the proposer of the header.
+	errInvalidBLSRegistration = errors.New("invalid BLS public key registration")
 	// errEmptyCommittedSeals is returned if the field of committed seals is zero.
 	errEmptyCommittedSeals = errors.New("zero committed seals")
 	// errMismatchTxhashes is returned if the TxHash in header is mismatch.
 	errMismatchTxhashes = errors.New("mismatch transactions hashes")
+	// errInvalidBaseFee is returned if the base fee of a block is not the one calculated from the parent,
+	// or if a block before the dynamic fee fork has a base fee.
+	errInvalidBaseFee = errors.New("invalid base fee")
 )
 var (
 	defaultBlockScore = big.NewInt(1)
@@ -156,15 +167,22 @@ func (sb *backend) verifyCascadingFields(chain consensus.ChainReader, header *ty
 	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
 		return consensus.ErrUnknownAncestor
 	}
-	if parent.Time.Uint64()+sb.config.BlockPeriod > header.Time.Uint64() {
+	if parent.Time.Uint64()+sb.blockPeriod(number) > header.Time.Uint64() {
 		return errInvalidTimestamp
 	}
 	if err := sb.verifySigner(chain, header, parents); err != nil {
 		return err
 	}
+	if err := sb.verifyBaseFee(chain, header, parent); err != nil {
+		return err
+	}
+	if err := verifyBLSRegistration(chain, header); err != nil {
+		return err
+	}
 
 	// At every epoch governance data will come in block header. Verify it.
-	if number%sb.governance.Epoch() == 0 && len(header.Governance) > 0 {
+	// In the contract governance mode, it is verified against the state in Finalize.
+	if number%sb.governance.Epoch() == 0 && len(header.Governance) > 0 && !sb.governance.IsContractMode(number) {
 		return sb.governance.VerifyGovernance(header.Governance)
 	}
 	return sb.verifyCommittedSeals(chain, header, parents)
@@ -191,6 +209,32 @@ func (sb *backend) VerifyHeaders(chain consensus.ChainReader, headers []*types.H
 	return abort, results
 }
 
+// blockPeriod returns the minimum time difference between the block of the given number
+// and its parent. The one set by the governance takes precedence over the configured one.
+func (sb *backend) blockPeriod(number uint64) uint64 {
+	if period, ok := sb.governance.BlockPeriod(number); ok {
+		return period
+	}
+	return sb.config.BlockPeriod
+}
+
+// verifyBaseFee checks whether the base fee of the header is calculated from the parent
+// according to the dynamic fee parameters of the governance.
+func (sb *backend) verifyBaseFee(chain consensus.ChainReader, header *types.Header, parent *types.Header) error {
+	if !chain.Config().IsDynamicFeeForkEnabled(header.Number) {
+		if header.BaseFee != nil {
+			return errInvalidBaseFee
+		}
+		return nil
+	}
+	expected := blockchain.CalcBaseFee(parent, sb.governance.DynamicFeeConfig(header.Number.Uint64()))
+	if header.BaseFee == nil || header.BaseFee.Cmp(expected) != 0 {
+		logger.Warn("Invalid base fee", "number", header.Number, "have", header.BaseFee, "want", expected)
+		return errInvalidBaseFee
+	}
+	return nil
+}
+
 // verifySigner checks whether the signer is in parent's validator set
 func (sb *backend) verifySigner(chain consensus.ChainReader, header *types.Header, parents []*types.Header) error {
 	// Verifying the genesis block is not supported
@@ -237,13 +281,15 @@ func (sb *backend) verifyCommittedSeals(chain consensus.ChainReader, header *typ
 		return err
 	}
 	// The length of Committed seals should be larger than 0
-	if len(extra.CommittedSeal) == 0 {
+	hasAggregatedSeal := len(extra.AggregatedSeal) > 0 || len(extra.SealBitmap) > 0
+	if len(extra.CommittedSeal) == 0 && !hasAggregatedSeal {
 		return errEmptyCommittedSeals
 	}
 
 	validators := snap.ValSet.Copy()
 	// Check whether the committed seals are generated by parent's validators
 	validSeal := 0
+	var signers []common.Address
 	proposalSeal := istanbulCore.PrepareCommittedSeal(header.Hash())
 	// 1. Get committed seals from current header
 	for _, seal := range extra.CommittedSeal {
@@ -256,16 +302,33 @@ func (sb *backend) verifyCommittedSeals(chain consensus.ChainReader, header *typ
 		// validator, the validator cannot be found and errInvalidCommittedSeals is returned.
 		if validators.RemoveValidator(addr) {
 			validSeal += 1
+			signers = append(signers, addr)
 		} else {
 			return errInvalidCommittedSeals
 		}
 	}
+	// 3. Get the committers of the aggregated seal, which must not have ECDSA seals as well
+	if hasAggregatedSeal {
+		committers, err := verifyAggregatedSeal(chain, snap, header, extra)
+		if err != nil {
+			return err
+		}
+		for _, addr := range committers {
+			if validators.RemoveValidator(addr) {
+				validSeal += 1
+				signers = append(signers, addr)
+			} else {
+				return errInvalidCommittedSeals
+			}
+		}
+	}
 
 	// The length of validSeal should be larger than number of faulty node + 1
 	if validSeal <= 2*snap.ValSet.F() {
 		return errInvalidCommittedSeals
 	}
 
+	sb.indexParticipation(chain, snap, header, parents, signers)
 	return nil
 }
 
@@ -300,6 +363,10 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 	// use the same blockscore for all blocks
 	header.BlockScore = defaultBlockScore
 
+	if chain.Config().IsDynamicFeeForkEnabled(header.Number) {
+		header.BaseFee = blockchain.CalcBaseFee(parent, sb.governance.DynamicFeeConfig(number))
+	}
+
 	// Assemble the voting snapshot
 	snap, err := sb.snapshot(chain, number-1, header.ParentHash, nil)
 	if err != nil {
@@ -307,7 +374,8 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 	}
 
 	// If it reaches the Epoch, governance config will be added to block header
-	if number%sb.governance.Epoch() == 0 {
+	// In the contract governance mode, it is added in Finalize from the governance contract.
+	if number%sb.governance.Epoch() == 0 && !sb.governance.IsContractMode(number) {
 		if g := sb.governance.GetGovernanceChange(); g != nil {
 			if data, err := json.Marshal(g); err != nil {
 				logger.Error("Failed to encode governance changes!! Possible configuration mismatch!! ")
@@ -329,8 +397,15 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 	}
 	header.Extra = extra
 
+	// register the BLS public key of the node with its first proposal after the BLS fork
+	if chain.Config().IsBLSForkEnabled(header.Number) && !bytes.Equal(snap.BLSPublicKeys[sb.address], sb.blsPublicKey) {
+		if err := writeBLSRegistration(header, sb.blsPublicKey, sb.blsProof); err != nil {
+			return err
+		}
+	}
+
 	// set header's timestamp
-	header.Time = new(big.Int).Add(parent.Time, new(big.Int).SetUint64(sb.config.BlockPeriod))
+	header.Time = new(big.Int).Add(parent.Time, new(big.Int).SetUint64(sb.blockPeriod(number)))
 	header.TimeFoS = parent.TimeFoS
 	if header.Time.Int64() < time.Now().Unix() {
 		t := time.Now()
@@ -340,6 +415,15 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 	return nil
 }
 
+// calcBlockReward returns the block reward of the block, which is distributed in Finalize.
+func (sb *backend) calcBlockReward(header *types.Header) (*reward.RewardSpec, error) {
+	// If sb.chain is nil, it means backend is not initialized yet.
+	if sb.chain != nil && sb.governance.ProposerPolicy() == uint64(istanbul.WeightedRandom) {
+		return sb.rewardDistributor.CalcBlockReward(header, reward.GetStakingInfo(header.Number.Uint64()))
+	}
+	return sb.rewardDistributor.CalcMintKLAY(header)
+}
+
 // Finalize runs any post-transaction state modifications (e.g. block rewards)
 // and assembles the final block.
 //
@@ -352,8 +436,6 @@ func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, s
 	if sb.chain != nil && sb.governance.ProposerPolicy() == uint64(istanbul.WeightedRandom) {
 		// TODO-Klaytn Let's redesign below logic and remove dependency between block reward and istanbul consensus.
 
-		pocAddr := common.Address{}
-		kirAddr := common.Address{}
 		lastHeader := chain.CurrentHeader()
 		valSet := sb.getValidators(lastHeader.Number.Uint64(), lastHeader.Hash())
 
@@ -373,18 +455,25 @@ func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, s
 			}
 			logger.Trace(logMsg, "header.Number", header.Number.Uint64(), "node address", sb.address, "rewardbase", header.Rewardbase)
 		}
+	}
 
-		if stakingInfo := reward.GetStakingInfo(header.Number.Uint64()); stakingInfo != nil {
-			kirAddr = stakingInfo.KIRAddr
-			pocAddr = stakingInfo.PoCAddr
-		}
+	spec, err := sb.calcBlockReward(header)
+	if err != nil {
+		return nil, err
+	}
+	spec.Distribute(state)
 
-		if err := sb.rewardDistributor.DistributeBlockReward(state, header, pocAddr, kirAddr); err != nil {
+	// In the contract governance mode, the parameters changed in the governance contract
+	// are added to the block header at every epoch.
+	if number := header.Number.Uint64(); number%sb.governance.Epoch() == 0 && sb.governance.IsContractMode(number) {
+		change, err := sb.governance.ContractGovernanceChange(number, state)
+		if err != nil {
 			return nil, err
 		}
-	} else {
-		if err := sb.rewardDistributor.MintKLAY(state, header); err != nil {
-			return nil, err
+		if common.EmptyHash(header.Root) {
+			header.Governance = change
+		} else if !bytes.Equal(header.Governance, change) {
+			return nil, errInvalidGovernance
 		}
 	}
 
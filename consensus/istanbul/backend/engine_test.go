@@ -31,6 +31,7 @@ import (
 	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/consensus/istanbul"
 	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/networks/rpc"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/ser/rlp"
 	"math/big"
@@ -43,6 +44,11 @@ import (
 // block by one node. Otherwise, if n is larger than 1, we have to generate
 // other fake events to process Istanbul.
 func newBlockChain(n int) (*blockchain.BlockChain, *backend) {
+	return newBlockChainWithConfig(n, params.TestChainConfig)
+}
+
+// newBlockChainWithConfig is the same as newBlockChain, except that the genesis block has the given chain config.
+func newBlockChainWithConfig(n int, config *params.ChainConfig) (*blockchain.BlockChain, *backend) {
 	var nodeKeys = make([]*ecdsa.PrivateKey, n)
 	var addrs = make([]common.Address, n)
 
@@ -57,7 +63,7 @@ func newBlockChain(n int) (*blockchain.BlockChain, *backend) {
 
 	// generate a genesis block
 	genesis := blockchain.DefaultGenesisBlock()
-	genesis.Config = params.TestChainConfig
+	genesis.Config = config
 	genesis.Timestamp = uint64(time.Now().Unix())
 
 	// force enable Istanbul engine
@@ -505,3 +511,99 @@ func TestWriteCommittedSeals(t *testing.T) {
 		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommittedSeals)
 	}
 }
+
+func TestAPIExtension_GetRewards(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatal(err)
+	}
+	api := &APIExtension{chain: chain, istanbul: engine}
+
+	// The rewards are the same as the ones distributed in the block
+	number := rpc.BlockNumber(1)
+	spec, err := api.GetRewards(&number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parentState, _ := chain.StateAt(chain.Genesis().Root())
+	blockState, _ := chain.StateAt(block.Root())
+	for addr, amount := range spec.Rewards {
+		distributed := new(big.Int).Sub(blockState.GetBalance(addr), parentState.GetBalance(addr))
+		if distributed.Cmp(amount) != 0 {
+			t.Errorf("reward mismatch of %v: have %v, want %v", addr.String(), amount, distributed)
+		}
+	}
+	if _, ok := spec.Rewards[block.Rewardbase()]; !ok {
+		t.Errorf("no reward for the proposer %v", block.Rewardbase().String())
+	}
+
+	// The genesis block is skipped in the accumulated rewards
+	accumulated, err := api.GetRewardsAccumulated(rpc.BlockNumber(0), rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accumulated.Minted.Cmp(spec.Minted) != 0 {
+		t.Errorf("minted amount mismatch: have %v, want %v", accumulated.Minted, spec.Minted)
+	}
+
+	number = rpc.BlockNumber(0)
+	if _, err := api.GetRewards(&number); err != errNoRewardAtGenesis {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoRewardAtGenesis)
+	}
+	if _, err := api.GetRewardsAccumulated(rpc.BlockNumber(0), rpc.BlockNumber(2)); err != errEndLargetThanLatest {
+		t.Errorf("error mismatch: have %v, want %v", err, errEndLargetThanLatest)
+	}
+}
+
+func TestAPI_GetParticipation(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatal(err)
+	}
+	// The participation is indexed when the block is inserted
+	p := readParticipation(engine.db, block.Hash())
+	if p == nil {
+		t.Fatal("participation is not indexed")
+	}
+	if p.Number != 1 || p.Proposer != engine.Address() || len(p.Signers) != 1 || p.Signers[0] != engine.Address() {
+		t.Errorf("indexed participation mismatch: %v", p)
+	}
+	api := &API{chain: chain, istanbul: engine}
+
+	// The only validator proposes and signs every block in its turn
+	summary, err := api.GetParticipation(engine.Address(), rpc.BlockNumber(0), rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.CommitteeBlocks != 1 || summary.SignedBlocks != 1 || len(summary.MissedSealBlocks) != 0 {
+		t.Errorf("committed seal mismatch: have %v/%v, missed %v", summary.SignedBlocks, summary.CommitteeBlocks, summary.MissedSealBlocks)
+	}
+	if summary.ProposerTurns != 1 || summary.ProposedBlocks != 1 || len(summary.MissedProposalBlocks) != 0 {
+		t.Errorf("proposal mismatch: have %v/%v, missed %v", summary.ProposedBlocks, summary.ProposerTurns, summary.MissedProposalBlocks)
+	}
+	if summary.RoundChanges != 0 {
+		t.Errorf("round change mismatch: have %v, want 0", summary.RoundChanges)
+	}
+
+	// Another address takes no part in the blocks
+	summary, err = api.GetParticipation(common.StringToAddress("0x1"), rpc.BlockNumber(1), rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.CommitteeBlocks != 0 || summary.ProposerTurns != 0 || summary.ProposedBlocks != 0 {
+		t.Errorf("participation of a non-validator: %v", summary)
+	}
+
+	if _, err := api.GetParticipation(engine.Address(), rpc.BlockNumber(1), rpc.BlockNumber(0)); err != errStartLargerThanEnd {
+		t.Errorf("error mismatch: have %v, want %v", err, errStartLargerThanEnd)
+	}
+	if _, err := api.GetParticipation(engine.Address(), rpc.BlockNumber(0), rpc.BlockNumber(2)); err != errEndLargetThanLatest {
+		t.Errorf("error mismatch: have %v, want %v", err, errEndLargetThanLatest)
+	}
+}
@@ -134,5 +134,8 @@ func (sb *backend) NewChainHead() error {
 	}
 
 	go sb.istanbulEventMux.Post(istanbul.FinalCommittedEvent{})
+	if sb.chain != nil && sb.currentBlock != nil {
+		go sb.updateParticipationMetrics(sb.chain, sb.currentBlock().Header())
+	}
 	return nil
 }
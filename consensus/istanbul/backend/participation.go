@@ -0,0 +1,301 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"encoding/json"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	istanbulCore "github.com/klaytn/klaytn/consensus/istanbul/core"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/rcrowley/go-metrics"
+	"math/big"
+)
+
+const (
+	maxParticipationRound = 256 // Maximum round searched to find the round where a block is proposed
+)
+
+var (
+	roundChangeMeter    = metrics.NewRegisteredMeter("consensus/istanbul/backend/participation/roundChange", nil)
+	missedProposalMeter = metrics.NewRegisteredMeter("consensus/istanbul/backend/participation/missedProposal", nil)
+	missedSealGauge     = metrics.NewRegisteredGauge("consensus/istanbul/backend/participation/missedSeal", nil)
+	selfMissedSealMeter = metrics.NewRegisteredMeter("consensus/istanbul/backend/participation/selfMissedSeal", nil)
+)
+
+// Participation is the record of the validators who took part in making a block.
+// It is derived from the header of the block, so it always follows the canonical chain.
+type Participation struct {
+	Number           uint64           `json:"number"`
+	Hash             common.Hash      `json:"hash"`
+	Proposer         common.Address   `json:"proposer"`         // The validator who proposed the block
+	ExpectedProposer common.Address   `json:"expectedProposer"` // The validator who had to propose the block at round 0
+	Round            uint64           `json:"round"`            // The number of round changes before the block is proposed
+	Committee        []common.Address `json:"committee"`        // The committee of the round where the block is proposed
+	Signers          []common.Address `json:"signers"`          // The committee members whose committed seals are in the block
+}
+
+// hasSigned returns true if the given validator has signed the committed seal of the block.
+func (p *Participation) hasSigned(addr common.Address) bool {
+	for _, signer := range p.Signers {
+		if signer == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// inCommittee returns true if the given validator is a committee member of the block.
+func (p *Participation) inCommittee(addr common.Address) bool {
+	for _, member := range p.Committee {
+		if member == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// readParticipation retrieves the participation of the block of the given hash from the index.
+// It returns nil if the block is not indexed.
+func readParticipation(db database.DBManager, hash common.Hash) *Participation {
+	blob, err := db.ReadIstanbulParticipation(hash)
+	if err != nil || len(blob) == 0 {
+		return nil
+	}
+	p := new(Participation)
+	if err := json.Unmarshal(blob, p); err != nil {
+		logger.Error("Invalid participation JSON", "hash", hash, "err", err)
+		return nil
+	}
+	return p
+}
+
+// writeParticipation indexes the participation of a block.
+func writeParticipation(db database.DBManager, p *Participation) {
+	blob, err := json.Marshal(p)
+	if err != nil {
+		logger.Error("Failed to encode the participation", "number", p.Number, "hash", p.Hash, "err", err)
+		return
+	}
+	if err := db.WriteIstanbulParticipation(p.Hash, blob); err != nil {
+		logger.Error("Failed to write the participation", "number", p.Number, "hash", p.Hash, "err", err)
+	}
+}
+
+// lastProposer returns the proposer of the parent block of the given header, from which
+// the proposer of the header is calculated.
+func lastProposer(chain consensus.ChainReader, header *types.Header, parents []*types.Header) (common.Address, error) {
+	number := header.Number.Uint64()
+	if number <= 1 {
+		return common.Address{}, nil
+	}
+	var parent *types.Header
+	if len(parents) > 0 {
+		parent = parents[len(parents)-1]
+	} else {
+		parent = chain.GetHeader(header.ParentHash, number-1)
+	}
+	if parent == nil || parent.Hash() != header.ParentHash {
+		return common.Address{}, consensus.ErrUnknownAncestor
+	}
+	return ecrecover(parent)
+}
+
+// participation returns the participation of the given block from the index.
+// The participation of a block is indexed when the block is verified, so it is calculated and indexed here
+// only for the blocks which are not verified by this node, such as the ones proposed by itself.
+func (sb *backend) participation(chain consensus.ChainReader, header *types.Header) (*Participation, error) {
+	if p := readParticipation(sb.db, header.Hash()); p != nil {
+		return p, nil
+	}
+
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil, errUnknownBlock
+	}
+
+	proposer, err := ecrecover(header)
+	if err != nil {
+		return nil, err
+	}
+	lastProposer, err := lastProposer(chain, header, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := sb.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	p, err := snap.calcParticipation(header, proposer, lastProposer)
+	if err != nil {
+		return nil, err
+	}
+
+	writeParticipation(sb.db, p)
+	return p, nil
+}
+
+// indexParticipation indexes the participation of the given block whose committed seals are verified
+// to be signed by the given signers. The snapshot must be the one of the parent block.
+func (sb *backend) indexParticipation(chain consensus.ChainReader, snap *Snapshot, header *types.Header, parents []*types.Header, signers []common.Address) {
+	proposer, err := ecrecover(header)
+	if err != nil {
+		logger.Debug("Failed to index the participation", "number", header.Number, "err", err)
+		return
+	}
+	lastProposer, err := lastProposer(chain, header, parents)
+	if err != nil {
+		logger.Debug("Failed to index the participation", "number", header.Number, "err", err)
+		return
+	}
+	p := snap.newParticipation(header, proposer, lastProposer)
+	p.Signers = signers
+	writeParticipation(sb.db, p)
+}
+
+// calcParticipation returns the participation of the given block proposed by the given proposer.
+// The snapshot must be the one of the parent block, and lastProposer is the proposer of the parent block.
+func (s *Snapshot) calcParticipation(header *types.Header, proposer common.Address, lastProposer common.Address) (*Participation, error) {
+	p := s.newParticipation(header, proposer, lastProposer)
+
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	proposalSeal := istanbulCore.PrepareCommittedSeal(p.Hash)
+	for _, seal := range extra.CommittedSeal {
+		addr, err := istanbul.GetSignatureAddress(proposalSeal, seal)
+		if err != nil {
+			return nil, errInvalidSignature
+		}
+		p.Signers = append(p.Signers, addr)
+	}
+	// The aggregated seal is verified with the header, so the signers in its bitmap are trusted here.
+	blsCommitters, err := s.blsCommitters(extra)
+	if err != nil {
+		return nil, err
+	}
+	p.Signers = append(p.Signers, blsCommitters...)
+	return p, nil
+}
+
+// newParticipation returns the participation of the given block without the signers of the committed seals.
+// The round is the smallest one where the proposer is selected, so more round changes than
+// the number of proposer slots of the validator set cannot be told apart.
+func (s *Snapshot) newParticipation(header *types.Header, proposer common.Address, lastProposer common.Address) *Participation {
+	p := &Participation{
+		Number:   header.Number.Uint64(),
+		Hash:     header.Hash(),
+		Proposer: proposer,
+	}
+
+	valSet := s.ValSet.Copy()
+	for round := uint64(0); round < maxParticipationRound; round++ {
+		valSet.CalcProposer(lastProposer, round)
+		if valSet.GetProposer() == nil {
+			break
+		}
+		if round == 0 {
+			p.ExpectedProposer = valSet.GetProposer().Address()
+		}
+		if valSet.GetProposer().Address() == proposer {
+			p.Round = round
+			break
+		}
+	}
+
+	view := &istanbul.View{
+		Sequence: new(big.Int).Set(header.Number),
+		Round:    new(big.Int).SetUint64(p.Round),
+	}
+	for _, val := range s.ValSet.SubListWithProposer(header.ParentHash, proposer, view) {
+		p.Committee = append(p.Committee, val.Address())
+	}
+	return p
+}
+
+// updateParticipationMetrics updates the metrics about the participation of the given block.
+func (sb *backend) updateParticipationMetrics(chain consensus.ChainReader, header *types.Header) {
+	p, err := sb.participation(chain, header)
+	if err != nil {
+		logger.Debug("Failed to get the participation of the block", "number", header.Number, "err", err)
+		return
+	}
+
+	roundChangeMeter.Mark(int64(p.Round))
+	if p.Proposer != p.ExpectedProposer {
+		missedProposalMeter.Mark(1)
+		logger.Debug("The expected proposer did not propose the block", "number", p.Number,
+			"expected", p.ExpectedProposer, "proposer", p.Proposer, "round", p.Round)
+	}
+
+	missedSeals := 0
+	for _, member := range p.Committee {
+		if !p.hasSigned(member) {
+			missedSeals++
+		}
+	}
+	missedSealGauge.Update(int64(missedSeals))
+
+	if p.inCommittee(sb.address) && !p.hasSigned(sb.address) {
+		selfMissedSealMeter.Mark(1)
+	}
+}
+
+// ParticipationSummary is the participation of a validator in the blocks of a range.
+type ParticipationSummary struct {
+	Address   common.Address `json:"address"`
+	FromBlock uint64         `json:"fromBlock"`
+	ToBlock   uint64         `json:"toBlock"`
+
+	CommitteeBlocks  uint64   `json:"committeeBlocks"`  // The number of blocks where the validator is a committee member
+	SignedBlocks     uint64   `json:"signedBlocks"`     // The number of blocks where the committed seal of the validator is included
+	MissedSealBlocks []uint64 `json:"missedSealBlocks"` // The blocks where the validator is a committee member but its committed seal is not included
+
+	ProposerTurns        uint64   `json:"proposerTurns"`        // The number of blocks which the validator had to propose at round 0
+	ProposedBlocks       uint64   `json:"proposedBlocks"`       // The number of blocks proposed by the validator
+	MissedProposalBlocks []uint64 `json:"missedProposalBlocks"` // The blocks which the validator had to propose at round 0 but proposed by another one
+
+	RoundChanges uint64 `json:"roundChanges"` // The number of round changes of all blocks in the range
+}
+
+// add reflects the participation of a block to the summary.
+func (s *ParticipationSummary) add(p *Participation) {
+	if p.inCommittee(s.Address) {
+		s.CommitteeBlocks++
+		if p.hasSigned(s.Address) {
+			s.SignedBlocks++
+		} else {
+			s.MissedSealBlocks = append(s.MissedSealBlocks, p.Number)
+		}
+	}
+
+	if p.ExpectedProposer == s.Address {
+		s.ProposerTurns++
+		if p.Proposer != s.Address {
+			s.MissedProposalBlocks = append(s.MissedProposalBlocks, p.Number)
+		}
+	}
+	if p.Proposer == s.Address {
+		s.ProposedBlocks++
+	}
+
+	s.RoundChanges += p.Round
+}
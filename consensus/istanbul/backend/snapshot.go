@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/consensus/istanbul"
 	"github.com/klaytn/klaytn/consensus/istanbul/validator"
 	"github.com/klaytn/klaytn/governance"
@@ -46,6 +47,10 @@ type Snapshot struct {
 	CommitteeSize uint64
 	Votes         []governance.GovernanceVote      // List of votes cast in chronological order
 	Tally         []governance.GovernanceTallyItem // Current vote tally to avoid recalculating
+	BLSPublicKeys map[common.Address][]byte        // BLS public keys registered by the validators
+
+	Duties    map[common.Address]*validatorDuties // Duties of the validators counted in the current epoch
+	Demotions map[common.Address]*demotion        // Validators demoted or on probation due to missed duties
 }
 
 func getGovernanceValue(gov *governance.Governance, number uint64) (epoch uint64, policy uint64, committeeSize uint64) {
@@ -87,6 +92,9 @@ func newSnapshot(gov *governance.Governance, number uint64, hash common.Hash, va
 		CommitteeSize: committeeSize,
 		Votes:         make([]governance.GovernanceVote, 0),
 		Tally:         make([]governance.GovernanceTallyItem, 0),
+		BLSPublicKeys: make(map[common.Address][]byte),
+		Duties:        make(map[common.Address]*validatorDuties),
+		Demotions:     make(map[common.Address]*demotion),
 	}
 	return snap
 }
@@ -125,10 +133,22 @@ func (s *Snapshot) copy() *Snapshot {
 		CommitteeSize: s.CommitteeSize,
 		Votes:         make([]governance.GovernanceVote, len(s.Votes)),
 		Tally:         make([]governance.GovernanceTallyItem, len(s.Tally)),
+		BLSPublicKeys: make(map[common.Address][]byte, len(s.BLSPublicKeys)),
+		Duties:        make(map[common.Address]*validatorDuties, len(s.Duties)),
+		Demotions:     make(map[common.Address]*demotion, len(s.Demotions)),
 	}
 
 	copy(cpy.Votes, s.Votes)
 	copy(cpy.Tally, s.Tally)
+	for addr, key := range s.BLSPublicKeys {
+		cpy.BLSPublicKeys[addr] = key
+	}
+	for addr, d := range s.Duties {
+		cpy.Duties[addr] = &validatorDuties{Duties: d.Duties, Misses: d.Misses}
+	}
+	for addr, d := range s.Demotions {
+		cpy.Demotions[addr] = &demotion{Until: d.Until, Count: d.Count}
+	}
 
 	return cpy
 }
@@ -162,6 +182,8 @@ func (s *Snapshot) apply(headers []*types.Header, gov *governance.Governance, ad
 	// Copy values which might be changed by governance vote
 	snap.Epoch, snap.Policy, snap.CommitteeSize = getGovernanceValue(gov, snap.Number)
 
+	// The last proposer is only used to count the duties of the weighted random policy, which does not depend on it.
+	var lastProposer common.Address
 	for _, header := range headers {
 		// Remove any votes on checkpoint blocks
 		number := header.Number.Uint64()
@@ -175,6 +197,23 @@ func (s *Snapshot) apply(headers []*types.Header, gov *governance.Governance, ad
 			return nil, errUnauthorized
 		}
 
+		// The duties of the validators are counted with the validators of the parent block
+		if snap.ValSet.Policy() == istanbul.WeightedRandom && gov.DemotionThreshold(number) > 0 {
+			snap.ValSet.SetBlockNum(number - 1)
+			snap.ValSet.SetSubGroupSize(snap.CommitteeSize)
+			p, err := snap.calcParticipation(header, validator, lastProposer)
+			if err != nil {
+				return nil, err
+			}
+			snap.countDuties(p)
+		}
+		lastProposer = validator
+
+		// The BLS public key in the header is registered for its proposer, which is verified with the header
+		if extra, err := types.ExtractIstanbulExtra(header); err == nil && len(extra.BLSPublicKey) > 0 {
+			snap.BLSPublicKeys[validator] = extra.BLSPublicKey
+		}
+
 		snap.ValSet, snap.Votes, snap.Tally = gov.HandleGovernanceVote(snap.ValSet, snap.Votes, snap.Tally, header, validator, addr)
 
 		if number%snap.Epoch == 0 {
@@ -183,11 +222,22 @@ func (s *Snapshot) apply(headers []*types.Header, gov *governance.Governance, ad
 			}
 			gov.UpdateCurrentGovernance(number)
 			gov.ClearVotes(number)
+			gov.ApplyScheduledChanges(number)
 
 			// Reload governance values because epoch changed
 			snap.Epoch, snap.Policy, snap.CommitteeSize = getGovernanceValue(gov, number)
 			snap.Votes = make([]governance.GovernanceVote, 0)
 			snap.Tally = make([]governance.GovernanceTallyItem, 0)
+
+			snap.updateDemotions(number, gov.DemotionThreshold(number))
+		}
+
+		// Proposers are refreshed at the proposer update block as snapshot() does, so that the duties of
+		// the following blocks are counted with the same proposers regardless of how many headers are applied at once.
+		if snap.ValSet.Policy() == istanbul.WeightedRandom && params.CalcProposerBlockNumber(number+1) == number {
+			if err := snap.ValSet.Refresh(header.Hash(), number); err != nil {
+				logger.Trace("Skip refreshing proposers while applying headers", "number", number, "err", err)
+			}
 		}
 	}
 	snap.Number += uint64(len(headers))
@@ -262,6 +312,12 @@ type snapshotJSON struct {
 	Weights           []uint64         `json:"weight"`
 	Proposers         []common.Address `json:"proposers"`
 	ProposersBlockNum uint64           `json:"proposersBlockNum"`
+	Demoted           []common.Address `json:"demoted,omitempty"`
+
+	BLSPublicKeys map[common.Address]hexutil.Bytes `json:"blsPublicKeys,omitempty"`
+
+	Duties    map[common.Address]*validatorDuties `json:"duties,omitempty"`
+	Demotions map[common.Address]*demotion        `json:"demotions,omitempty"`
 }
 
 func (s *Snapshot) toJSONStruct() *snapshotJSON {
@@ -271,10 +327,17 @@ func (s *Snapshot) toJSONStruct() *snapshotJSON {
 	var proposers []common.Address
 	var proposersBlockNum uint64
 	var validators []common.Address
+	var demoted []common.Address
+
+	blsPublicKeys := make(map[common.Address]hexutil.Bytes, len(s.BLSPublicKeys))
+	for addr, key := range s.BLSPublicKeys {
+		blsPublicKeys[addr] = key
+	}
 
 	// TODO-Klaytn-Issue1166 For weightedCouncil
 	if s.ValSet.Policy() == istanbul.WeightedRandom {
 		validators, rewardAddrs, votingPowers, weights, proposers, proposersBlockNum = validator.GetWeightedCouncilData(s.ValSet)
+		demoted = validator.GetDemotedValidators(s.ValSet)
 	} else {
 		validators = s.validators()
 	}
@@ -293,6 +356,10 @@ func (s *Snapshot) toJSONStruct() *snapshotJSON {
 		Weights:           weights,
 		Proposers:         proposers,
 		ProposersBlockNum: proposersBlockNum,
+		Demoted:           demoted,
+		BLSPublicKeys:     blsPublicKeys,
+		Duties:            s.Duties,
+		Demotions:         s.Demotions,
 	}
 }
 
@@ -308,11 +375,24 @@ func (s *Snapshot) UnmarshalJSON(b []byte) error {
 	s.Hash = j.Hash
 	s.Votes = j.Votes
 	s.Tally = j.Tally
+	s.BLSPublicKeys = make(map[common.Address][]byte, len(j.BLSPublicKeys))
+	for addr, key := range j.BLSPublicKeys {
+		s.BLSPublicKeys[addr] = key
+	}
+	s.Duties = j.Duties
+	if s.Duties == nil {
+		s.Duties = make(map[common.Address]*validatorDuties)
+	}
+	s.Demotions = j.Demotions
+	if s.Demotions == nil {
+		s.Demotions = make(map[common.Address]*demotion)
+	}
 
 	// TODO-Klaytn-Issue1166 For weightedCouncil
 	if j.Policy == istanbul.WeightedRandom {
 		s.ValSet = validator.NewWeightedCouncil(j.Validators, j.RewardAddrs, j.VotingPowers, j.Weights, j.Policy, j.SubGroupSize, j.Number, j.ProposersBlockNum, nil)
 		validator.RecoverWeightedCouncilProposer(s.ValSet, j.Proposers)
+		validator.SetDemotedValidators(s.ValSet, j.Demoted)
 	} else {
 		s.ValSet = validator.NewSubSet(j.Validators, j.Policy, j.SubGroupSize)
 	}
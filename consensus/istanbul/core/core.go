@@ -22,7 +22,6 @@ package core
 
 import (
 	"bytes"
-	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/consensus/istanbul"
 	"github.com/klaytn/klaytn/event"
@@ -116,8 +115,7 @@ func (c *core) finalizeMessage(msg *message) ([]byte, error) {
 	msg.CommittedSeal = []byte{}
 	// Assign the CommittedSeal if it's a COMMIT message and proposal is not nil
 	if msg.Code == msgCommit && c.current.Proposal() != nil {
-		seal := PrepareCommittedSeal(c.current.Proposal().Hash())
-		msg.CommittedSeal, err = c.backend.Sign(seal)
+		msg.CommittedSeal, err = c.backend.SignCommittedSeal(c.current.Proposal())
 		if err != nil {
 			return nil, err
 		}
@@ -179,12 +177,14 @@ func (c *core) commit() {
 	proposal := c.current.Proposal()
 	if proposal != nil {
 		committedSeals := make([][]byte, c.current.Commits.Size())
+		committers := make([]common.Address, c.current.Commits.Size())
 		for i, v := range c.current.Commits.Values() {
-			committedSeals[i] = make([]byte, types.IstanbulExtraSeal)
-			copy(committedSeals[i][:], v.CommittedSeal[:])
+			// the length of a seal depends on its signature scheme
+			committedSeals[i] = common.CopyBytes(v.CommittedSeal)
+			committers[i] = v.Address
 		}
 
-		if err := c.backend.Commit(proposal, committedSeals); err != nil {
+		if err := c.backend.Commit(proposal, committedSeals, committers); err != nil {
 			c.current.UnlockHash() //Unlock block when insertion fails
 			c.sendNextRoundChange("commit failure")
 			return
@@ -64,6 +64,7 @@ func newMockBackend(t *testing.T, validatorAddrs []common.Address) (*mock_istanb
 
 	// Always return nil for broadcasting related functions
 	mockBackend.EXPECT().Sign(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockBackend.EXPECT().SignCommittedSeal(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockBackend.EXPECT().Broadcast(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockBackend.EXPECT().GossipSubPeer(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
@@ -80,17 +80,17 @@ func (mr *MockBackendMockRecorder) CheckSignature(arg0, arg1, arg2 interface{})
 }
 
 // Commit mocks base method
-func (m *MockBackend) Commit(arg0 istanbul.Proposal, arg1 [][]byte) error {
+func (m *MockBackend) Commit(arg0 istanbul.Proposal, arg1 [][]byte, arg2 []common.Address) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Commit", arg0, arg1)
+	ret := m.ctrl.Call(m, "Commit", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Commit indicates an expected call of Commit
-func (mr *MockBackendMockRecorder) Commit(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Commit(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockBackend)(nil).Commit), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockBackend)(nil).Commit), arg0, arg1, arg2)
 }
 
 // EventMux mocks base method
@@ -275,6 +275,21 @@ func (mr *MockBackendMockRecorder) Sign(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockBackend)(nil).Sign), arg0)
 }
 
+// SignCommittedSeal mocks base method
+func (m *MockBackend) SignCommittedSeal(arg0 istanbul.Proposal) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignCommittedSeal", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignCommittedSeal indicates an expected call of SignCommittedSeal
+func (mr *MockBackendMockRecorder) SignCommittedSeal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignCommittedSeal", reflect.TypeOf((*MockBackend)(nil).SignCommittedSeal), arg0)
+}
+
 // Validators mocks base method
 func (m *MockBackend) Validators(arg0 istanbul.Proposal) istanbul.ValidatorSet {
 	m.ctrl.T.Helper()
@@ -108,6 +108,8 @@ type weightedCouncil struct {
 	stakingInfo *reward.StakingInfo
 
 	blockNum uint64 // block number when council is determined
+
+	demoted []common.Address // validators excluded from proposers and committees due to missed duties
 }
 
 func RecoverWeightedCouncilProposer(valSet istanbul.ValidatorSet, proposerAddrs []common.Address) {
@@ -246,6 +248,38 @@ func GetWeightedCouncilData(valSet istanbul.ValidatorSet) (validators []common.A
 	return
 }
 
+// SetDemotedValidators sets the validators excluded from proposers and committees.
+// The proposers excluding them are calculated at the next Refresh.
+func SetDemotedValidators(valSet istanbul.ValidatorSet, demoted []common.Address) {
+	weightedCouncil, ok := valSet.(*weightedCouncil)
+	if !ok {
+		logger.Error("Not weightedCouncil type. Return without setting demoted validators.")
+		return
+	}
+
+	weightedCouncil.validatorMu.Lock()
+	defer weightedCouncil.validatorMu.Unlock()
+
+	weightedCouncil.demoted = make([]common.Address, len(demoted))
+	copy(weightedCouncil.demoted, demoted)
+}
+
+// GetDemotedValidators returns the validators excluded from proposers and committees.
+func GetDemotedValidators(valSet istanbul.ValidatorSet) []common.Address {
+	weightedCouncil, ok := valSet.(*weightedCouncil)
+	if !ok {
+		logger.Error("Not weightedCouncil type. Return without getting demoted validators.")
+		return nil
+	}
+
+	weightedCouncil.validatorMu.RLock()
+	defer weightedCouncil.validatorMu.RUnlock()
+
+	demoted := make([]common.Address, len(weightedCouncil.demoted))
+	copy(demoted, weightedCouncil.demoted)
+	return demoted
+}
+
 func weightedRandomProposer(valSet istanbul.ValidatorSet, lastProposer common.Address, round uint64) istanbul.Validator {
 	weightedCouncil, ok := valSet.(*weightedCouncil)
 	if !ok {
@@ -319,7 +353,7 @@ func (valSet *weightedCouncil) SubListWithProposer(prevHash common.Hash, propose
 
 	// return early if the committee size is equal or larger than the validator size
 	if committeeSize >= validatorSize {
-		return validators
+		return valSet.activeValidators(validators, proposerAddr, proposerAddr)
 	}
 
 	// find the proposer
@@ -351,7 +385,15 @@ func (valSet *weightedCouncil) SubListWithProposer(prevHash common.Hash, propose
 		}
 		idx++
 	}
-	nextProposerIdx, _ := valSet.getByAddress(nextProposer.Address())
+
+	// exclude the demoted validators
+	validators = valSet.activeValidators(validators, proposerAddr, nextProposer.Address())
+	validatorSize = uint64(len(validators))
+	if committeeSize >= validatorSize {
+		return validators
+	}
+	proposerIdx = indexOfValidator(validators, proposerAddr)
+	nextProposerIdx := indexOfValidator(validators, nextProposer.Address())
 	if nextProposerIdx < 0 {
 		logger.Error("invalid index of the next proposer",
 			"addr", nextProposer.Address().String(), "index", nextProposerIdx)
@@ -377,6 +419,42 @@ func (valSet *weightedCouncil) SubListWithProposer(prevHash common.Hash, propose
 	return committee
 }
 
+// activeValidators returns the given validators except the demoted ones.
+// The proposers selected before the demotion can still be demoted ones until the proposers are refreshed,
+// so the demoted validators are kept in the committee if the proposer or the next proposer is demoted.
+func (valSet *weightedCouncil) activeValidators(validators istanbul.Validators, proposerAddr common.Address, nextProposerAddr common.Address) istanbul.Validators {
+	if len(valSet.demoted) == 0 || valSet.isDemoted(proposerAddr) || valSet.isDemoted(nextProposerAddr) {
+		return validators
+	}
+	active := make(istanbul.Validators, 0, len(validators))
+	for _, val := range validators {
+		if !valSet.isDemoted(val.Address()) {
+			active = append(active, val)
+		}
+	}
+	return active
+}
+
+// indexOfValidator returns the index of the validator of the given address, or -1 if not found.
+func indexOfValidator(validators istanbul.Validators, addr common.Address) int {
+	for i, val := range validators {
+		if val.Address() == addr {
+			return i
+		}
+	}
+	return -1
+}
+
+// isDemoted returns true if the validator of the given address is demoted.
+func (valSet *weightedCouncil) isDemoted(addr common.Address) bool {
+	for _, demoted := range valSet.demoted {
+		if demoted == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func (valSet *weightedCouncil) CheckInSubList(prevHash common.Hash, view *istanbul.View, addr common.Address) bool {
 	for _, val := range valSet.SubList(prevHash, view) {
 		if val.Address() == addr {
@@ -540,6 +618,9 @@ func (valSet *weightedCouncil) Copy() istanbul.ValidatorSet {
 	newWeightedCouncil.proposers = make([]istanbul.Validator, len(valSet.proposers))
 	copy(newWeightedCouncil.proposers, valSet.proposers)
 
+	newWeightedCouncil.demoted = make([]common.Address, len(valSet.demoted))
+	copy(newWeightedCouncil.demoted, valSet.demoted)
+
 	return &newWeightedCouncil
 }
 
@@ -708,6 +789,9 @@ func (valSet *weightedCouncil) refreshProposers(seed int64, blockNum uint64) {
 	var candidateValsIdx []int // This is a slice which stores index of validator. it is used for shuffling
 
 	for index, val := range valSet.validators {
+		if valSet.isDemoted(val.Address()) {
+			continue
+		}
 		weight := val.Weight()
 		for i := uint64(0); i < weight; i++ {
 			candidateValsIdx = append(candidateValsIdx, index)
@@ -716,8 +800,10 @@ func (valSet *weightedCouncil) refreshProposers(seed int64, blockNum uint64) {
 
 	if len(candidateValsIdx) == 0 {
 		// All validators has zero weight. Let's use all validators as candidate proposers.
-		for index := 0; index < len(valSet.validators); index++ {
-			candidateValsIdx = append(candidateValsIdx, index)
+		for index, val := range valSet.validators {
+			if !valSet.isDemoted(val.Address()) {
+				candidateValsIdx = append(candidateValsIdx, index)
+			}
 		}
 		logger.Trace("Refresh uses all validators as candidate proposers, because all weight is zero.", "candidateValsIdx", candidateValsIdx)
 	}
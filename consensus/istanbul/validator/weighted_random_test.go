@@ -469,8 +469,86 @@ func TestWeightedCouncil_SubListWithProposer(t *testing.T) {
 	}
 }
 
+func TestWeightedCouncil_RefreshWithDemotedValidators(t *testing.T) {
+	valSet := makeTestWeightedCouncil(testNonZeroWeights)
+	demoted := []common.Address{testAddrs[7], testAddrs[12]}
+	SetDemotedValidators(valSet, demoted)
+	runRefreshForTest(valSet)
+
+	assert.Equal(t, demoted, GetDemotedValidators(valSet))
+
+	// demoted validators are excluded from proposers regardless of their weights
+	assert.NotEqual(t, 0, len(valSet.proposers))
+	for _, p := range valSet.proposers {
+		assert.NotContains(t, demoted, p.Address())
+	}
+
+	// demoted validators are still validators
+	for _, addr := range demoted {
+		_, val := valSet.GetByAddress(addr)
+		assert.NotNil(t, val)
+	}
+
+	// demoted validators are reinstated at the next refresh
+	SetDemotedValidators(valSet, nil)
+	runRefreshForTest(valSet)
+
+	assert.Equal(t, 0, len(GetDemotedValidators(valSet)))
+	proposerAddrs := make([]common.Address, len(valSet.proposers))
+	for i, p := range valSet.proposers {
+		proposerAddrs[i] = p.Address()
+	}
+	for _, addr := range demoted {
+		assert.Contains(t, proposerAddrs, addr)
+	}
+}
+
+func TestWeightedCouncil_SubListWithDemotedValidators(t *testing.T) {
+	valSet := makeTestWeightedCouncil(testNonZeroWeights)
+	demoted := []common.Address{testAddrs[7], testAddrs[12]}
+	SetDemotedValidators(valSet, demoted)
+	runRefreshForTest(valSet)
+
+	valSet.SetBlockNum(1)
+	view := &istanbul.View{
+		Sequence: new(big.Int).SetInt64(1),
+		Round:    new(big.Int).SetInt64(0),
+	}
+	prevHash := crypto.Keccak256Hash([]byte("This is a test"))
+	valSet.CalcProposer(common.Address{}, 0)
+	proposer := valSet.GetProposer().Address()
+
+	// demoted validators are excluded from committees of any size
+	numActive := len(testAddrs) - len(demoted)
+	for size := 2; size <= len(testAddrs); size++ {
+		valSet.SetSubGroupSize(uint64(size))
+		committee := valSet.SubListWithProposer(prevHash, proposer, view)
+		expectedSize := size
+		if expectedSize > numActive {
+			expectedSize = numActive
+		}
+		assert.Equal(t, expectedSize, len(committee))
+		assert.Contains(t, istanbul.Validators(committee).AddressStringList(), proposer.String())
+		for _, addr := range demoted {
+			assert.NotContains(t, istanbul.Validators(committee).AddressStringList(), addr.String())
+		}
+	}
+
+	// demoted validators are kept in committees if the proposers are not refreshed after the demotion
+	staleValSet := makeTestWeightedCouncil(testNonZeroWeights)
+	runRefreshForTest(staleValSet)
+	staleValSet.SetBlockNum(1)
+	staleValSet.CalcProposer(common.Address{}, 0)
+	staleProposer := staleValSet.GetProposer().Address()
+	SetDemotedValidators(staleValSet, []common.Address{staleProposer})
+	staleValSet.SetSubGroupSize(uint64(len(testAddrs)))
+	committee := staleValSet.SubListWithProposer(prevHash, staleProposer, view)
+	assert.Equal(t, len(testAddrs), len(committee))
+}
+
 func TestWeightedCouncil_Copy(t *testing.T) {
 	valSet := makeTestWeightedCouncil(testNonZeroWeights)
+	SetDemotedValidators(valSet, []common.Address{testAddrs[0]})
 
 	copiedValSet := valSet.Copy().(*weightedCouncil)
 
@@ -480,7 +558,8 @@ func TestWeightedCouncil_Copy(t *testing.T) {
 		valSet.proposersBlockNum != copiedValSet.proposersBlockNum ||
 		!reflect.DeepEqual(valSet.validators, copiedValSet.validators) ||
 		!reflect.DeepEqual(valSet.proposers, copiedValSet.proposers) ||
-		!reflect.DeepEqual(valSet.stakingInfo, copiedValSet.stakingInfo) {
+		!reflect.DeepEqual(valSet.stakingInfo, copiedValSet.stakingInfo) ||
+		!reflect.DeepEqual(valSet.demoted, copiedValSet.demoted) {
 		t.Errorf("copied weightedCouncil is different from original.")
 		t.Errorf("block number. original : %v, Copied : %v", valSet.blockNum, copiedValSet.blockNum)
 		t.Errorf("proposer. original : %v, Copied : %v", valSet.GetProposer(), copiedValSet.GetProposer())
@@ -490,5 +569,6 @@ func TestWeightedCouncil_Copy(t *testing.T) {
 		t.Errorf("validators. original : %v, Copied : %v", valSet.validators, copiedValSet.validators)
 		t.Errorf("proposers. original : %v, Copied : %v", valSet.proposers, copiedValSet.proposers)
 		t.Errorf("staking. original : %v, Copied : %v", valSet.stakingInfo, copiedValSet.stakingInfo)
+		t.Errorf("demoted. original : %v, Copied : %v", valSet.demoted, copiedValSet.demoted)
 	}
 }
@@ -171,6 +171,16 @@ web3._extend({
 			call: 'governance_vote',
 			params: 2
 		}),
+		new web3._extend.Method({
+			name: 'voteAt',
+			call: 'governance_voteAt',
+			params: 3
+		}),
+		new web3._extend.Method({
+			name: 'cancelScheduledChange',
+			call: 'governance_cancelScheduledChange',
+			params: 2
+		}),
 		new web3._extend.Method({
 			name: 'itemsAt',
 			call: 'governance_itemsAt',
@@ -182,6 +192,18 @@ web3._extend({
 			call: 'governance_itemCacheFromDb',
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'paramHistory',
+			call: 'governance_paramHistory',
+			params: 3,
+			inputFormatter: [null, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getStakingInfo',
+			call: 'governance_getStakingInfo',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 		})
 	],
 	properties: [
@@ -550,6 +572,12 @@ web3._extend({
 			params: 2,
 			inputFormatter: [null, null]
 		}),
+		new web3._extend.Method({
+			name: 'profileBlocks',
+			call: 'debug_profileBlocks',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter, null]
+		}),
 		new web3._extend.Method({
 			name: 'preimage',
 			call: 'debug_preimage',
@@ -561,6 +589,11 @@ web3._extend({
 			call: 'debug_getBadBlocks',
 			params: 0,
 		}),
+		new web3._extend.Method({
+			name: 'blockExecutionProfile',
+			call: 'debug_blockExecutionProfile',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'storageRangeAt',
 			call: 'debug_storageRangeAt',
@@ -666,6 +699,18 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getRewards',
+			call: 'klay_getRewards',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getRewardsAccumulated',
+			call: 'klay_getRewardsAccumulated',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'gasPriceAt',
 			call: 'klay_gasPriceAt',
@@ -905,7 +950,36 @@ web3._extend({
 const TxPool_JS = `
 web3._extend({
 	property: 'txpool',
-	methods: [],
+	methods: [
+		new web3._extend.Method({
+			name: 'replace',
+			call: 'txpool_replace',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'remove',
+			call: 'txpool_remove',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'removeSender',
+			call: 'txpool_removeSender',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'contentFrom',
+			call: 'txpool_contentFrom',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, null]
+		}),
+		new web3._extend.Method({
+			name: 'contentByFeePayer',
+			call: 'txpool_contentByFeePayer',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, null]
+		}),
+	],
 	properties:
 	[
 		new web3._extend.Property({
@@ -925,6 +999,22 @@ web3._extend({
 				return status;
 			}
 		}),
+		new web3._extend.Property({
+			name: 'summary',
+			getter: 'txpool_summary'
+		}),
+		new web3._extend.Property({
+			name: 'priorityStatus',
+			getter: 'txpool_priorityStatus',
+			outputFormatter: function(classes) {
+				for (var i = 0; i < classes.length; i++) {
+					classes[i].slots = web3._extend.utils.toDecimal(classes[i].slots);
+					classes[i].pending = web3._extend.utils.toDecimal(classes[i].pending);
+					classes[i].queued = web3._extend.utils.toDecimal(classes[i].queued);
+				}
+				return classes;
+			}
+		}),
 	]
 });
 `
@@ -960,6 +1050,12 @@ web3._extend({
 			name: 'discard',
 			call: 'istanbul_discard',
 			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getParticipation',
+			call: 'istanbul_getParticipation',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		})
 	],
 	properties:
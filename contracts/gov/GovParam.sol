@@ -0,0 +1,86 @@
+pragma solidity ^0.5.6;
+
+/// @title GovParam
+/// @notice The governance contract read by the nodes in the "contract" governance mode.
+/// The voters propose a parameter change and vote on it. A proposal approved by the
+/// majority of the voters is executed, and the parameter is applied by the nodes from
+/// the next epoch block.
+/// @dev The nodes read paramNames and paramValues from the storage of the contract,
+/// so the two variables must stay at the storage slots 0 and 1. A value is encoded
+/// as a vote value in a block header: a big-endian integer for a number or a boolean,
+/// 20 bytes for an address and the raw bytes for a string.
+contract GovParam {
+    // The keys of the parameters ever set, such as "governance.unitprice"
+    string[] public paramNames;
+    // The current value of each parameter
+    mapping(string => bytes) public paramValues;
+
+    struct Proposal {
+        string name;
+        bytes value;
+        uint256 approvals;
+        bool executed;
+        mapping(address => bool) voted;
+    }
+
+    mapping(address => bool) public isVoter;
+    uint256 public voterCount;
+    Proposal[] public proposals;
+
+    event ProposalCreated(uint256 indexed id, string name, bytes value);
+    event Voted(uint256 indexed id, address indexed voter);
+    event ParamChanged(string name, bytes value);
+
+    modifier onlyVoter() {
+        require(isVoter[msg.sender], "not a voter");
+        _;
+    }
+
+    constructor(address[] memory voters) public {
+        for (uint256 i = 0; i < voters.length; i++) {
+            if (!isVoter[voters[i]]) {
+                isVoter[voters[i]] = true;
+                voterCount++;
+            }
+        }
+        require(voterCount > 0, "no voter");
+    }
+
+    /// @notice Proposes to change a parameter, voting for it.
+    function propose(string calldata name, bytes calldata value) external onlyVoter returns (uint256 id) {
+        require(value.length > 0, "empty value");
+        id = proposals.length;
+        proposals.push(Proposal(name, value, 0, false));
+        emit ProposalCreated(id, name, value);
+        vote(id);
+    }
+
+    /// @notice Votes for a proposal. The proposal is executed when the majority of the voters approve it.
+    function vote(uint256 id) public onlyVoter {
+        require(id < proposals.length, "unknown proposal");
+        Proposal storage p = proposals[id];
+        require(!p.executed, "already executed");
+        require(!p.voted[msg.sender], "already voted");
+
+        p.voted[msg.sender] = true;
+        p.approvals++;
+        emit Voted(id, msg.sender);
+
+        if (p.approvals * 2 > voterCount) {
+            p.executed = true;
+            setParam(p.name, p.value);
+        }
+    }
+
+    function setParam(string memory name, bytes memory value) internal {
+        if (paramValues[name].length == 0) {
+            paramNames.push(name);
+        }
+        paramValues[name] = value;
+        emit ParamChanged(name, value);
+    }
+
+    function paramCount() external view returns (uint256) {
+        return paramNames.length;
+    }
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blake2b
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/blake2b"
+)
+
+// TestF checks that a single final block compressed with 12 rounds produces the
+// BLAKE2b-512 digest of the block.
+func TestF(t *testing.T) {
+	for _, input := range [][]byte{{}, []byte("abc"), make([]byte, 128)} {
+		h := iv
+		h[0] ^= 0x01010000 ^ blake2b.Size // no key, 64 bytes output
+
+		var block [128]byte
+		copy(block[:], input)
+		var m [16]uint64
+		for i := range m {
+			m[i] = binary.LittleEndian.Uint64(block[i*8:])
+		}
+		F(&h, m, [2]uint64{uint64(len(input)), 0}, true, 12)
+
+		var digest [blake2b.Size]byte
+		for i, w := range h {
+			binary.LittleEndian.PutUint64(digest[i*8:], w)
+		}
+		assert.Equal(t, blake2b.Sum512(input), digest)
+	}
+}
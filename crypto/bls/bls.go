@@ -0,0 +1,251 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package bls
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/kilic/bls12-381"
+)
+
+const (
+	SecretKeyLength = 32 // The length of a serialized secret key
+	PublicKeyLength = 48 // The length of a compressed public key in G1
+	SignatureLength = 96 // The length of a compressed signature in G2
+)
+
+var (
+	// Domain separation tags of the proof of possession scheme
+	signatureDST  = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	possessionDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	keyDerivationSalt = []byte("KLAYTN-BLS-KEYGEN")
+
+	// The order of G1 and G2
+	curveOrder = bls12381.NewG1().Q()
+
+	ErrInvalidSecretKey = errors.New("invalid BLS secret key")
+	ErrInvalidPublicKey = errors.New("invalid BLS public key")
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+	ErrEmptyAggregation = errors.New("nothing to aggregate")
+)
+
+// SecretKey is a BLS secret key, a non-zero scalar less than the curve order.
+type SecretKey struct {
+	k *big.Int
+}
+
+// PublicKey is a BLS public key, a point in G1.
+type PublicKey struct {
+	p *bls12381.PointG1
+}
+
+// Signature is a BLS signature or an aggregation of signatures, a point in G2.
+type Signature struct {
+	p *bls12381.PointG2
+}
+
+// GenerateKey generates a secret key with the given random source.
+func GenerateKey(rand io.Reader) (*SecretKey, error) {
+	for {
+		k, err := bls12381.NewFr().Rand(rand)
+		if err != nil {
+			return nil, err
+		}
+		if !k.IsZero() {
+			return &SecretKey{k: k.ToBig()}, nil
+		}
+	}
+}
+
+// DeriveKey derives a secret key from the given secret seed deterministically.
+// The same seed always results in the same key, so the seed must be kept as secret as the key.
+func DeriveKey(seed []byte) *SecretKey {
+	var counter [4]byte
+	for i := uint32(0); ; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		h := sha512.New()
+		h.Write(keyDerivationSalt)
+		h.Write(seed)
+		h.Write(counter[:])
+
+		// a 512-bit hash makes the bias of the modular reduction negligible
+		k := new(big.Int).SetBytes(h.Sum(nil))
+		k.Mod(k, curveOrder)
+		if k.Sign() != 0 {
+			return &SecretKey{k: k}
+		}
+	}
+}
+
+// SecretKeyFromBytes returns the secret key of the given big-endian bytes.
+func SecretKeyFromBytes(b []byte) (*SecretKey, error) {
+	if len(b) != SecretKeyLength {
+		return nil, ErrInvalidSecretKey
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Sign() == 0 || k.Cmp(curveOrder) >= 0 {
+		return nil, ErrInvalidSecretKey
+	}
+	return &SecretKey{k: k}, nil
+}
+
+// Bytes returns the big-endian bytes of the secret key.
+func (sk *SecretKey) Bytes() []byte {
+	b := make([]byte, SecretKeyLength)
+	kb := sk.k.Bytes()
+	copy(b[SecretKeyLength-len(kb):], kb)
+	return b
+}
+
+// PublicKey returns the public key of the secret key.
+func (sk *SecretKey) PublicKey() *PublicKey {
+	g1 := bls12381.NewG1()
+	p := g1.New()
+	g1.MulScalarBig(p, g1.One(), sk.k)
+	return &PublicKey{p: g1.Affine(p)}
+}
+
+// Sign signs the given message.
+func (sk *SecretKey) Sign(msg []byte) *Signature {
+	return sk.sign(msg, signatureDST)
+}
+
+// ProvePossession returns the proof of possession of the secret key, which is a signature
+// of the public key followed by the given context. It must be checked before the public key is
+// aggregated with others, to prevent an attacker from registering a public key cancelling the others out.
+// The context binds the proof to its owner, e.g. an address, so that others can't replay the proof.
+func (sk *SecretKey) ProvePossession(context []byte) *Signature {
+	return sk.sign(append(sk.PublicKey().Bytes(), context...), possessionDST)
+}
+
+func (sk *SecretKey) sign(msg []byte, dst []byte) *Signature {
+	g2 := bls12381.NewG2()
+	h, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		// It can't be happened since the domain separation tags are short enough.
+		panic(err)
+	}
+	p := g2.New()
+	g2.MulScalarBig(p, h, sk.k)
+	return &Signature{p: g2.Affine(p)}
+}
+
+// PublicKeyFromBytes returns the public key of the given compressed bytes.
+// It returns an error if the bytes are not a point in G1 or the point is the identity.
+func PublicKeyFromBytes(b []byte) (*PublicKey, error) {
+	if len(b) != PublicKeyLength {
+		return nil, ErrInvalidPublicKey
+	}
+	g1 := bls12381.NewG1()
+	p, err := g1.FromCompressed(b)
+	if err != nil || g1.IsZero(p) || !g1.InCorrectSubgroup(p) {
+		return nil, ErrInvalidPublicKey
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// Bytes returns the compressed bytes of the public key.
+func (pk *PublicKey) Bytes() []byte {
+	return bls12381.NewG1().ToCompressed(pk.p)
+}
+
+// VerifyPossession checks whether the given proof of possession of the context is signed by the secret key of the public key.
+func (pk *PublicKey) VerifyPossession(proof *Signature, context []byte) bool {
+	return verify(pk.p, append(pk.Bytes(), context...), possessionDST, proof)
+}
+
+// SignatureFromBytes returns the signature of the given compressed bytes.
+// It returns an error if the bytes are not a point in G2.
+func SignatureFromBytes(b []byte) (*Signature, error) {
+	if len(b) != SignatureLength {
+		return nil, ErrInvalidSignature
+	}
+	g2 := bls12381.NewG2()
+	p, err := g2.FromCompressed(b)
+	if err != nil || !g2.InCorrectSubgroup(p) {
+		return nil, ErrInvalidSignature
+	}
+	return &Signature{p: p}, nil
+}
+
+// Bytes returns the compressed bytes of the signature.
+func (sig *Signature) Bytes() []byte {
+	return bls12381.NewG2().ToCompressed(sig.p)
+}
+
+// Verify checks whether the signature of the given message is signed by the secret key of the public key.
+func Verify(pk *PublicKey, msg []byte, sig *Signature) bool {
+	return verify(pk.p, msg, signatureDST, sig)
+}
+
+// AggregateSignatures returns a signature aggregating all the given signatures.
+func AggregateSignatures(sigs []*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, ErrEmptyAggregation
+	}
+	g2 := bls12381.NewG2()
+	p := g2.Zero()
+	for _, sig := range sigs {
+		g2.Add(p, p, sig.p)
+	}
+	return &Signature{p: g2.Affine(p)}, nil
+}
+
+// AggregatePublicKeys returns a public key aggregating all the given public keys.
+// The possession of all the public keys must be proven beforehand.
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, ErrEmptyAggregation
+	}
+	g1 := bls12381.NewG1()
+	p := g1.Zero()
+	for _, pk := range pks {
+		g1.Add(p, p, pk.p)
+	}
+	return &PublicKey{p: g1.Affine(p)}, nil
+}
+
+// FastAggregateVerify checks whether the aggregated signature of the given message is
+// signed by all the secret keys of the public keys.
+func FastAggregateVerify(pks []*PublicKey, msg []byte, sig *Signature) bool {
+	aggregated, err := AggregatePublicKeys(pks)
+	if err != nil {
+		return false
+	}
+	return verify(aggregated.p, msg, signatureDST, sig)
+}
+
+// verify checks e(pk, H(msg)) == e(g1, sig).
+func verify(pk *bls12381.PointG1, msg []byte, dst []byte, sig *Signature) bool {
+	g1, g2 := bls12381.NewG1(), bls12381.NewG2()
+	if g1.IsZero(pk) {
+		return false
+	}
+	h, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return false
+	}
+	engine := bls12381.NewEngine()
+	engine.AddPair(pk, h)
+	engine.AddPairInv(g1.One(), sig.p)
+	return engine.Check()
+}
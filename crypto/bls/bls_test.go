@@ -0,0 +1,187 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+var testMsg = []byte("klaytn committed seal")
+
+func generateTestKeys(t *testing.T, n int) []*SecretKey {
+	sks := make([]*SecretKey, n)
+	for i := range sks {
+		sk, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sks[i] = sk
+	}
+	return sks
+}
+
+func TestSignAndVerify(t *testing.T) {
+	sks := generateTestKeys(t, 2)
+
+	sig := sks[0].Sign(testMsg)
+	if !Verify(sks[0].PublicKey(), testMsg, sig) {
+		t.Error("failed to verify a valid signature")
+	}
+	if Verify(sks[1].PublicKey(), testMsg, sig) {
+		t.Error("verified a signature with a wrong public key")
+	}
+	if Verify(sks[0].PublicKey(), []byte("another message"), sig) {
+		t.Error("verified a signature of a wrong message")
+	}
+
+	// A proof of possession is not a signature of the public key
+	if Verify(sks[0].PublicKey(), sks[0].PublicKey().Bytes(), sks[0].ProvePossession(nil)) {
+		t.Error("verified a proof of possession as a signature")
+	}
+}
+
+func TestPossession(t *testing.T) {
+	sks := generateTestKeys(t, 2)
+
+	context := []byte("owner")
+
+	if !sks[0].PublicKey().VerifyPossession(sks[0].ProvePossession(context), context) {
+		t.Error("failed to verify a valid proof of possession")
+	}
+	if sks[0].PublicKey().VerifyPossession(sks[1].ProvePossession(context), context) {
+		t.Error("verified a proof of possession of another key")
+	}
+	if sks[0].PublicKey().VerifyPossession(sks[0].ProvePossession(context), []byte("another owner")) {
+		t.Error("verified a proof of possession of another context")
+	}
+	if sks[0].PublicKey().VerifyPossession(sks[0].Sign(append(sks[0].PublicKey().Bytes(), context...)), context) {
+		t.Error("verified a signature as a proof of possession")
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	sks := generateTestKeys(t, 4)
+	pks := make([]*PublicKey, len(sks))
+	sigs := make([]*Signature, len(sks))
+	for i, sk := range sks {
+		pks[i] = sk.PublicKey()
+		sigs[i] = sk.Sign(testMsg)
+	}
+
+	aggregated, err := AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !FastAggregateVerify(pks, testMsg, aggregated) {
+		t.Error("failed to verify a valid aggregated signature")
+	}
+	if FastAggregateVerify(pks[:3], testMsg, aggregated) {
+		t.Error("verified an aggregated signature without a signer")
+	}
+
+	partial, err := AggregateSignatures(sigs[:3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if FastAggregateVerify(pks, testMsg, partial) {
+		t.Error("verified an aggregated signature with a non-signer")
+	}
+
+	if _, err := AggregateSignatures(nil); err != ErrEmptyAggregation {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrEmptyAggregation)
+	}
+	if FastAggregateVerify(nil, testMsg, aggregated) {
+		t.Error("verified an aggregated signature without public keys")
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	sk := generateTestKeys(t, 1)[0]
+
+	skBytes := sk.Bytes()
+	if len(skBytes) != SecretKeyLength {
+		t.Fatalf("secret key length mismatch: have %v, want %v", len(skBytes), SecretKeyLength)
+	}
+	decodedSk, err := SecretKeyFromBytes(skBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decodedSk.PublicKey().Bytes(), sk.PublicKey().Bytes()) {
+		t.Error("public key mismatch of the decoded secret key")
+	}
+
+	pkBytes := sk.PublicKey().Bytes()
+	if len(pkBytes) != PublicKeyLength {
+		t.Fatalf("public key length mismatch: have %v, want %v", len(pkBytes), PublicKeyLength)
+	}
+	pk, err := PublicKeyFromBytes(pkBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sigBytes := sk.Sign(testMsg).Bytes()
+	if len(sigBytes) != SignatureLength {
+		t.Fatalf("signature length mismatch: have %v, want %v", len(sigBytes), SignatureLength)
+	}
+	sig, err := SignatureFromBytes(sigBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(pk, testMsg, sig) {
+		t.Error("failed to verify a decoded signature")
+	}
+
+	// invalid inputs
+	if _, err := SecretKeyFromBytes(make([]byte, SecretKeyLength)); err != ErrInvalidSecretKey {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidSecretKey)
+	}
+	if _, err := SecretKeyFromBytes(bytes.Repeat([]byte{0xff}, SecretKeyLength)); err != ErrInvalidSecretKey {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidSecretKey)
+	}
+	if _, err := PublicKeyFromBytes(pkBytes[1:]); err != ErrInvalidPublicKey {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidPublicKey)
+	}
+	infinity := make([]byte, PublicKeyLength)
+	infinity[0] = 0xc0
+	if _, err := PublicKeyFromBytes(infinity); err != ErrInvalidPublicKey {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidPublicKey)
+	}
+	if _, err := SignatureFromBytes(sigBytes[1:]); err != ErrInvalidSignature {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidSignature)
+	}
+	if _, err := SignatureFromBytes(make([]byte, SignatureLength)); err != ErrInvalidSignature {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	sk1 := DeriveKey([]byte("seed"))
+	sk2 := DeriveKey([]byte("seed"))
+	sk3 := DeriveKey([]byte("another seed"))
+
+	if !bytes.Equal(sk1.Bytes(), sk2.Bytes()) {
+		t.Error("different keys are derived from the same seed")
+	}
+	if bytes.Equal(sk1.Bytes(), sk3.Bytes()) {
+		t.Error("the same key is derived from different seeds")
+	}
+	if !Verify(sk1.PublicKey(), testMsg, sk1.Sign(testMsg)) {
+		t.Error("failed to verify a signature of a derived key")
+	}
+}
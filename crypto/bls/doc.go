@@ -0,0 +1,30 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package bls implements BLS signatures over the BLS12-381 curve.
+
+Public keys are points in G1 and signatures are points in G2, both in the compressed form.
+Signatures of the same message can be aggregated into one signature, which is verified at once
+with the public keys of the signers. To prevent rogue key attacks, the proof of possession scheme
+of draft-irtf-cfrg-bls-signature is used: the possession of a public key must be proven before it
+is aggregated with others. The curve arithmetic is implemented in pure Go.
+
+Source Files
+
+	- bls.go : Provides key generation, signing, verification and aggregation of signatures and public keys
+*/
+package bls
@@ -21,6 +21,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/networks/rpc"
 	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/reward"
 	"math/big"
 	"reflect"
 	"strings"
@@ -35,6 +36,8 @@ type returnTally struct {
 	Key                string
 	Value              interface{}
 	ApprovalPercentage float64
+	ActivationBlock    uint64 `json:",omitempty"`
+	Cancel             bool   `json:",omitempty"`
 }
 
 func NewGovernanceAPI(gov *Governance) *PublicGovernanceAPI {
@@ -57,10 +60,19 @@ var (
 	errPermissionDenied       = errors.New("You don't have the right to vote")
 	errRemoveSelf             = errors.New("You can't vote on removing yourself")
 	errInvalidKeyValue        = errors.New("Your vote couldn't be placed. Please check your vote's key and value")
+	errContractMode           = errors.New("In contract governance mode, parameters are changed by the governance contract")
+	errInvalidActivationBlock = errors.New("The activation block should be an epoch block after the one from which a vote cast now is applied")
+	errScheduleNotFound       = errors.New("No change is scheduled for the key at the activation block")
+	errScheduleNotEnabled     = errors.New("A vote with an activation block is not allowed before the scheduled governance fork")
+	errStakingInfoNotFound    = errors.New("Staking information is not available for the block")
 )
 
 func (api *GovernanceKlayAPI) GasPriceAt(num *rpc.BlockNumber) (*big.Int, error) {
 	if num == nil || *num == rpc.LatestBlockNumber || *num == rpc.PendingBlockNumber {
+		// After the dynamic fee fork, the gas price is the base fee of the block
+		if baseFee := api.chain.CurrentHeader().BaseFee; baseFee != nil {
+			return new(big.Int).Set(baseFee), nil
+		}
 		ret := api.governance.UnitPrice()
 		return big.NewInt(0).SetUint64(ret), nil
 	} else {
@@ -70,6 +82,10 @@ func (api *GovernanceKlayAPI) GasPriceAt(num *rpc.BlockNumber) (*big.Int, error)
 			return nil, errUnknownBlock
 		}
 
+		if header := api.chain.GetHeaderByNumber(uint64(blockNum)); header != nil && header.BaseFee != nil {
+			return new(big.Int).Set(header.BaseFee), nil
+		}
+
 		if ret, err := api.GasPriceAtNumber(blockNum); err != nil {
 			return nil, err
 		} else {
@@ -79,18 +95,45 @@ func (api *GovernanceKlayAPI) GasPriceAt(num *rpc.BlockNumber) (*big.Int, error)
 }
 
 func (api *GovernanceKlayAPI) GasPrice() *big.Int {
+	if baseFee := api.chain.CurrentHeader().BaseFee; baseFee != nil {
+		return new(big.Int).Set(baseFee)
+	}
 	ret := api.governance.UnitPrice()
 	return big.NewInt(0).SetUint64(ret)
 }
 
 // Vote injects a new vote for governance targets such as unitprice and governingnode.
 func (api *PublicGovernanceAPI) Vote(key string, val interface{}) (string, error) {
+	return api.vote(key, val, 0, false)
+}
+
+// VoteAt injects a new vote for a governance target to be applied from the given activation block.
+func (api *PublicGovernanceAPI) VoteAt(key string, val interface{}, activationBlock uint64) (string, error) {
+	if activationBlock == 0 {
+		return "", errInvalidActivationBlock
+	}
+	return api.vote(key, val, activationBlock, false)
+}
+
+// CancelScheduledChange injects a new vote to cancel the change of the key scheduled at the given activation block.
+func (api *PublicGovernanceAPI) CancelScheduledChange(key string, activationBlock uint64) (string, error) {
+	change, ok := api.governance.scheduledChanges.Get(api.governance.getKey(key), activationBlock)
+	if !ok {
+		return "", errScheduleNotFound
+	}
+	return api.vote(change.Key, change.Value, activationBlock, true)
+}
+
+func (api *PublicGovernanceAPI) vote(key string, val interface{}, activationBlock uint64, cancel bool) (string, error) {
 	gMode := api.governance.GovernanceMode()
 	gNode := api.governance.GoverningNode()
 
 	if GovernanceModeMap[gMode] == params.GovernanceMode_Single && gNode != api.governance.nodeAddress.Load().(common.Address) {
 		return "", errPermissionDenied
 	}
+	if api.governance.inContractMode() && !isContractVoteKey(api.governance.getKey(key)) {
+		return "", errContractMode
+	}
 	if strings.ToLower(key) == "governance.removevalidator" {
 		if reflect.TypeOf(val).String() != "string" {
 			return "", errInvalidKeyValue
@@ -103,7 +146,18 @@ func (api *PublicGovernanceAPI) Vote(key string, val interface{}) (string, error
 			return "", errRemoveSelf
 		}
 	}
-	if api.governance.AddVote(key, val) {
+	if activationBlock > 0 {
+		// The vote is included in the next block at the earliest
+		next := api.governance.blockChain.CurrentHeader().Number.Uint64() + 1
+		if !api.governance.ChainConfig.IsScheduledGovernanceForkEnabled(new(big.Int).SetUint64(next)) {
+			return "", errScheduleNotEnabled
+		}
+		vote := &GovernanceVote{Key: api.governance.getKey(key), ActivationBlock: activationBlock, Cancel: cancel}
+		if !api.governance.checkSchedule(vote, next) {
+			return "", errInvalidActivationBlock
+		}
+	}
+	if api.governance.AddScheduledVote(key, val, activationBlock, cancel) {
 		return "Your vote was successfully placed.", nil
 	}
 	return "", errInvalidKeyValue
@@ -127,6 +181,8 @@ func (api *PublicGovernanceAPI) ShowTally() []*returnTally {
 			Key:                val.Key,
 			Value:              val.Value,
 			ApprovalPercentage: float64(val.Votes) / float64(atomic.LoadUint64(&api.governance.totalVotingPower)) * 100,
+			ActivationBlock:    val.ActivationBlock,
+			Cancel:             val.Cancel,
 		}
 		ret = append(ret, item)
 	}
@@ -156,8 +212,58 @@ func (api *PublicGovernanceAPI) ItemsAt(num *rpc.BlockNumber) (map[string]interf
 	}
 }
 
+// GetStakingInfo returns the staking information used for the given block, with the gini coefficient
+// and the voting weights of the council nodes. It is re-computed from the state if it is not stored.
+func (api *PublicGovernanceAPI) GetStakingInfo(num *rpc.BlockNumber) (*reward.StakingInfoResponse, error) {
+	blockNumber := uint64(0)
+	if num == nil || *num == rpc.LatestBlockNumber || *num == rpc.PendingBlockNumber {
+		blockNumber = api.governance.blockChain.CurrentHeader().Number.Uint64()
+	} else {
+		blockNumber = uint64(num.Int64())
+	}
+	stakingInfo := reward.GetStakingInfo(blockNumber)
+	if stakingInfo == nil {
+		return nil, errStakingInfoNotFound
+	}
+	return reward.NewStakingInfoResponse(stakingInfo), nil
+}
+
+// ParamHistory returns the changes of the governance parameter of the given key applied
+// from fromBlock to toBlock, with the votes and the tally which led to each change.
+func (api *PublicGovernanceAPI) ParamHistory(key string, fromBlock rpc.BlockNumber, toBlock *rpc.BlockNumber) ([]*ParamChange, error) {
+	from, err := api.blockNumber(fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to := api.governance.blockChain.CurrentHeader().Number.Uint64()
+	if toBlock != nil {
+		if to, err = api.blockNumber(*toBlock); err != nil {
+			return nil, err
+		}
+	}
+	return api.governance.ParamHistory(key, from, to)
+}
+
+// blockNumber resolves the latest and the pending block numbers to the number of the current head.
+// The other negative block numbers are not valid.
+func (api *PublicGovernanceAPI) blockNumber(num rpc.BlockNumber) (uint64, error) {
+	switch {
+	case num == rpc.LatestBlockNumber || num == rpc.PendingBlockNumber:
+		return api.governance.blockChain.CurrentHeader().Number.Uint64(), nil
+	case num < 0:
+		return 0, errUnknownBlock
+	}
+	return uint64(num.Int64()), nil
+}
+
+// PendingChanges returns the changes to be written at the next epoch block, and the
+// scheduled changes under "scheduledChanges" if any.
 func (api *PublicGovernanceAPI) PendingChanges() map[string]interface{} {
-	return api.governance.PendingChanges()
+	ret := api.governance.PendingChanges()
+	if scheduled := api.governance.ScheduledChanges(); len(scheduled) > 0 {
+		ret["scheduledChanges"] = scheduled
+	}
+	return ret
 }
 
 func (api *PublicGovernanceAPI) Votes() []GovernanceVote {
@@ -184,10 +290,12 @@ func (api *PublicGovernanceAPI) ItemCacheFromDb(num *rpc.BlockNumber) map[string
 }
 
 type VoteList struct {
-	Key      string
-	Value    interface{}
-	Casted   bool
-	BlockNum uint64
+	Key             string
+	Value           interface{}
+	Casted          bool
+	BlockNum        uint64
+	ActivationBlock uint64 `json:",omitempty"`
+	Cancel          bool   `json:",omitempty"`
 }
 
 func (api *PublicGovernanceAPI) MyVotes() []*VoteList {
@@ -197,10 +305,12 @@ func (api *PublicGovernanceAPI) MyVotes() []*VoteList {
 
 	for k, v := range api.governance.voteMap.Copy() {
 		item := &VoteList{
-			Key:      k,
-			Value:    v.Value,
-			Casted:   v.Casted,
-			BlockNum: v.Num,
+			Key:             k.Key,
+			Value:           v.Value,
+			Casted:          v.Casted,
+			BlockNum:        v.Num,
+			ActivationBlock: k.ActivationBlock,
+			Cancel:          v.Cancel,
 		}
 		ret = append(ret, item)
 	}
@@ -224,7 +334,9 @@ func (api *PublicGovernanceAPI) NodeAddress() common.Address {
 }
 
 func (api *PublicGovernanceAPI) isGovernanceModeBallot() bool {
-	if GovernanceModeMap[api.governance.GovernanceMode()] == params.GovernanceMode_Ballot {
+	// The votes on the validators are tallied by the voting power in the contract mode as well
+	switch GovernanceModeMap[api.governance.GovernanceMode()] {
+	case params.GovernanceMode_Ballot, params.GovernanceMode_Contract:
 		return true
 	}
 	return false
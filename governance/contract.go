@@ -0,0 +1,227 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/ser/rlp"
+)
+
+// The storage layout of the governance contract (contracts/gov/GovParam.sol)
+var (
+	// string[] paramNames
+	paramNamesSlot = common.BigToHash(big.NewInt(0))
+	// mapping(string => bytes) paramValues
+	paramValuesSlot = common.BigToHash(big.NewInt(1))
+)
+
+const (
+	// Limits of the contract storage read, not to be stalled by a malformed contract
+	maxContractParams     = 256
+	maxContractValueBytes = 1024
+)
+
+// contractStorage is an interface for state.StateDB used to read the governance contract.
+type contractStorage interface {
+	GetState(addr common.Address, hash common.Hash) common.Hash
+}
+
+// IsContractMode returns true if the governance mode applied to the block of the given
+// number is "contract". In the mode, the parameters are changed by the governance
+// contract instead of the votes in the block headers.
+func (gov *Governance) IsContractMode(num uint64) bool {
+	v, err := gov.GetItemAtNumberByIntKey(num, params.GovernanceMode)
+	if err != nil {
+		return false
+	}
+	mode, ok := v.(string)
+	return ok && GovernanceModeMap[mode] == params.GovernanceMode_Contract
+}
+
+// inContractMode returns true if the current governance mode is "contract".
+func (gov *Governance) inContractMode() bool {
+	mode, _ := gov.GetGovernanceValue(params.GovernanceMode).(string)
+	return GovernanceModeMap[mode] == params.GovernanceMode_Contract
+}
+
+// isContractVoteKey returns true if the key can still be voted on in the contract
+// governance mode. The governance contract doesn't manage the validators.
+func isContractVoteKey(key string) bool {
+	k := GovernanceKeyMap[key]
+	return k == params.AddValidator || k == params.RemoveValidator
+}
+
+// ContractGovernanceChange returns the parameters set in the governance contract which
+// differ from the current governance, encoded as the governance data of the header of
+// the block of the given number. It returns nil if nothing is changed.
+//
+// The state should be the one after the transactions of the block are applied.
+func (gov *Governance) ContractGovernanceChange(num uint64, storage contractStorage) ([]byte, error) {
+	if num == 0 {
+		return nil, nil
+	}
+	v, err := gov.GetItemAtNumberByIntKey(num, params.GovParamContract)
+	if err != nil {
+		return nil, nil
+	}
+	contract, ok := v.(common.Address)
+	if !ok || (contract == common.Address{}) {
+		return nil, nil
+	}
+
+	current, err := gov.latestGovernanceBefore(num)
+	if err != nil {
+		return nil, err
+	}
+
+	change := make(map[string]interface{})
+	for key, value := range gov.readContractParams(contract, storage) {
+		if have, ok := current[key]; !ok || have != value {
+			change[key] = value
+		}
+	}
+	if len(change) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(change)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(data)
+}
+
+// latestGovernanceBefore returns the governance items stored at the last governance
+// block before the given number, which the changes of the block are merged into.
+func (gov *Governance) latestGovernanceBefore(num uint64) (map[string]interface{}, error) {
+	if idx, ok := gov.searchCache(num - 1); ok {
+		if data, ok := gov.getGovernanceCache(idx); ok {
+			return data, nil
+		}
+	}
+	if gov.db == nil {
+		return nil, ErrNotInitialized
+	}
+	indices, err := gov.db.ReadRecentGovernanceIdx(0)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(indices) - 1; i >= 0; i-- {
+		if indices[i] < num {
+			data, err := gov.db.ReadGovernance(indices[i])
+			if err != nil {
+				return nil, err
+			}
+			return adjustDecodedSet(data), nil
+		}
+	}
+	return nil, ErrItemNotFound
+}
+
+// readContractParams returns the valid parameters set in the governance contract.
+// An invalid parameter is ignored, so that a wrong proposal doesn't stop the chain.
+func (gov *Governance) readContractParams(contract common.Address, storage contractStorage) map[string]interface{} {
+	items := make(map[string]interface{})
+
+	count := storage.GetState(contract, paramNamesSlot).Big()
+	if count.Cmp(big.NewInt(maxContractParams)) > 0 {
+		logger.Warn("Too many parameters in the governance contract", "contract", contract, "count", count)
+		count.SetInt64(maxContractParams)
+	}
+	base := crypto.Keccak256Hash(paramNamesSlot.Bytes()).Big()
+
+	for i := int64(0); i < count.Int64(); i++ {
+		slot := common.BigToHash(new(big.Int).Add(base, big.NewInt(i)))
+		name := readStorageBytes(contract, slot, storage)
+		key := gov.getKey(string(name))
+
+		if _, ok := GovernanceKeyMap[key]; !ok || isContractVoteKey(key) {
+			logger.Warn("Unknown parameter in the governance contract", "contract", contract, "key", key)
+			continue
+		}
+		if _, ok := GovernanceForbiddenKeyMap[key]; ok {
+			logger.Warn("Forbidden parameter in the governance contract", "contract", contract, "key", key)
+			continue
+		}
+
+		valueSlot := crypto.Keccak256Hash(name, paramValuesSlot.Bytes())
+		value := readStorageBytes(contract, valueSlot, storage)
+		if parsed, ok := gov.parseContractValue(key, value); ok {
+			items[key] = parsed
+		} else {
+			logger.Warn("Invalid parameter in the governance contract", "contract", contract, "key", key, "value", common.Bytes2Hex(value))
+		}
+	}
+	return items
+}
+
+// parseContractValue parses a value set in the governance contract, which is encoded
+// as a vote value in a block header.
+func (gov *Governance) parseContractValue(key string, value []byte) (interface{}, bool) {
+	if len(value) == 0 {
+		return nil, false
+	}
+	switch GovernanceItems[GovernanceKeyMap[key]].t {
+	case uint64T, boolT:
+		if len(value) > 8 {
+			return nil, false
+		}
+	case addressT:
+		if len(value) != common.AddressLength {
+			return nil, false
+		}
+	}
+
+	vote, err := gov.ParseVoteValue(&GovernanceVote{Key: key, Value: value})
+	if err != nil {
+		return nil, false
+	}
+	vote, ok := gov.ValidateVote(vote)
+	return vote.Value, ok
+}
+
+// readStorageBytes reads a bytes or a string variable stored at the given slot, as
+// laid out by Solidity. A short value is stored in the slot with its length doubled at
+// the last byte, and a long one is stored from the slot of its hash with its length
+// doubled plus one in the slot.
+func readStorageBytes(contract common.Address, slot common.Hash, storage contractStorage) []byte {
+	word := storage.GetState(contract, slot)
+	if word[common.HashLength-1]&1 == 0 {
+		length := int(word[common.HashLength-1] / 2)
+		if length >= common.HashLength {
+			return nil
+		}
+		return common.CopyBytes(word[:length])
+	}
+
+	length := new(big.Int).Rsh(word.Big(), 1)
+	if length.Cmp(big.NewInt(maxContractValueBytes)) > 0 {
+		return nil
+	}
+	data := make([]byte, 0, length.Int64())
+	base := crypto.Keccak256Hash(slot.Bytes()).Big()
+	for i := int64(0); int64(len(data)) < length.Int64(); i++ {
+		word := storage.GetState(contract, common.BigToHash(new(big.Int).Add(base, big.NewInt(i))))
+		data = append(data, word[:]...)
+	}
+	return data[:length.Int64()]
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeStorageBytes writes a bytes or a string variable at the given slot, as laid out by Solidity.
+func writeStorageBytes(s *state.StateDB, contract common.Address, slot common.Hash, data []byte) {
+	if len(data) < common.HashLength {
+		word := common.RightPadBytes(data, common.HashLength)
+		word[common.HashLength-1] = byte(len(data) * 2)
+		s.SetState(contract, slot, common.BytesToHash(word))
+		return
+	}
+	s.SetState(contract, slot, common.BigToHash(big.NewInt(int64(len(data)*2+1))))
+	base := crypto.Keccak256Hash(slot.Bytes()).Big()
+	for i := 0; i*common.HashLength < len(data); i++ {
+		word := common.RightPadBytes(data[i*common.HashLength:], common.HashLength)[:common.HashLength]
+		s.SetState(contract, common.BigToHash(new(big.Int).Add(base, big.NewInt(int64(i)))), common.BytesToHash(word))
+	}
+}
+
+// writeContractParams writes the parameters to the storage of the governance contract.
+func writeContractParams(s *state.StateDB, contract common.Address, names []string, values [][]byte) {
+	s.SetState(contract, paramNamesSlot, common.BigToHash(big.NewInt(int64(len(names)))))
+	base := crypto.Keccak256Hash(paramNamesSlot.Bytes()).Big()
+	for i, name := range names {
+		writeStorageBytes(s, contract, common.BigToHash(new(big.Int).Add(base, big.NewInt(int64(i)))), []byte(name))
+		writeStorageBytes(s, contract, crypto.Keccak256Hash([]byte(name), paramValuesSlot.Bytes()), values[i])
+	}
+}
+
+func getContractModeGovernance(contract common.Address) *Governance {
+	config := *getTestConfig()
+	config.Governance = GetDefaultGovernanceConfig(params.UseIstanbul)
+	config.Governance.GovernanceMode = "contract"
+	config.Governance.GovParamContract = contract
+	return NewGovernance(&config, database.NewMemoryDBManager())
+}
+
+func TestReadStorageBytes(t *testing.T) {
+	s, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+	contract := common.HexToAddress("0x1000")
+
+	for i, data := range [][]byte{{}, []byte("short"), []byte(strings.Repeat("x", 31)), []byte(strings.Repeat("y", 32)), []byte(strings.Repeat("z", 70))} {
+		slot := common.BigToHash(big.NewInt(int64(i)))
+		writeStorageBytes(s, contract, slot, data)
+		assert.Equal(t, data, readStorageBytes(contract, slot, s), i)
+	}
+
+	// A value longer than the limit is not read
+	slot := common.BigToHash(big.NewInt(100))
+	writeStorageBytes(s, contract, slot, make([]byte, maxContractValueBytes+1))
+	assert.Nil(t, readStorageBytes(contract, slot, s))
+}
+
+func TestGovernance_ContractGovernanceChange(t *testing.T) {
+	contract := common.HexToAddress("0x1000")
+	gov := getContractModeGovernance(contract)
+	epoch := gov.Epoch()
+
+	assert.True(t, gov.IsContractMode(epoch))
+	assert.Equal(t, contract, gov.GetGovernanceValue(params.GovParamContract))
+
+	s, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+
+	// Nothing is changed before the parameters are set
+	change, err := gov.ContractGovernanceChange(epoch, s)
+	assert.NoError(t, err)
+	assert.Nil(t, change)
+
+	writeContractParams(s, contract, []string{
+		"governance.unitprice",          // changed
+		"Reward.UseGiniCoeff",           // changed, the key is case insensitive
+		"reward.mintingamount",          // not changed
+		"reward.ratio",                  // invalid value
+		"istanbul.policy",               // forbidden
+		"governance.addvalidator",       // not managed by the contract
+		"governance.unknown",            // unknown
+		"dynamicfee.basefeedenominator", // a number longer than uint64
+	}, [][]byte{
+		{0x32},
+		{0x01},
+		[]byte(gov.MintingAmount()),
+		[]byte("10/10/10"),
+		{0x00},
+		common.HexToAddress("0x2000").Bytes(),
+		{0x01},
+		make([]byte, 9),
+	})
+
+	change, err = gov.ContractGovernanceChange(epoch, s)
+	assert.NoError(t, err)
+
+	var data []byte
+	items := make(map[string]interface{})
+	assert.NoError(t, rlp.DecodeBytes(change, &data))
+	assert.NoError(t, json.Unmarshal(data, &items))
+	assert.Equal(t, map[string]interface{}{
+		"governance.unitprice": uint64(0x32),
+		"reward.useginicoeff":  true,
+	}, adjustDecodedSet(items))
+
+	// A contract-set value applied at an epoch is not changed at the next one
+	gov.UpdateGovernance(epoch, change)
+	change, err = gov.ContractGovernanceChange(2*epoch, s)
+	assert.NoError(t, err)
+	assert.Nil(t, change)
+
+	// Nothing is read in the other modes
+	ballot := getGovernance()
+	assert.False(t, ballot.IsContractMode(epoch))
+	change, err = ballot.ContractGovernanceChange(epoch, s)
+	assert.NoError(t, err)
+	assert.Nil(t, change)
+}
+
+func TestGovernance_AddVote_ContractMode(t *testing.T) {
+	gov := getContractModeGovernance(common.HexToAddress("0x1000"))
+
+	// Only the votes on the validators are accepted
+	assert.False(t, gov.AddVote("governance.unitprice", uint64(50)))
+	assert.True(t, gov.AddVote("governance.addvalidator", "0x0000000000000000000000000000000000002000"))
+
+	_, err := NewGovernanceAPI(gov).Vote("governance.unitprice", float64(50))
+	assert.Equal(t, errContractMode, err)
+}
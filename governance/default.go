@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -64,6 +65,19 @@ var (
 		"governance.removevalidator":    params.RemoveValidator,
 		"param.txgashumanreadable":      params.ConstTxGasHumanReadable,
 		"istanbul.timeout":              params.Timeout,
+		"dynamicfee.lowerboundbasefee":  params.LowerBoundBaseFee,
+		"dynamicfee.upperboundbasefee":  params.UpperBoundBaseFee,
+		"dynamicfee.gastarget":          params.GasTarget,
+		"dynamicfee.basefeedenominator": params.BaseFeeDenominator,
+		"dynamicfee.burnratio":          params.BurnRatio,
+		"governance.govparamcontract":   params.GovParamContract,
+		"param.blockgaslimit":           params.BlockGasLimit,
+		"param.computationcostlimit":    params.ComputationCostLimit,
+		"istanbul.blockperiod":          params.BlockPeriod,
+		"txpool.maxtxsize":              params.MaxTxSize,
+		"txpool.minimumprice":           params.MinimumTxPoolPrice,
+		"reward.stakingreward":          params.StakingReward,
+		"istanbul.demotionthreshold":    params.DemotionThreshold,
 	}
 
 	GovernanceForbiddenKeyMap = map[string]int{
@@ -91,6 +105,19 @@ var (
 		params.RemoveValidator:         "governance.removevalidator",
 		params.ConstTxGasHumanReadable: "param.txgashumanreadable",
 		params.Timeout:                 "istanbul.timeout",
+		params.LowerBoundBaseFee:       "dynamicfee.lowerboundbasefee",
+		params.UpperBoundBaseFee:       "dynamicfee.upperboundbasefee",
+		params.GasTarget:               "dynamicfee.gastarget",
+		params.BaseFeeDenominator:      "dynamicfee.basefeedenominator",
+		params.BurnRatio:               "dynamicfee.burnratio",
+		params.GovParamContract:        "governance.govparamcontract",
+		params.BlockGasLimit:           "param.blockgaslimit",
+		params.ComputationCostLimit:    "param.computationcostlimit",
+		params.BlockPeriod:             "istanbul.blockperiod",
+		params.MaxTxSize:               "txpool.maxtxsize",
+		params.MinimumTxPoolPrice:      "txpool.minimumprice",
+		params.StakingReward:           "reward.stakingreward",
+		params.DemotionThreshold:       "istanbul.demotionthreshold",
 	}
 
 	ProposerPolicyMap = map[string]int{
@@ -106,9 +133,10 @@ var (
 	}
 
 	GovernanceModeMap = map[string]int{
-		"none":   params.GovernanceMode_None,
-		"single": params.GovernanceMode_Single,
-		"ballot": params.GovernanceMode_Ballot,
+		"none":     params.GovernanceMode_None,
+		"single":   params.GovernanceMode_Single,
+		"ballot":   params.GovernanceMode_Ballot,
+		"contract": params.GovernanceMode_Contract,
 	}
 )
 
@@ -125,13 +153,20 @@ type GovernanceVote struct {
 	Validator common.Address `json:"validator"`
 	Key       string         `json:"key"`
 	Value     interface{}    `json:"value"`
+	BlockNum  uint64         `json:"blockNum,omitempty" rlp:"-"` // The block where the vote is included, not encoded in the header
+
+	// A scheduled vote is applied from the activation block, or cancels the scheduled change
+	ActivationBlock uint64 `json:"activationBlock,omitempty" rlp:"optional"`
+	Cancel          bool   `json:"cancel,omitempty" rlp:"optional"`
 }
 
 // GovernanceTallies represents a tally for each governance item
 type GovernanceTallyItem struct {
-	Key   string      `json:"key"`
-	Value interface{} `json:"value"`
-	Votes uint64      `json:"votes"`
+	Key             string      `json:"key"`
+	Value           interface{} `json:"value"`
+	Votes           uint64      `json:"votes"`
+	ActivationBlock uint64      `json:"activationBlock,omitempty"`
+	Cancel          bool        `json:"cancel,omitempty"`
 }
 
 type GovernanceTallyList struct {
@@ -147,21 +182,57 @@ type VoteStatus struct {
 	Value  interface{} `json:"value"`
 	Casted bool        `json:"casted"`
 	Num    uint64      `json:"num"`
+	Cancel bool        `json:"cancel,omitempty"`
+}
+
+// VoteKey identifies a vote in the VoteMap, so that the votes for the same key
+// scheduled at different activation blocks are kept together.
+type VoteKey struct {
+	Key             string
+	ActivationBlock uint64
+}
+
+// MarshalText encodes the VoteKey as "key" or "key@activationBlock",
+// which is used as the key of the VoteMap in JSON.
+func (k VoteKey) MarshalText() ([]byte, error) {
+	if k.ActivationBlock == 0 {
+		return []byte(k.Key), nil
+	}
+	return []byte(k.Key + "@" + strconv.FormatUint(k.ActivationBlock, 10)), nil
+}
+
+// UnmarshalText decodes the VoteKey encoded by MarshalText.
+func (k *VoteKey) UnmarshalText(text []byte) error {
+	s := string(text)
+	idx := strings.LastIndex(s, "@")
+	if idx < 0 {
+		*k = VoteKey{Key: s}
+		return nil
+	}
+	num, err := strconv.ParseUint(s[idx+1:], 10, 64)
+	if err != nil {
+		return err
+	}
+	*k = VoteKey{Key: s[:idx], ActivationBlock: num}
+	return nil
 }
 
 type VoteMap struct {
-	items map[string]VoteStatus
+	items map[VoteKey]VoteStatus
 	mu    *sync.RWMutex
 }
 
 // txPool is an interface for blockchain.TxPool used in governance package.
 type txPool interface {
 	SetGasPrice(price *big.Int)
+	SetMaxTxSize(size uint64)
+	SetMinimumPrice(price *big.Int)
 }
 
 // blockChain is an interface for blockchain.Blockchain used in governance package.
 type blockChain interface {
 	CurrentHeader() *types.Header
+	GetHeaderByNumber(number uint64) *types.Header
 	SetProposerPolicy(val uint64)
 	SetUseGiniCoeff(val bool)
 }
@@ -193,6 +264,9 @@ type Governance struct {
 	currentSet GovernanceSet
 	changeSet  GovernanceSet
 
+	// The changes approved to be applied from the activation blocks
+	scheduledChanges ScheduledChangeList
+
 	TxPool txPool
 
 	blockChain blockChain
@@ -200,7 +274,7 @@ type Governance struct {
 
 func NewVoteMap() VoteMap {
 	return VoteMap{
-		items: make(map[string]VoteStatus),
+		items: make(map[VoteKey]VoteStatus),
 		mu:    new(sync.RWMutex),
 	}
 }
@@ -226,11 +300,11 @@ func (gt *GovernanceTallyList) Clear() {
 	gt.items = make([]GovernanceTallyItem, 0)
 }
 
-func (vl *VoteMap) Copy() map[string]VoteStatus {
+func (vl *VoteMap) Copy() map[VoteKey]VoteStatus {
 	vl.mu.RLock()
 	defer vl.mu.RUnlock()
 
-	ret := make(map[string]VoteStatus)
+	ret := make(map[VoteKey]VoteStatus)
 	for k, v := range vl.items {
 		ret[k] = v
 	}
@@ -238,21 +312,21 @@ func (vl *VoteMap) Copy() map[string]VoteStatus {
 	return ret
 }
 
-func (vl *VoteMap) GetValue(key string) VoteStatus {
+func (vl *VoteMap) GetValue(key VoteKey) VoteStatus {
 	vl.mu.RLock()
 	defer vl.mu.RUnlock()
 
 	return vl.items[key]
 }
 
-func (vl *VoteMap) SetValue(key string, val VoteStatus) {
+func (vl *VoteMap) SetValue(key VoteKey, val VoteStatus) {
 	vl.mu.Lock()
 	defer vl.mu.Unlock()
 
 	vl.items[key] = val
 }
 
-func (vl *VoteMap) Import(src map[string]VoteStatus) {
+func (vl *VoteMap) Import(src map[VoteKey]VoteStatus) {
 	vl.mu.Lock()
 	defer vl.mu.Unlock()
 
@@ -265,7 +339,7 @@ func (vl *VoteMap) Clear() {
 	vl.mu.Lock()
 	defer vl.mu.Unlock()
 
-	vl.items = make(map[string]VoteStatus)
+	vl.items = make(map[VoteKey]VoteStatus)
 }
 
 func (vl *VoteMap) Size() int {
@@ -408,6 +482,7 @@ func NewGovernance(chainConfig *params.ChainConfig, dbm database.DBManager) *Gov
 		itemCache:                newGovernanceCache(),
 		currentSet:               NewGovernanceSet(),
 		changeSet:                NewGovernanceSet(),
+		scheduledChanges:         NewScheduledChangeList(),
 		lastGovernanceStateBlock: 0,
 		GovernanceTallies:        NewGovernanceTallies(),
 		GovernanceVotes:          NewGovernanceVotes(),
@@ -449,12 +524,20 @@ func (g *Governance) GetEncodedVote(addr common.Address, number uint64) []byte {
 		if val.Casted == false {
 			vote := new(GovernanceVote)
 			vote.Validator = addr
-			vote.Key = key
+			vote.Key = key.Key
 			vote.Value = val.Value
+			vote.ActivationBlock = key.ActivationBlock
+			vote.Cancel = val.Cancel
+			// A scheduled vote which can't be included any more is dropped
+			if !g.checkSchedule(vote, number) {
+				logger.Warn("Dropped a scheduled vote which can't be included", "number", number, "key", key.Key, "activationBlock", key.ActivationBlock)
+				g.RemoveScheduledVote(key.Key, val.Value, key.ActivationBlock, number)
+				continue
+			}
 			encoded, err := rlp.EncodeToBytes(vote)
 			if err != nil {
 				logger.Error("Failed to RLP Encode a vote", "vote", vote)
-				g.RemoveVote(key, val, number)
+				g.RemoveScheduledVote(key.Key, val.Value, key.ActivationBlock, number)
 				continue
 			}
 			return encoded
@@ -469,12 +552,17 @@ func (g *Governance) getKey(k string) string {
 
 // RemoveVote remove a vote from the voteMap to prevent repetitive addition of same vote
 func (g *Governance) RemoveVote(key string, value interface{}, number uint64) {
-	if g.voteMap.GetValue(key).Value == value {
-		g.voteMap.SetValue(key, VoteStatus{
-			Value:  value,
-			Casted: true,
-			Num:    number,
-		})
+	g.RemoveScheduledVote(key, value, 0, number)
+}
+
+// RemoveScheduledVote removes a vote scheduled at the activation block from the voteMap
+// to prevent repetitive addition of same vote
+func (g *Governance) RemoveScheduledVote(key string, value interface{}, activationBlock uint64, number uint64) {
+	voteKey := VoteKey{Key: key, ActivationBlock: activationBlock}
+	if status := g.voteMap.GetValue(voteKey); status.Value == value {
+		status.Casted = true
+		status.Num = number
+		g.voteMap.SetValue(voteKey, status)
 	}
 	if g.CanWriteGovernanceState(number) {
 		g.WriteGovernanceState(number, false)
@@ -502,12 +590,14 @@ func (g *Governance) ParseVoteValue(gVote *GovernanceVote) (*GovernanceVote, err
 	switch k {
 	case params.GovernanceMode, params.MintingAmount, params.MinimumStake, params.Ratio:
 		val = string(gVote.Value.([]uint8))
-	case params.GoverningNode, params.AddValidator, params.RemoveValidator:
+	case params.GoverningNode, params.AddValidator, params.RemoveValidator, params.GovParamContract:
 		val = common.BytesToAddress(gVote.Value.([]uint8))
-	case params.Epoch, params.CommitteeSize, params.UnitPrice, params.StakeUpdateInterval, params.ProposerRefreshInterval, params.ConstTxGasHumanReadable, params.Policy, params.Timeout:
+	case params.Epoch, params.CommitteeSize, params.UnitPrice, params.StakeUpdateInterval, params.ProposerRefreshInterval, params.ConstTxGasHumanReadable, params.Policy, params.Timeout,
+		params.LowerBoundBaseFee, params.UpperBoundBaseFee, params.GasTarget, params.BaseFeeDenominator, params.BurnRatio,
+		params.BlockGasLimit, params.ComputationCostLimit, params.BlockPeriod, params.MaxTxSize, params.MinimumTxPoolPrice, params.DemotionThreshold:
 		gVote.Value = append(make([]byte, 8-len(gVote.Value.([]uint8))), gVote.Value.([]uint8)...)
 		val = binary.BigEndian.Uint64(gVote.Value.([]uint8))
-	case params.UseGiniCoeff, params.DeferredTxFee:
+	case params.UseGiniCoeff, params.DeferredTxFee, params.StakingReward:
 		gVote.Value = append(make([]byte, 8-len(gVote.Value.([]uint8))), gVote.Value.([]uint8)...)
 		if binary.BigEndian.Uint64(gVote.Value.([]uint8)) != uint64(0) {
 			val = true
@@ -529,19 +619,21 @@ func (gov *Governance) ReflectVotes(vote GovernanceVote) {
 
 func (gov *Governance) updateChangeSet(vote GovernanceVote) bool {
 	switch GovernanceKeyMap[vote.Key] {
-	case params.GoverningNode:
+	case params.GoverningNode, params.GovParamContract:
 		gov.changeSet.SetValue(GovernanceKeyMap[vote.Key], vote.Value.(common.Address))
 		return true
 	case params.GovernanceMode, params.Ratio:
 		gov.changeSet.SetValue(GovernanceKeyMap[vote.Key], vote.Value.(string))
 		return true
-	case params.Epoch, params.StakeUpdateInterval, params.ProposerRefreshInterval, params.CommitteeSize, params.UnitPrice, params.ConstTxGasHumanReadable, params.Policy, params.Timeout:
+	case params.Epoch, params.StakeUpdateInterval, params.ProposerRefreshInterval, params.CommitteeSize, params.UnitPrice, params.ConstTxGasHumanReadable, params.Policy, params.Timeout,
+		params.LowerBoundBaseFee, params.UpperBoundBaseFee, params.GasTarget, params.BaseFeeDenominator, params.BurnRatio,
+		params.BlockGasLimit, params.ComputationCostLimit, params.BlockPeriod, params.MaxTxSize, params.MinimumTxPoolPrice, params.DemotionThreshold:
 		gov.changeSet.SetValue(GovernanceKeyMap[vote.Key], vote.Value.(uint64))
 		return true
 	case params.MintingAmount, params.MinimumStake:
 		gov.changeSet.SetValue(GovernanceKeyMap[vote.Key], vote.Value.(string))
 		return true
-	case params.UseGiniCoeff, params.DeferredTxFee:
+	case params.UseGiniCoeff, params.DeferredTxFee, params.StakingReward:
 		gov.changeSet.SetValue(GovernanceKeyMap[vote.Key], vote.Value.(bool))
 		return true
 	default:
@@ -604,6 +696,19 @@ func CheckGenesisValues(c *params.ChainConfig) error {
 		"reward.stakingupdateinterval":  c.Governance.Reward.StakingUpdateInterval,
 		"reward.proposerupdateinterval": c.Governance.Reward.ProposerUpdateInterval,
 	}
+	if (c.Governance.GovParamContract != common.Address{}) {
+		tstMap["governance.govparamcontract"] = c.Governance.GovParamContract
+	}
+	if c.Governance.Reward.StakingReward {
+		tstMap["reward.stakingreward"] = c.Governance.Reward.StakingReward
+	}
+	if dynamicFee := c.Governance.DynamicFee; dynamicFee != nil {
+		tstMap["dynamicfee.lowerboundbasefee"] = dynamicFee.LowerBoundBaseFee
+		tstMap["dynamicfee.upperboundbasefee"] = dynamicFee.UpperBoundBaseFee
+		tstMap["dynamicfee.gastarget"] = dynamicFee.GasTarget
+		tstMap["dynamicfee.basefeedenominator"] = dynamicFee.BaseFeeDenominator
+		tstMap["dynamicfee.burnratio"] = dynamicFee.BurnRatio
+	}
 
 	for k, v := range tstMap {
 		if _, ok := gov.ValidateVote(&GovernanceVote{Key: k, Value: v}); !ok {
@@ -779,12 +884,17 @@ func (gov *Governance) UpdateGovernance(number uint64, governance []byte) {
 			if err := gov.WriteGovernance(number, gov.currentSet, tempSet); err != nil {
 				logger.Crit("Failed to store new governance data", "number", number, "err", err)
 			}
+
+			// Index the changes with the votes of the epoch, which are not replayed for the old blocks
+			if number > atomic.LoadUint64(&gov.lastGovernanceStateBlock) {
+				gov.writeParamChanges(number, tempItems)
+			}
 		}
 	}
 }
 
 func (gov *Governance) removeDuplicatedVote(vote *GovernanceVote, number uint64) {
-	gov.RemoveVote(vote.Key, vote.Value, number)
+	gov.RemoveScheduledVote(vote.Key, vote.Value, vote.ActivationBlock, number)
 }
 
 func (gov *Governance) UpdateCurrentGovernance(num uint64) {
@@ -811,7 +921,7 @@ func adjustDecodedSet(src map[string]interface{}) map[string]interface{} {
 		if x.Kind() == reflect.Float64 {
 			src[k] = uint64(v.(float64))
 		}
-		if GovernanceKeyMap[k] == params.GoverningNode {
+		if key := GovernanceKeyMap[k]; key == params.GoverningNode || key == params.GovParamContract {
 			if reflect.TypeOf(v) == stringT {
 				src[k] = common.HexToAddress(v.(string))
 			} else {
@@ -844,7 +954,7 @@ func (gov *Governance) VerifyGovernance(received []byte) error {
 
 	if len(rChangeSet) == gov.changeSet.Size() {
 		for k, v := range rChangeSet {
-			if GovernanceKeyMap[k] == params.GoverningNode {
+			if key := GovernanceKeyMap[k]; key == params.GoverningNode || key == params.GovParamContract {
 				if reflect.TypeOf(v) == stringT {
 					v = common.HexToAddress(v.(string))
 				}
@@ -863,12 +973,14 @@ func (gov *Governance) VerifyGovernance(received []byte) error {
 type governanceJSON struct {
 	BlockNumber     uint64                 `json:"blockNumber"`
 	ChainConfig     *params.ChainConfig    `json:"chainConfig"`
-	VoteMap         map[string]VoteStatus  `json:"voteMap"`
+	VoteMap         map[VoteKey]VoteStatus `json:"voteMap"`
 	NodeAddress     common.Address         `json:"nodeAddress"`
 	GovernanceVotes []GovernanceVote       `json:"governanceVotes"`
 	GovernanceTally []GovernanceTallyItem  `json:"governanceTally"`
 	CurrentSet      map[string]interface{} `json:"currentSet"`
 	ChangeSet       map[string]interface{} `json:"changeSet"`
+
+	ScheduledChanges []ScheduledChange `json:"scheduledChanges,omitempty"`
 }
 
 func (gov *Governance) toJSON(num uint64) ([]byte, error) {
@@ -881,6 +993,8 @@ func (gov *Governance) toJSON(num uint64) ([]byte, error) {
 		GovernanceTally: gov.GovernanceTallies.Copy(),
 		CurrentSet:      gov.currentSet.Items(),
 		ChangeSet:       gov.changeSet.Items(),
+
+		ScheduledChanges: gov.scheduledChanges.Copy(),
 	}
 	j, _ := json.Marshal(ret)
 	return j, nil
@@ -898,6 +1012,10 @@ func (gov *Governance) UnmarshalJSON(b []byte) error {
 	gov.GovernanceTallies.Import(j.GovernanceTally)
 	gov.currentSet.Import(adjustDecodedSet(j.CurrentSet))
 	gov.changeSet.Import(adjustDecodedSet(j.ChangeSet))
+	for i, change := range j.ScheduledChanges {
+		j.ScheduledChanges[i].Value = adjustDecodedSet(map[string]interface{}{change.Key: change.Value})[change.Key]
+	}
+	gov.scheduledChanges.Import(j.ScheduledChanges)
 	atomic.StoreUint64(&gov.lastGovernanceStateBlock, j.BlockNumber)
 
 	return nil
@@ -975,6 +1093,34 @@ func getGovernanceItemsFromChainConfig(config *params.ChainConfig) GovernanceSet
 				writeFailLog(k, err)
 			}
 		}
+
+		if (governance.GovParamContract != common.Address{}) {
+			if err := g.SetValue(params.GovParamContract, governance.GovParamContract); err != nil {
+				writeFailLog(params.GovParamContract, err)
+			}
+		}
+
+		if governance.Reward.StakingReward {
+			if err := g.SetValue(params.StakingReward, governance.Reward.StakingReward); err != nil {
+				writeFailLog(params.StakingReward, err)
+			}
+		}
+
+		if dynamicFee := governance.DynamicFee; dynamicFee != nil {
+			dynamicFeeMap := map[int]interface{}{
+				params.LowerBoundBaseFee:  dynamicFee.LowerBoundBaseFee,
+				params.UpperBoundBaseFee:  dynamicFee.UpperBoundBaseFee,
+				params.GasTarget:          dynamicFee.GasTarget,
+				params.BaseFeeDenominator: dynamicFee.BaseFeeDenominator,
+				params.BurnRatio:          dynamicFee.BurnRatio,
+			}
+
+			for k, v := range dynamicFeeMap {
+				if err := g.SetValue(k, v); err != nil {
+					writeFailLog(k, err)
+				}
+			}
+		}
 	}
 
 	if config.Istanbul != nil {
@@ -1018,6 +1164,87 @@ func (gov *Governance) UnitPrice() uint64 {
 	return gov.GetGovernanceValue(params.UnitPrice).(uint64)
 }
 
+// DynamicFeeConfig returns the parameters of the dynamic fee mode applied to the block of
+// the given number. The default value is used for a parameter which has never been set,
+// as in a network started before the dynamic fee mode is introduced.
+func (gov *Governance) DynamicFeeConfig(num uint64) *params.DynamicFeeConfig {
+	config := params.DefaultDynamicFeeConfig()
+	for key, field := range map[int]*uint64{
+		params.LowerBoundBaseFee:  &config.LowerBoundBaseFee,
+		params.UpperBoundBaseFee:  &config.UpperBoundBaseFee,
+		params.GasTarget:          &config.GasTarget,
+		params.BaseFeeDenominator: &config.BaseFeeDenominator,
+		params.BurnRatio:          &config.BurnRatio,
+	} {
+		if v, err := gov.GetItemAtNumberByIntKey(num, key); err == nil {
+			if value, ok := v.(uint64); ok {
+				*field = value
+			}
+		}
+	}
+	return config
+}
+
+// BlockPeriod returns the minimum time difference between two consecutive blocks
+// at the given block number, if it is set by the governance.
+func (gov *Governance) BlockPeriod(num uint64) (uint64, bool) {
+	if v, err := gov.GetItemAtNumberByIntKey(num, params.BlockPeriod); err == nil {
+		if period, ok := v.(uint64); ok {
+			return period, true
+		}
+	}
+	return 0, false
+}
+
+// DemotionThreshold returns the percentage of missed proposer turns and committed seals in an epoch over which a validator
+// is demoted at the given block number. Zero means the automatic demotion is disabled.
+func (gov *Governance) DemotionThreshold(num uint64) uint64 {
+	if v, err := gov.GetItemAtNumberByIntKey(num, params.DemotionThreshold); err == nil {
+		if threshold, ok := v.(uint64); ok {
+			return threshold
+		}
+	}
+	return 0
+}
+
+// BlockGasLimit returns the gas limit of all transactions in the block of the given number.
+// Zero means no limit.
+func (gov *Governance) BlockGasLimit(num uint64) uint64 {
+	if v, err := gov.GetItemAtNumberByIntKey(num, params.BlockGasLimit); err == nil {
+		if limit, ok := v.(uint64); ok {
+			return limit
+		}
+	}
+	return params.DefaultBlockGasLimit
+}
+
+// ComputationCostLimit returns the opcode computation cost limit of a transaction
+// in the block of the given number.
+func (gov *Governance) ComputationCostLimit(num uint64) uint64 {
+	if v, err := gov.GetItemAtNumberByIntKey(num, params.ComputationCostLimit); err == nil {
+		if limit, ok := v.(uint64); ok {
+			return limit
+		}
+	}
+	return params.OpcodeComputationCostLimit
+}
+
+// MaxTxSize returns the size limit of a transaction accepted by the transaction pool.
+func (gov *Governance) MaxTxSize() uint64 {
+	if ret, ok := gov.GetGovernanceValue(params.MaxTxSize).(uint64); ok {
+		return ret
+	}
+	return params.DefaultMaxTxSize
+}
+
+// MinimumTxPoolPrice returns the minimum gas price of a transaction accepted by the transaction pool.
+func (gov *Governance) MinimumTxPoolPrice() uint64 {
+	if ret, ok := gov.GetGovernanceValue(params.MinimumTxPoolPrice).(uint64); ok {
+		return ret
+	}
+	return params.DefaultMinimumTxPoolPrice
+}
+
 func (gov *Governance) CommitteeSize() uint64 {
 	return gov.GetGovernanceValue(params.CommitteeSize).(uint64)
 }
@@ -1038,6 +1265,19 @@ func (gov *Governance) DeferredTxFee() bool {
 	return gov.GetGovernanceValue(params.DeferredTxFee).(bool)
 }
 
+// StakingReward returns true if the CN reward of the given block is distributed to the staked council members.
+func (gov *Governance) StakingReward(num uint64) bool {
+	if !gov.ChainConfig.IsStakingRewardForkEnabled(new(big.Int).SetUint64(num)) {
+		return false
+	}
+	if v, err := gov.GetItemAtNumberByIntKey(num, params.StakingReward); err == nil {
+		if stakingReward, ok := v.(bool); ok {
+			return stakingReward
+		}
+	}
+	return false
+}
+
 func (gov *Governance) MinimumStake() string {
 	return gov.GetGovernanceValue(params.MinimumStake).(string)
 }
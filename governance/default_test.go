@@ -104,6 +104,29 @@ var tstData = []voteValue{
 	{k: "istanbul.timeout", v: true, e: false},
 	{k: "istanbul.timeout", v: "10", e: false},
 	{k: "istanbul.timeout", v: 5.3, e: false},
+	{k: "param.blockgaslimit", v: uint64(0), e: true},
+	{k: "param.blockgaslimit", v: uint64(100000000), e: true},
+	{k: "param.blockgaslimit", v: "100000000", e: false},
+	{k: "param.computationcostlimit", v: uint64(200000000), e: true},
+	{k: "param.computationcostlimit", v: uint64(0), e: false},
+	{k: "istanbul.blockperiod", v: uint64(2), e: true},
+	{k: "istanbul.blockperiod", v: float64(2.0), e: true},
+	{k: "istanbul.blockperiod", v: uint64(0), e: false},
+	{k: "istanbul.blockperiod", v: uint64(61), e: false},
+	{k: "txpool.maxtxsize", v: uint64(64 * 1024), e: true},
+	{k: "txpool.maxtxsize", v: uint64(0), e: false},
+	{k: "txpool.maxtxsize", v: uint64(10*1024*1024 + 1), e: false},
+	{k: "txpool.minimumprice", v: uint64(0), e: true},
+	{k: "txpool.minimumprice", v: uint64(25000000000), e: true},
+	{k: "txpool.minimumprice", v: float64(-1), e: false},
+	{k: "reward.stakingreward", v: true, e: true},
+	{k: "reward.stakingreward", v: false, e: true},
+	{k: "reward.stakingreward", v: "true", e: false},
+	{k: "reward.stakingreward", v: uint64(1), e: false},
+	{k: "istanbul.demotionthreshold", v: uint64(0), e: true},
+	{k: "istanbul.demotionthreshold", v: uint64(50), e: true},
+	{k: "istanbul.demotionthreshold", v: uint64(101), e: false},
+	{k: "istanbul.demotionthreshold", v: "50", e: false},
 }
 
 var goodVotes = []voteValue{
@@ -116,6 +139,10 @@ var goodVotes = []voteValue{
 	{k: "reward.mintingamount", v: "9600000000000000000", e: true},
 	{k: "reward.ratio", v: "10/10/80", e: true},
 	{k: "istanbul.timeout", v: uint64(5000), e: true},
+	{k: "istanbul.blockperiod", v: uint64(2), e: true},
+	{k: "txpool.maxtxsize", v: uint64(64 * 1024), e: true},
+	{k: "reward.stakingreward", v: true, e: true},
+	{k: "istanbul.demotionthreshold", v: uint64(50), e: true},
 }
 
 func getTestConfig() *params.ChainConfig {
@@ -187,6 +214,36 @@ func TestGovernance_AddVote(t *testing.T) {
 	}
 }
 
+func TestGovernance_ValidateVote_BaseFeeBounds(t *testing.T) {
+	gov := getGovernance()
+	gov.currentSet.Import(map[string]interface{}{
+		"dynamicfee.lowerboundbasefee": uint64(25),
+		"dynamicfee.upperboundbasefee": uint64(750),
+	})
+
+	testCases := []struct {
+		key      string
+		value    uint64
+		expected bool
+	}{
+		{"dynamicfee.lowerboundbasefee", 750, true},
+		{"dynamicfee.lowerboundbasefee", 751, false},
+		{"dynamicfee.upperboundbasefee", 25, true},
+		{"dynamicfee.upperboundbasefee", 24, false},
+	}
+	for _, tc := range testCases {
+		_, ok := gov.ValidateVote(&GovernanceVote{Key: tc.key, Value: tc.value})
+		assert.Equal(t, tc.expected, ok, "%v: %v", tc.key, tc.value)
+	}
+
+	// A bound is compared with the other bound passed to be applied together
+	gov.changeSet.SetValue(params.UpperBoundBaseFee, uint64(1000))
+	_, ok := gov.ValidateVote(&GovernanceVote{Key: "dynamicfee.lowerboundbasefee", Value: uint64(1000)})
+	assert.True(t, ok)
+	_, ok = gov.ValidateVote(&GovernanceVote{Key: "dynamicfee.lowerboundbasefee", Value: uint64(1001)})
+	assert.False(t, ok)
+}
+
 func TestGovernance_RemoveVote(t *testing.T) {
 	gov := getGovernance()
 
@@ -265,8 +322,8 @@ func TestGovernance_GetEncodedVote(t *testing.T) {
 			assert.Equal(t, nil, err)
 		}
 
-		if v.Value != gov.voteMap.GetValue(v.Key).Value {
-			t.Errorf("Encoded vote and Decoded vote are different! Encoded: %v, Decoded: %v\n", gov.voteMap.GetValue(v.Key).Value, v.Value)
+		if v.Value != gov.voteMap.GetValue(VoteKey{Key: v.Key}).Value {
+			t.Errorf("Encoded vote and Decoded vote are different! Encoded: %v, Decoded: %v\n", gov.voteMap.GetValue(VoteKey{Key: v.Key}).Value, v.Value)
 		}
 		gov.RemoveVote(v.Key, v.Value, 1000)
 	}
@@ -783,3 +840,118 @@ func TestGovernance_HandleGovernanceVote_Ballot_mode(t *testing.T) {
 	}
 	gov.voteMap.Clear()
 }
+
+type testTxPool struct {
+	gasPrice     *big.Int
+	maxTxSize    uint64
+	minimumPrice *big.Int
+}
+
+func (pool *testTxPool) SetGasPrice(price *big.Int)     { pool.gasPrice = price }
+func (pool *testTxPool) SetMaxTxSize(size uint64)       { pool.maxTxSize = size }
+func (pool *testTxPool) SetMinimumPrice(price *big.Int) { pool.minimumPrice = price }
+
+func TestGovernance_TriggerChange_Limits(t *testing.T) {
+	gov := getGovernance()
+	pool := &testTxPool{}
+	gov.SetTxPool(pool)
+
+	assert.Equal(t, params.DefaultMaxTxSize, gov.MaxTxSize())
+	assert.Equal(t, params.DefaultMinimumTxPoolPrice, gov.MinimumTxPoolPrice())
+
+	changes := map[string]interface{}{
+		"param.blockgaslimit":        uint64(50000000),
+		"param.computationcostlimit": uint64(200000000),
+		"txpool.maxtxsize":           uint64(64 * 1024),
+		"txpool.minimumprice":        uint64(25000000000),
+	}
+	gov.currentSet.Import(changes)
+	gov.triggerChange(changes)
+
+	// The block limits are not changed globally, but read at each block
+	assert.Equal(t, params.DefaultComputationCostLimit, params.OpcodeComputationCostLimit)
+	assert.Equal(t, uint64(64*1024), pool.maxTxSize)
+	assert.Equal(t, big.NewInt(25000000000), pool.minimumPrice)
+	assert.Equal(t, uint64(64*1024), gov.MaxTxSize())
+	assert.Equal(t, uint64(25000000000), gov.MinimumTxPoolPrice())
+}
+
+func TestGovernance_BlockPeriod(t *testing.T) {
+	gov := getGovernance()
+	epoch := gov.Epoch()
+
+	// The block period is not governed unless it is voted
+	_, ok := gov.BlockPeriod(epoch)
+	assert.False(t, ok)
+
+	src := NewGovernanceSet()
+	src.Import(gov.currentSet.Items())
+	src.SetValue(params.BlockPeriod, uint64(3))
+	assert.NoError(t, gov.WriteGovernance(epoch, src, NewGovernanceSet()))
+
+	// The block period written at an epoch block is applied from the next epoch
+	_, ok = gov.BlockPeriod(2*epoch - 1)
+	assert.False(t, ok)
+	period, ok := gov.BlockPeriod(2 * epoch)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), period)
+}
+
+func TestGovernance_DemotionThreshold(t *testing.T) {
+	gov := getGovernance()
+	epoch := gov.Epoch()
+
+	// The automatic demotion is disabled unless it is voted
+	assert.Equal(t, uint64(0), gov.DemotionThreshold(epoch))
+
+	src := NewGovernanceSet()
+	src.Import(gov.currentSet.Items())
+	src.SetValue(params.DemotionThreshold, uint64(50))
+	assert.NoError(t, gov.WriteGovernance(epoch, src, NewGovernanceSet()))
+
+	// The threshold written at an epoch block is applied from the next epoch
+	assert.Equal(t, uint64(0), gov.DemotionThreshold(2*epoch-1))
+	assert.Equal(t, uint64(50), gov.DemotionThreshold(2*epoch))
+}
+
+func TestGovernance_StakingReward(t *testing.T) {
+	dbm := database.NewDBManager(&database.DBConfig{DBType: database.MemoryDB})
+	config := *getTestConfig()
+	epoch := config.Istanbul.Epoch
+	config.StakingRewardCompatibleBlock = new(big.Int).SetUint64(3 * epoch)
+	gov := NewGovernance(&config, dbm)
+
+	// The staking reward is disabled unless it is voted
+	assert.False(t, gov.StakingReward(3*epoch))
+
+	src := NewGovernanceSet()
+	src.Import(gov.currentSet.Items())
+	src.SetValue(params.StakingReward, true)
+	assert.NoError(t, gov.WriteGovernance(epoch, src, NewGovernanceSet()))
+
+	// The staking reward is enabled from the next epoch, but only after the fork block
+	assert.False(t, gov.StakingReward(2*epoch))
+	assert.False(t, gov.StakingReward(3*epoch-1))
+	assert.True(t, gov.StakingReward(3*epoch))
+}
+
+func TestGovernance_BlockLimits(t *testing.T) {
+	gov := getGovernance()
+	epoch := gov.Epoch()
+
+	// The default limits are used unless they are voted
+	assert.Equal(t, params.DefaultBlockGasLimit, gov.BlockGasLimit(epoch))
+	assert.Equal(t, params.OpcodeComputationCostLimit, gov.ComputationCostLimit(epoch))
+
+	src := NewGovernanceSet()
+	src.Import(gov.currentSet.Items())
+	src.SetValue(params.BlockGasLimit, uint64(50000000))
+	src.SetValue(params.ComputationCostLimit, uint64(200000000))
+	assert.NoError(t, gov.WriteGovernance(epoch, src, NewGovernanceSet()))
+
+	// The limits written at an epoch block are applied from the next epoch
+	assert.Equal(t, params.DefaultBlockGasLimit, gov.BlockGasLimit(2*epoch-1))
+	assert.Equal(t, params.OpcodeComputationCostLimit, gov.ComputationCostLimit(2*epoch-1))
+	assert.Equal(t, uint64(50000000), gov.BlockGasLimit(2*epoch))
+	assert.Equal(t, uint64(200000000), gov.ComputationCostLimit(2*epoch))
+}
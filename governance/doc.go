@@ -24,6 +24,13 @@ How to cast a vote
 To cast a vote, a node have to be a member of the Governance Council.
 If the governance mode is "single", only one designated node (the governing node) can vote.
 In the console of the node, "governance.vote(key, value)" API can be used to cast a vote.
+A change approved by the votes is written at the next epoch block and applied from the epoch block after it.
+To apply a change from a later epoch block, "governance.voteAt(key, value, activationBlock)" API can be used to cast
+a scheduled vote. An approved scheduled change is pending until it is written at the epoch block before its activation
+block, and it can be cancelled by the votes cast with "governance.cancelScheduledChange(key, activationBlock)".
+The scheduled votes are only allowed after the scheduled governance fork (ScheduledGovernanceCompatibleBlock).
+If the governance mode is "contract", the parameters are changed by the governance contract instead, and only
+the votes to add or remove a validator can be casted.
 
 Keys for the voting API
 
@@ -40,6 +47,19 @@ Following keys can be handled as of 7/20/2019.
   - "reward.useginicoeff"         : To change the application of gini coefficient to reduce gap between CCOs
   - "reward.deferredtxfee"        : To change the way of distributing tx fee
   - "reward.minimumstake"         : To change the minimum amount of stake to participate in the governance council
+  - "reward.stakingreward"        : To distribute the CN reward to all staked council members in proportion to their stakes (after the staking reward fork)
+  - "dynamicfee.lowerboundbasefee"  : To change the minimum base fee of the dynamic fee mode (not higher than the maximum)
+  - "dynamicfee.upperboundbasefee"  : To change the maximum base fee of the dynamic fee mode (not lower than the minimum)
+  - "dynamicfee.gastarget"          : To change the gas used by a block keeping the base fee unchanged
+  - "dynamicfee.basefeedenominator" : To change the rate of the base fee change between blocks (1/denominator at most)
+  - "dynamicfee.burnratio"          : To change the percentage of the tx fee burned in the dynamic fee mode
+  - "governance.govparamcontract"   : To change the governance contract used in the contract governance mode
+  - "param.blockgaslimit"           : To change the gas limit of all txs in a block (0 means no limit)
+  - "param.computationcostlimit"    : To change the opcode computation cost limit of a tx
+  - "istanbul.blockperiod"          : To change the minimum time difference between two consecutive blocks in seconds
+  - "txpool.maxtxsize"              : To change the maximum size of a tx accepted by the tx pool
+  - "txpool.minimumprice"           : To change the minimum gas price of a tx accepted by the tx pool
+  - "istanbul.demotionthreshold"    : To change the percentage of missed proposer turns and committed seals in an epoch over which a validator is excluded from the proposers and the committee (0 means disabled)
 
 
 How governance works
@@ -54,6 +74,17 @@ parse the header and handle it. This process is handled by snapshot.go in the co
 If a vote satisfies the requirement (more than 50% of votes in favor of), it will update the governance struct and many other packages
 like "reward", "txpool" and so on will reference it.
 
+How the contract governance mode works
+
+In the "contract" governance mode, parameter proposals, voting and execution happen in the governance contract
+(contracts/gov/GovParam.sol) at the address of "governance.govparamcontract". At every epoch, the proposer reads
+the parameters set in the contract from the state after the transactions of the block, and writes the ones differing
+from the current governance to the block header. The other nodes read the contract in the same way and reject the
+block if the header doesn't match. The changes are then applied as the ones voted in the "ballot" mode.
+
+To migrate from the "ballot" mode, vote "governance.govparamcontract" to the address of the deployed contract and
+then "governance.governancemode" to "contract". The contract can set "governance.governancemode" back to "ballot".
+
 
 Source Files
 
@@ -61,6 +92,9 @@ Governance related functions and variables are defined in the files listed below
   - default.go    : the governance struct, cache and persistence
   - handler.go    : functions to handle votes and its application
   - api.go        : console APIs to get governance information and to cast a vote
+  - contract.go   : functions to read the parameters from the governance contract in the contract governance mode
+  - history.go    : the index of the governance changes with the votes which led to them
+  - schedule.go   : the governance changes scheduled to be applied from an explicit activation block
 
 */
 package governance
@@ -29,6 +29,12 @@ import (
 	"sync/atomic"
 )
 
+const (
+	minBlockPeriod = uint64(1)        // The minimum block period in seconds
+	maxBlockPeriod = uint64(60)       // The maximum block period in seconds
+	maxTxSizeLimit = 10 * 1024 * 1024 // The maximum cap on the size of a protocol message
+)
+
 type check struct {
 	t         reflect.Type
 	validator func(k string, v interface{}) bool
@@ -61,6 +67,19 @@ var GovernanceItems = map[int]check{
 	params.CommitteeSize:           {uint64T, checkUint64andBool, nil},
 	params.ConstTxGasHumanReadable: {uint64T, checkUint64andBool, updateTxGasHumanReadable},
 	params.Timeout:                 {uint64T, checkUint64andBool, nil},
+	params.LowerBoundBaseFee:       {uint64T, checkUint64andBool, nil},
+	params.UpperBoundBaseFee:       {uint64T, checkUint64andBool, nil},
+	params.GasTarget:               {uint64T, checkNonZeroUint64, nil},
+	params.BaseFeeDenominator:      {uint64T, checkNonZeroUint64, nil},
+	params.BurnRatio:               {uint64T, checkPercentage, nil},
+	params.GovParamContract:        {addressT, checkAddress, nil},
+	params.BlockGasLimit:           {uint64T, checkUint64andBool, nil},
+	params.ComputationCostLimit:    {uint64T, checkNonZeroUint64, nil},
+	params.BlockPeriod:             {uint64T, checkBlockPeriod, nil},
+	params.MaxTxSize:               {uint64T, checkMaxTxSize, updateMaxTxSize},
+	params.MinimumTxPoolPrice:      {uint64T, checkUint64andBool, updateMinimumTxPoolPrice},
+	params.StakingReward:           {boolT, checkUint64andBool, nil},
+	params.DemotionThreshold:       {uint64T, checkPercentage, nil},
 }
 
 func updateTxGasHumanReadable(g *Governance, k string, v interface{}) {
@@ -68,6 +87,18 @@ func updateTxGasHumanReadable(g *Governance, k string, v interface{}) {
 	logger.Info("TxGasHumanReadable changed", "New value", params.TxGasHumanReadable)
 }
 
+func updateMaxTxSize(g *Governance, k string, v interface{}) {
+	if g.TxPool != nil {
+		g.TxPool.SetMaxTxSize(v.(uint64))
+	}
+}
+
+func updateMinimumTxPoolPrice(g *Governance, k string, v interface{}) {
+	if g.TxPool != nil {
+		g.TxPool.SetMinimumPrice(new(big.Int).SetUint64(v.(uint64)))
+	}
+}
+
 func updateUnitPrice(g *Governance, k string, v interface{}) {
 	newPrice := v.(uint64)
 	if g.TxPool != nil {
@@ -97,6 +128,13 @@ func updateProposerPolicy(g *Governance, k string, v interface{}) {
 
 // AddVote adds a vote to the voteMap
 func (g *Governance) AddVote(key string, val interface{}) bool {
+	return g.AddScheduledVote(key, val, 0, false)
+}
+
+// AddScheduledVote adds a vote to the voteMap, which is applied from the activation block
+// or cancels the change scheduled at the activation block. A vote with zero activation block
+// is applied from the epoch after the next one as usual.
+func (g *Governance) AddScheduledVote(key string, val interface{}, activationBlock uint64, cancel bool) bool {
 	key = g.getKey(key)
 
 	// If the key is forbidden, stop processing it
@@ -104,13 +142,19 @@ func (g *Governance) AddVote(key string, val interface{}) bool {
 		return false
 	}
 
-	vote := &GovernanceVote{Key: key, Value: val}
+	// In the contract mode, the parameters are changed by the governance contract
+	if g.inContractMode() && !isContractVoteKey(key) {
+		return false
+	}
+
+	vote := &GovernanceVote{Key: key, Value: val, ActivationBlock: activationBlock, Cancel: cancel}
 	var ok bool
 	if vote, ok = g.ValidateVote(vote); ok {
-		g.voteMap.SetValue(key, VoteStatus{
+		g.voteMap.SetValue(VoteKey{Key: key, ActivationBlock: activationBlock}, VoteStatus{
 			Value:  vote.Value,
 			Casted: false,
 			Num:    0,
+			Cancel: cancel,
 		})
 		return true
 	}
@@ -166,11 +210,38 @@ func (gov *Governance) ValidateVote(vote *GovernanceVote) (*GovernanceVote, bool
 	vote.Value = gov.adjustValueType(vote.Key, vote.Value)
 
 	if gov.checkKey(vote.Key) && gov.checkType(vote) {
-		return vote, GovernanceItems[key].validator(vote.Key, vote.Value)
+		return vote, GovernanceItems[key].validator(vote.Key, vote.Value) && gov.checkBaseFeeBounds(key, vote.Value)
 	}
 	return vote, false
 }
 
+// checkBaseFeeBounds checks that a vote on a bound of the base fee does not invert the bounds.
+// The value is compared with the other bound passed to be applied together, or the current one.
+func (gov *Governance) checkBaseFeeBounds(key int, v interface{}) bool {
+	var other int
+	switch key {
+	case params.LowerBoundBaseFee:
+		other = params.UpperBoundBaseFee
+	case params.UpperBoundBaseFee:
+		other = params.LowerBoundBaseFee
+	default:
+		return true
+	}
+
+	config := params.DefaultDynamicFeeConfig()
+	bounds := map[int]*uint64{
+		params.LowerBoundBaseFee: &config.LowerBoundBaseFee,
+		params.UpperBoundBaseFee: &config.UpperBoundBaseFee,
+	}
+	if value, ok := gov.changeSet.GetValue(other); ok {
+		*bounds[other] = value.(uint64)
+	} else if value, ok := gov.currentSet.GetValue(other); ok {
+		*bounds[other] = value.(uint64)
+	}
+	*bounds[key] = v.(uint64)
+	return config.CheckBaseFeeBounds() == nil
+}
+
 func checkRatio(k string, v interface{}) bool {
 	x := strings.Split(v.(string), "/")
 	if len(x) != params.RewardSliceCount {
@@ -206,6 +277,27 @@ func checkUint64andBool(k string, v interface{}) bool {
 	return false
 }
 
+func checkNonZeroUint64(k string, v interface{}) bool {
+	return v.(uint64) != 0
+}
+
+// checkBlockPeriod checks the block period is long enough to produce a block and
+// short enough not to be taken as a failure of the consensus.
+func checkBlockPeriod(k string, v interface{}) bool {
+	period := v.(uint64)
+	return period >= minBlockPeriod && period <= maxBlockPeriod
+}
+
+// checkMaxTxSize checks a transaction of the size can be propagated in a protocol message.
+func checkMaxTxSize(k string, v interface{}) bool {
+	size := v.(uint64)
+	return size != 0 && size <= maxTxSizeLimit
+}
+
+func checkPercentage(k string, v interface{}) bool {
+	return v.(uint64) <= 100
+}
+
 func checkProposerPolicy(k string, v interface{}) bool {
 	if _, ok := ProposerPolicyMap[v.(string)]; ok {
 		return true
@@ -246,6 +338,12 @@ func (gov *Governance) HandleGovernanceVote(valset istanbul.ValidatorSet, votes
 			return valset, votes, tally
 		}
 
+		// In the contract mode, only the votes on the validators are handled
+		if gov.inContractMode() && !isContractVoteKey(gVote.Key) {
+			logger.Warn("Parameter vote was received in the contract governance mode", "key", gVote.Key, "value", gVote.Value, "from", gVote.Validator)
+			return valset, votes, tally
+		}
+
 		key := GovernanceKeyMap[gVote.Key]
 		switch key {
 		case params.GoverningNode:
@@ -265,8 +363,9 @@ func (gov *Governance) HandleGovernanceVote(valset istanbul.ValidatorSet, votes
 		}
 
 		number := header.Number.Uint64()
+		gVote.BlockNum = number
 		// Check vote's validity
-		if gVote, ok := gov.ValidateVote(gVote); ok {
+		if gVote, ok := gov.ValidateVote(gVote); ok && gov.checkSchedule(gVote, number) {
 			governanceMode := GovernanceModeMap[gov.GovernanceMode()]
 			governingNode := gov.GoverningNode()
 
@@ -311,18 +410,23 @@ func (gov *Governance) removePreviousVote(valset istanbul.ValidatorSet, votes []
 	// Removing duplicated previous GovernanceVotes
 	for idx, vote := range votes {
 		// Check if previous vote from same validator exists
-		if vote.Validator == validator && vote.Key == gVote.Key {
+		// A scheduled vote is distinguished by its activation block and whether it cancels
+		if vote.Validator == validator && vote.Key == gVote.Key && vote.ActivationBlock == gVote.ActivationBlock && vote.Cancel == gVote.Cancel {
 			// Reduce Tally
 			_, v := valset.GetByAddress(vote.Validator)
 			vp := v.VotingPower()
 			var currentVotes uint64
-			currentVotes, tally = gov.changeGovernanceTally(tally, vote.Key, vote.Value, vp, false)
+			currentVotes, tally = gov.changeGovernanceTally(tally, vote, vp, false)
 
 			// Remove the old vote from GovernanceVotes
 			ret = append(votes[:idx], votes[idx+1:]...)
 			if gov.isGovernanceModeSingleOrNone(governanceMode, governingNode, gVote.Validator) ||
 				(governanceMode == params.GovernanceMode_Ballot && currentVotes <= valset.TotalVotingPower()/2) {
-				if v, ok := gov.changeSet.GetValue(GovernanceKeyMap[vote.Key]); ok && v == vote.Value {
+				if vote.ActivationBlock > 0 {
+					if !vote.Cancel {
+						gov.scheduledChanges.Remove(ScheduledChange{ActivationBlock: vote.ActivationBlock, Key: vote.Key, Value: vote.Value})
+					}
+				} else if v, ok := gov.changeSet.GetValue(GovernanceKeyMap[vote.Key]); ok && v == vote.Value {
 					gov.changeSet.RemoveItem(vote.Key)
 				}
 			}
@@ -333,14 +437,14 @@ func (gov *Governance) removePreviousVote(valset istanbul.ValidatorSet, votes []
 }
 
 // changeGovernanceTally updates snapshot's tally for governance votes.
-func (gov *Governance) changeGovernanceTally(tally []GovernanceTallyItem, key string, value interface{}, vp uint64, isAdd bool) (uint64, []GovernanceTallyItem) {
+func (gov *Governance) changeGovernanceTally(tally []GovernanceTallyItem, vote GovernanceVote, vp uint64, isAdd bool) (uint64, []GovernanceTallyItem) {
 	found := false
 	var currentVote uint64
 	ret := make([]GovernanceTallyItem, len(tally))
 	copy(ret, tally)
 
 	for idx, v := range tally {
-		if v.Key == key && v.Value == value {
+		if v.Key == vote.Key && v.Value == vote.Value && v.ActivationBlock == vote.ActivationBlock && v.Cancel == vote.Cancel {
 			if isAdd {
 				ret[idx].Votes += vp
 			} else {
@@ -362,7 +466,7 @@ func (gov *Governance) changeGovernanceTally(tally []GovernanceTallyItem, key st
 	}
 
 	if !found && isAdd {
-		ret = append(ret, GovernanceTallyItem{Key: key, Value: value, Votes: vp})
+		ret = append(ret, GovernanceTallyItem{Key: vote.Key, Value: vote.Value, Votes: vp, ActivationBlock: vote.ActivationBlock, Cancel: vote.Cancel})
 		return vp, ret
 	} else {
 		return currentVote, ret
@@ -374,9 +478,16 @@ func (gov *Governance) addNewVote(valset istanbul.ValidatorSet, votes []Governan
 	if v != nil {
 		vp := v.VotingPower()
 		var currentVotes uint64
-		currentVotes, tally = gov.changeGovernanceTally(tally, gVote.Key, gVote.Value, vp, true)
+		currentVotes, tally = gov.changeGovernanceTally(tally, *gVote, vp, true)
 		if gov.isGovernanceModeSingleOrNone(governanceMode, governingNode, gVote.Validator) ||
-			(governanceMode == params.GovernanceMode_Ballot && currentVotes > valset.TotalVotingPower()/2) {
+			((governanceMode == params.GovernanceMode_Ballot || governanceMode == params.GovernanceMode_Contract) && currentVotes > valset.TotalVotingPower()/2) {
+			// A scheduled change is applied when its activation block comes
+			if gVote.ActivationBlock > 0 {
+				if blockNum > atomic.LoadUint64(&gov.lastGovernanceStateBlock) {
+					gov.reflectScheduledVote(*gVote)
+				}
+				return valset, votes, tally
+			}
 			switch GovernanceKeyMap[gVote.Key] {
 			case params.AddValidator:
 				valset.AddValidator(gVote.Value.(common.Address))
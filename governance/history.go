@@ -0,0 +1,180 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync/atomic"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/params"
+)
+
+var errInvalidBlockRange = errors.New("fromBlock is greater than toBlock")
+
+// ParamVote is a vote cast for a governance parameter.
+type ParamVote struct {
+	Voter    common.Address `json:"voter"`
+	BlockNum uint64         `json:"blockNum"`
+	Value    interface{}    `json:"value"`
+}
+
+// ParamChange is a change of a governance parameter written at a governance block,
+// with the votes and the tally which led to it.
+type ParamChange struct {
+	Key                string      `json:"key"`
+	Value              interface{} `json:"value"`
+	BlockNum           uint64      `json:"blockNum"`        // The block where the change is written
+	ActivationBlock    uint64      `json:"activationBlock"` // The first block where the change is applied
+	Votes              []ParamVote `json:"votes"`
+	Tally              uint64      `json:"tally"`
+	ApprovalPercentage float64     `json:"approvalPercentage"`
+}
+
+// writeParamChanges stores the governance changes written at the block of the given number
+// with the votes and the tally of the epoch. It should be called before the votes are cleared.
+func (gov *Governance) writeParamChanges(num uint64, items map[string]interface{}) {
+	if gov.db == nil {
+		return
+	}
+	var (
+		votes            = gov.GovernanceVotes.Copy()
+		tally            = gov.GovernanceTallies.Copy()
+		totalVotingPower = atomic.LoadUint64(&gov.totalVotingPower)
+		activation       = num + gov.epochAt(num)
+	)
+
+	changes := make([]*ParamChange, 0, len(items))
+	for key, value := range items {
+		change := &ParamChange{Key: key, Value: value, BlockNum: num, ActivationBlock: activation, Votes: []ParamVote{}}
+		for _, vote := range votes {
+			if vote.Key == key && vote.Value == value && vote.ActivationBlock == 0 {
+				change.Votes = append(change.Votes, ParamVote{Voter: vote.Validator, BlockNum: vote.BlockNum, Value: vote.Value})
+			}
+		}
+		for _, item := range tally {
+			if item.Key == key && item.Value == value && item.ActivationBlock == 0 {
+				change.Tally = item.Votes
+				if totalVotingPower > 0 {
+					change.ApprovalPercentage = float64(item.Votes) / float64(totalVotingPower) * 100
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+
+	b, err := json.Marshal(changes)
+	if err != nil {
+		logger.Error("Failed to marshal governance changes", "number", num, "err", err)
+		return
+	}
+	if err := gov.db.WriteGovernanceChanges(num, b); err != nil {
+		logger.Error("Failed to write governance changes", "number", num, "err", err)
+	}
+}
+
+// readParamChanges returns the governance changes written at the block of the given number.
+// The changes written before the changes are indexed are derived from the governance
+// stored at the block and at the previous governance block, without the votes.
+func (gov *Governance) readParamChanges(num uint64, prev uint64) ([]*ParamChange, error) {
+	if b, err := gov.db.ReadGovernanceChanges(num); err == nil {
+		var changes []*ParamChange
+		if err := json.Unmarshal(b, &changes); err != nil {
+			return nil, err
+		}
+		// Restore the types of the values as the ones of the governance items
+		adjust := func(key string, value interface{}) interface{} {
+			return adjustDecodedSet(map[string]interface{}{key: value})[key]
+		}
+		for _, change := range changes {
+			change.Value = adjust(change.Key, change.Value)
+			for i := range change.Votes {
+				change.Votes[i].Value = adjust(change.Key, change.Votes[i].Value)
+			}
+		}
+		return changes, nil
+	}
+
+	data, err := gov.db.ReadGovernance(num)
+	if err != nil {
+		return nil, err
+	}
+	prevData, err := gov.db.ReadGovernance(prev)
+	if err != nil {
+		return nil, err
+	}
+	data, prevData = adjustDecodedSet(data), adjustDecodedSet(prevData)
+
+	changes := make([]*ParamChange, 0)
+	activation := num + gov.epochAt(num)
+	for key, value := range data {
+		if prevValue, ok := prevData[key]; !ok || prevValue != value {
+			changes = append(changes, &ParamChange{Key: key, Value: value, BlockNum: num, ActivationBlock: activation, Votes: []ParamVote{}})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes, nil
+}
+
+// epochAt returns the epoch in force at the block of the given number, after which
+// the changes written at the block are applied.
+func (gov *Governance) epochAt(num uint64) uint64 {
+	if v, err := gov.GetItemAtNumberByIntKey(num, params.Epoch); err == nil {
+		if epoch, ok := v.(uint64); ok && epoch > 0 {
+			return epoch
+		}
+	}
+	return gov.Epoch()
+}
+
+// ParamHistory returns the changes of the governance parameter of the given key which
+// are applied from fromBlock to toBlock, in the order of the activation.
+func (gov *Governance) ParamHistory(key string, fromBlock, toBlock uint64) ([]*ParamChange, error) {
+	key = gov.getKey(key)
+	if _, ok := GovernanceKeyMap[key]; !ok {
+		return nil, ErrUnknownKey
+	}
+	if fromBlock > toBlock {
+		return nil, errInvalidBlockRange
+	}
+	if gov.db == nil {
+		return nil, ErrNotInitialized
+	}
+
+	indices, err := gov.db.ReadRecentGovernanceIdx(0)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*ParamChange, 0)
+	// The governance of the genesis block is not a change
+	for i := 1; i < len(indices) && indices[i] <= toBlock; i++ {
+		changes, err := gov.readParamChanges(indices[i], indices[i-1])
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range changes {
+			if change.Key == key && change.ActivationBlock >= fromBlock && change.ActivationBlock <= toBlock {
+				history = append(history, change)
+			}
+		}
+	}
+	return history, nil
+}
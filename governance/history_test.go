@@ -0,0 +1,188 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeGovernanceChange(t *testing.T, change map[string]interface{}) []byte {
+	data, err := json.Marshal(change)
+	assert.NoError(t, err)
+	encoded, err := rlp.EncodeToBytes(data)
+	assert.NoError(t, err)
+	return encoded
+}
+
+func TestGovernance_ParamHistory(t *testing.T) {
+	gov := getGovernance()
+	epoch := gov.Epoch()
+	voters := getTestCouncil()
+
+	// The votes of the first epoch which changed the unit price
+	gov.SetTotalVotingPower(4000)
+	gov.GovernanceVotes.Import([]GovernanceVote{
+		{Validator: voters[0], Key: "governance.unitprice", Value: uint64(50), BlockNum: 10},
+		{Validator: voters[1], Key: "governance.unitprice", Value: uint64(50), BlockNum: 11},
+		{Validator: voters[2], Key: "governance.unitprice", Value: uint64(60), BlockNum: 12},
+		{Validator: voters[2], Key: "reward.mintingamount", Value: "1", BlockNum: 13},
+	})
+	gov.GovernanceTallies.Import([]GovernanceTallyItem{
+		{Key: "governance.unitprice", Value: uint64(50), Votes: 3000},
+		{Key: "governance.unitprice", Value: uint64(60), Votes: 1000},
+		{Key: "reward.mintingamount", Value: "1", Votes: 1000},
+	})
+	gov.UpdateGovernance(epoch, encodeGovernanceChange(t, map[string]interface{}{"governance.unitprice": uint64(50)}))
+	gov.ClearVotes(epoch)
+
+	// A change stored before the changes are indexed
+	prev := NewGovernanceSet()
+	prev.Import(gov.currentSet.Items())
+	prev.SetValue(params.UnitPrice, uint64(70))
+	assert.NoError(t, gov.WriteGovernance(2*epoch, prev, NewGovernanceSet()))
+
+	history, err := gov.ParamHistory("Governance.UnitPrice", 0, 10*epoch)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ParamChange{
+		{
+			Key:             "governance.unitprice",
+			Value:           uint64(50),
+			BlockNum:        epoch,
+			ActivationBlock: 2 * epoch,
+			Votes: []ParamVote{
+				{Voter: voters[0], BlockNum: 10, Value: uint64(50)},
+				{Voter: voters[1], BlockNum: 11, Value: uint64(50)},
+			},
+			Tally:              3000,
+			ApprovalPercentage: 75,
+		},
+		{
+			Key:             "governance.unitprice",
+			Value:           uint64(70),
+			BlockNum:        2 * epoch,
+			ActivationBlock: 3 * epoch,
+			Votes:           []ParamVote{},
+		},
+	}, history)
+
+	// The changes are filtered by the activation block
+	history, err = gov.ParamHistory("governance.unitprice", 2*epoch+1, 3*epoch)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(history)) {
+		assert.Equal(t, uint64(70), history[0].Value)
+	}
+	history, err = gov.ParamHistory("reward.mintingamount", 0, 10*epoch)
+	assert.NoError(t, err)
+	assert.Empty(t, history)
+
+	_, err = gov.ParamHistory("governance.unknown", 0, 10*epoch)
+	assert.Equal(t, ErrUnknownKey, err)
+	_, err = gov.ParamHistory("governance.unitprice", 2, 1)
+	assert.Equal(t, errInvalidBlockRange, err)
+}
+
+func TestGovernance_ParamHistory_EpochChange(t *testing.T) {
+	gov := getGovernance()
+	epoch := gov.Epoch()
+
+	// The epoch is doubled from the second epoch, so the change written at the second epoch block
+	// is applied after the doubled epoch
+	gov.UpdateGovernance(epoch, encodeGovernanceChange(t, map[string]interface{}{"istanbul.epoch": 2 * epoch}))
+	gov.UpdateGovernance(2*epoch, encodeGovernanceChange(t, map[string]interface{}{"governance.unitprice": uint64(50)}))
+
+	history, err := gov.ParamHistory("istanbul.epoch", 0, 10*epoch)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(history)) {
+		assert.Equal(t, 2*epoch, history[0].ActivationBlock)
+	}
+	history, err = gov.ParamHistory("governance.unitprice", 0, 10*epoch)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(history)) {
+		assert.Equal(t, 4*epoch, history[0].ActivationBlock)
+	}
+
+	// The changes stored before the changes are indexed are applied after the epoch at the block as well
+	gov = getGovernance()
+	set := NewGovernanceSet()
+	set.Import(gov.currentSet.Items())
+	set.SetValue(params.Epoch, 2*epoch)
+	assert.NoError(t, gov.WriteGovernance(epoch, set, NewGovernanceSet()))
+	set.SetValue(params.UnitPrice, uint64(70))
+	assert.NoError(t, gov.WriteGovernance(2*epoch, set, NewGovernanceSet()))
+
+	history, err = gov.ParamHistory("governance.unitprice", 0, 10*epoch)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(history)) {
+		assert.Equal(t, uint64(70), history[0].Value)
+		assert.Equal(t, 4*epoch, history[0].ActivationBlock)
+	}
+}
+
+type testHeadChain struct {
+	head *types.Header
+}
+
+func (bc *testHeadChain) CurrentHeader() *types.Header                  { return bc.head }
+func (bc *testHeadChain) GetHeaderByNumber(number uint64) *types.Header { return nil }
+func (bc *testHeadChain) SetProposerPolicy(val uint64)                  {}
+func (bc *testHeadChain) SetUseGiniCoeff(val bool)                      {}
+
+func TestPublicGovernanceAPI_ParamHistory(t *testing.T) {
+	gov := getGovernance()
+	epoch := gov.Epoch()
+	gov.SetBlockchain(&testHeadChain{head: &types.Header{Number: new(big.Int).SetUint64(3 * epoch)}})
+	gov.UpdateGovernance(epoch, encodeGovernanceChange(t, map[string]interface{}{"governance.unitprice": uint64(50)}))
+	api := NewGovernanceAPI(gov)
+
+	// The latest and the pending blocks are resolved to the current head
+	latest, pending := rpc.LatestBlockNumber, rpc.PendingBlockNumber
+	for _, to := range []*rpc.BlockNumber{nil, &latest, &pending} {
+		history, err := api.ParamHistory("governance.unitprice", rpc.BlockNumber(0), to)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(history))
+	}
+	history, err := api.ParamHistory("governance.unitprice", rpc.LatestBlockNumber, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, history)
+
+	// The other negative block numbers are rejected
+	invalid := rpc.BlockNumber(-3)
+	_, err = api.ParamHistory("governance.unitprice", invalid, nil)
+	assert.Equal(t, errUnknownBlock, err)
+	_, err = api.ParamHistory("governance.unitprice", rpc.BlockNumber(0), &invalid)
+	assert.Equal(t, errUnknownBlock, err)
+}
+
+func TestGovernanceVote_BlockNumNotEncoded(t *testing.T) {
+	vote := &GovernanceVote{Validator: common.HexToAddress("0x1000"), Key: "governance.unitprice", Value: uint64(50)}
+	encoded, err := rlp.EncodeToBytes(vote)
+	assert.NoError(t, err)
+
+	vote.BlockNum = 10
+	withBlockNum, err := rlp.EncodeToBytes(vote)
+	assert.NoError(t, err)
+	assert.Equal(t, encoded, withBlockNum)
+}
@@ -0,0 +1,179 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klaytn/klaytn/params"
+)
+
+// ScheduledChange is a governance change approved to be applied from its activation block.
+type ScheduledChange struct {
+	ActivationBlock uint64      `json:"activationBlock"`
+	Key             string      `json:"key"`
+	Value           interface{} `json:"value"`
+}
+
+// ScheduledChangeList is the list of the scheduled changes in the order of the activation.
+type ScheduledChangeList struct {
+	items []ScheduledChange
+	mu    *sync.RWMutex
+}
+
+func NewScheduledChangeList() ScheduledChangeList {
+	return ScheduledChangeList{
+		items: []ScheduledChange{},
+		mu:    new(sync.RWMutex),
+	}
+}
+
+func (sl *ScheduledChangeList) Copy() []ScheduledChange {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	ret := make([]ScheduledChange, len(sl.items))
+	copy(ret, sl.items)
+
+	return ret
+}
+
+func (sl *ScheduledChangeList) Import(src []ScheduledChange) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.items = make([]ScheduledChange, len(src))
+	copy(sl.items, src)
+}
+
+// Get returns the scheduled change of the key at the activation block.
+func (sl *ScheduledChangeList) Get(key string, activationBlock uint64) (ScheduledChange, bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	for _, item := range sl.items {
+		if item.Key == key && item.ActivationBlock == activationBlock {
+			return item, true
+		}
+	}
+	return ScheduledChange{}, false
+}
+
+// Add adds a scheduled change, replacing the one of the same key at the same activation block.
+func (sl *ScheduledChangeList) Add(change ScheduledChange) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	for idx, item := range sl.items {
+		if item.Key == change.Key && item.ActivationBlock == change.ActivationBlock {
+			sl.items[idx] = change
+			return
+		}
+	}
+	sl.items = append(sl.items, change)
+	sort.SliceStable(sl.items, func(i, j int) bool {
+		if sl.items[i].ActivationBlock != sl.items[j].ActivationBlock {
+			return sl.items[i].ActivationBlock < sl.items[j].ActivationBlock
+		}
+		return sl.items[i].Key < sl.items[j].Key
+	})
+}
+
+// Remove removes the scheduled change if it is the same as the given one.
+func (sl *ScheduledChangeList) Remove(change ScheduledChange) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	for idx, item := range sl.items {
+		if item == change {
+			sl.items = append(sl.items[:idx:idx], sl.items[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// PopUntil removes and returns the scheduled changes activated at or before the given block.
+func (sl *ScheduledChangeList) PopUntil(num uint64) []ScheduledChange {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	idx := sort.Search(len(sl.items), func(i int) bool { return sl.items[i].ActivationBlock > num })
+	ret := make([]ScheduledChange, idx)
+	copy(ret, sl.items[:idx])
+	sl.items = append([]ScheduledChange{}, sl.items[idx:]...)
+	return ret
+}
+
+// checkSchedule returns true if the vote can be included in the block of the given number.
+// A scheduled vote is only allowed after the scheduled governance fork, and should be activated
+// at an epoch block after the one from which a change voted in the block is applied, so that
+// it can be written at an epoch block ahead.
+func (gov *Governance) checkSchedule(vote *GovernanceVote, number uint64) bool {
+	if vote.ActivationBlock == 0 {
+		return !vote.Cancel
+	}
+	if !gov.ChainConfig.IsScheduledGovernanceForkEnabled(new(big.Int).SetUint64(number)) {
+		return false
+	}
+
+	// The validators and the timeout are changed as soon as the vote is passed
+	switch GovernanceKeyMap[vote.Key] {
+	case params.AddValidator, params.RemoveValidator, params.Timeout:
+		return false
+	}
+
+	epoch := gov.Epoch()
+	nextEpochBlock := number - number%epoch + epoch
+	return vote.ActivationBlock%epoch == 0 && vote.ActivationBlock >= nextEpochBlock+2*epoch
+}
+
+// reflectScheduledVote schedules or cancels the change of a passed scheduled vote.
+func (gov *Governance) reflectScheduledVote(vote GovernanceVote) {
+	change := ScheduledChange{ActivationBlock: vote.ActivationBlock, Key: vote.Key, Value: vote.Value}
+	if vote.Cancel {
+		if gov.scheduledChanges.Remove(change) {
+			logger.Info("Scheduled governance change is cancelled", "key", vote.Key, "value", vote.Value, "activationBlock", vote.ActivationBlock)
+		}
+		return
+	}
+	gov.scheduledChanges.Add(change)
+	logger.Info("Governance change is scheduled", "key", vote.Key, "value", vote.Value, "activationBlock", vote.ActivationBlock)
+}
+
+// ApplyScheduledChanges moves the scheduled changes into the change set at the epoch block
+// of the given number, if they are to be activated by the epoch after the next one.
+// The changes are then written at the next epoch block as the ones voted in this epoch.
+// It should be called after the votes are cleared at the epoch block.
+func (gov *Governance) ApplyScheduledChanges(num uint64) {
+	// The changes of the old blocks are already applied in the stored governance state
+	if num <= atomic.LoadUint64(&gov.lastGovernanceStateBlock) {
+		return
+	}
+	for _, change := range gov.scheduledChanges.PopUntil(num + 2*gov.Epoch()) {
+		gov.ReflectVotes(GovernanceVote{Key: change.Key, Value: change.Value})
+		logger.Info("Scheduled governance change is applied", "key", change.Key, "value", change.Value, "activationBlock", change.ActivationBlock)
+	}
+}
+
+// ScheduledChanges returns the scheduled changes not yet moved into the change set.
+func (gov *Governance) ScheduledChanges() []ScheduledChange {
+	return gov.scheduledChanges.Copy()
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	"github.com/klaytn/klaytn/consensus/istanbul/validator"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledChangeList(t *testing.T) {
+	list := NewScheduledChangeList()
+	list.Add(ScheduledChange{ActivationBlock: 300, Key: "governance.unitprice", Value: uint64(1)})
+	list.Add(ScheduledChange{ActivationBlock: 200, Key: "reward.mintingamount", Value: "1"})
+	list.Add(ScheduledChange{ActivationBlock: 200, Key: "governance.unitprice", Value: uint64(2)})
+	// Replaces the one of the same key at the same activation block
+	list.Add(ScheduledChange{ActivationBlock: 300, Key: "governance.unitprice", Value: uint64(3)})
+
+	assert.Equal(t, []ScheduledChange{
+		{ActivationBlock: 200, Key: "governance.unitprice", Value: uint64(2)},
+		{ActivationBlock: 200, Key: "reward.mintingamount", Value: "1"},
+		{ActivationBlock: 300, Key: "governance.unitprice", Value: uint64(3)},
+	}, list.Copy())
+
+	// Only the same change is removed
+	assert.False(t, list.Remove(ScheduledChange{ActivationBlock: 300, Key: "governance.unitprice", Value: uint64(1)}))
+	assert.True(t, list.Remove(ScheduledChange{ActivationBlock: 200, Key: "reward.mintingamount", Value: "1"}))
+
+	assert.Equal(t, []ScheduledChange{{ActivationBlock: 200, Key: "governance.unitprice", Value: uint64(2)}}, list.PopUntil(299))
+	assert.Equal(t, []ScheduledChange{{ActivationBlock: 300, Key: "governance.unitprice", Value: uint64(3)}}, list.Copy())
+}
+
+func TestGovernanceVote_ScheduledEncoding(t *testing.T) {
+	// A vote without the activation block is encoded as before
+	type legacyVote struct {
+		Validator common.Address
+		Key       string
+		Value     interface{}
+	}
+	legacy, _ := rlp.EncodeToBytes(&legacyVote{common.HexToAddress("0x1000"), "governance.unitprice", uint64(50)})
+	encoded, _ := rlp.EncodeToBytes(&GovernanceVote{Validator: common.HexToAddress("0x1000"), Key: "governance.unitprice", Value: uint64(50)})
+	assert.Equal(t, legacy, encoded)
+
+	// The activation block is decoded
+	encoded, _ = rlp.EncodeToBytes(&GovernanceVote{Validator: common.HexToAddress("0x1000"), Key: "governance.unitprice", Value: uint64(50), ActivationBlock: 300, Cancel: true})
+	vote := new(GovernanceVote)
+	assert.NoError(t, rlp.DecodeBytes(encoded, vote))
+	assert.Equal(t, uint64(300), vote.ActivationBlock)
+	assert.True(t, vote.Cancel)
+}
+
+func TestGovernance_HandleGovernanceVote_Scheduled(t *testing.T) {
+	council := getTestCouncil()
+	valSet := istanbul.ValidatorSet(validator.NewWeightedCouncil(council, getTestRewards(), getTestVotingPowers(len(council)), nil, istanbul.WeightedRandom, 21, 0, 0, nil))
+
+	config := *getTestConfig()
+	config.Governance = GetDefaultGovernanceConfig(params.UseIstanbul)
+	config.Governance.GovernanceMode = GovernanceModeBallot
+	config.Istanbul = &params.IstanbulConfig{Epoch: 10, ProposerPolicy: params.DefaultProposerPolicy, SubGroupSize: params.DefaultSubGroupSize}
+	config.ScheduledGovernanceCompatibleBlock = big.NewInt(0)
+	gov := NewGovernance(&config, database.NewMemoryDBManager())
+	gov.nodeAddress.Store(council[len(council)-1])
+
+	var (
+		votes  = make([]GovernanceVote, 0)
+		tally  = make([]GovernanceTallyItem, 0)
+		self   = council[len(council)-1]
+		header = &types.Header{Number: big.NewInt(5), BlockScore: common.Big1}
+	)
+	vote := func(voters []common.Address, key string, value interface{}, activation uint64, cancel bool) {
+		for _, voter := range voters {
+			gov.voteMap.Clear()
+			assert.True(t, gov.AddScheduledVote(key, value, activation, cancel))
+			header.Vote = gov.GetEncodedVote(voter, header.Number.Uint64())
+			valSet, votes, tally = gov.HandleGovernanceVote(valSet, votes, tally, header, voter, self)
+		}
+	}
+
+	// A vote in the block 5 is applied from 20, so that a scheduled one should be applied from 30 or later
+	vote(council[:3], "governance.unitprice", uint64(100), 20, false)
+	vote(council[:3], "governance.unitprice", uint64(100), 35, false)
+	assert.Empty(t, gov.ScheduledChanges())
+
+	vote(council[:2], "governance.unitprice", uint64(100), 30, false)
+	assert.Empty(t, gov.ScheduledChanges())
+	vote(council[2:3], "governance.unitprice", uint64(100), 30, false)
+	assert.Equal(t, []ScheduledChange{{ActivationBlock: 30, Key: "governance.unitprice", Value: uint64(100)}}, gov.ScheduledChanges())
+	assert.Empty(t, gov.changeSet.Items())
+
+	// A scheduled change is cancelled by a subsequent vote
+	vote(council[:3], "governance.unitprice", uint64(200), 40, false)
+	vote(council[:3], "governance.unitprice", uint64(200), 40, true)
+	assert.Equal(t, []ScheduledChange{{ActivationBlock: 30, Key: "governance.unitprice", Value: uint64(100)}}, gov.ScheduledChanges())
+
+	// The change is moved into the change set at the epoch block 10, to be written at 20 and applied from 30
+	gov.ClearVotes(10)
+	gov.ApplyScheduledChanges(10)
+	assert.Empty(t, gov.ScheduledChanges())
+	assert.Equal(t, map[string]interface{}{"governance.unitprice": uint64(100)}, gov.changeSet.Items())
+
+	// The scheduled changes are stored with the governance state
+	gov.scheduledChanges.Add(ScheduledChange{ActivationBlock: 50, Key: "governance.governingnode", Value: council[0]})
+	b, err := gov.toJSON(10)
+	assert.NoError(t, err)
+	restored := NewGovernance(&config, database.NewMemoryDBManager())
+	assert.NoError(t, restored.UnmarshalJSON(b))
+	assert.Equal(t, gov.ScheduledChanges(), restored.ScheduledChanges())
+}
+
+func TestGovernance_HandleGovernanceVote_ScheduledBeforeFork(t *testing.T) {
+	council := getTestCouncil()
+	valSet := istanbul.ValidatorSet(validator.NewWeightedCouncil(council, getTestRewards(), getTestVotingPowers(len(council)), nil, istanbul.WeightedRandom, 21, 0, 0, nil))
+
+	config := *getTestConfig()
+	config.Governance = GetDefaultGovernanceConfig(params.UseIstanbul)
+	config.Governance.GovernanceMode = "single"
+	config.Governance.GoverningNode = council[0]
+	config.Istanbul = &params.IstanbulConfig{Epoch: 10, ProposerPolicy: params.DefaultProposerPolicy, SubGroupSize: params.DefaultSubGroupSize}
+	config.ScheduledGovernanceCompatibleBlock = big.NewInt(10)
+	gov := NewGovernance(&config, database.NewMemoryDBManager())
+	gov.nodeAddress.Store(council[len(council)-1])
+
+	var (
+		votes = make([]GovernanceVote, 0)
+		tally = make([]GovernanceTallyItem, 0)
+		self  = council[len(council)-1]
+	)
+
+	// A scheduled vote is not encoded before the fork, and dropped from the vote map
+	assert.True(t, gov.AddScheduledVote("governance.unitprice", uint64(100), 40, false))
+	assert.Nil(t, gov.GetEncodedVote(council[0], 5))
+	assert.Equal(t, 0, countUncastedVote(gov.voteMap))
+
+	// A scheduled vote included in a block before the fork is ignored
+	header := &types.Header{Number: big.NewInt(5), BlockScore: common.Big1}
+	header.Vote, _ = rlp.EncodeToBytes(&GovernanceVote{Validator: council[0], Key: "governance.unitprice", Value: uint64(100), ActivationBlock: 40})
+	valSet, votes, tally = gov.HandleGovernanceVote(valSet, votes, tally, header, council[0], self)
+	assert.Empty(t, gov.ScheduledChanges())
+	assert.Empty(t, votes)
+
+	// It is handled after the fork
+	header.Number = big.NewInt(10)
+	valSet, votes, tally = gov.HandleGovernanceVote(valSet, votes, tally, header, council[0], self)
+	assert.Equal(t, []ScheduledChange{{ActivationBlock: 40, Key: "governance.unitprice", Value: uint64(100)}}, gov.ScheduledChanges())
+}
+
+func TestGovernance_VoteMap_Scheduled(t *testing.T) {
+	config := *getTestConfig()
+	config.Istanbul = &params.IstanbulConfig{Epoch: 10, ProposerPolicy: params.DefaultProposerPolicy, SubGroupSize: params.DefaultSubGroupSize}
+	config.ScheduledGovernanceCompatibleBlock = big.NewInt(0)
+	gov := NewGovernance(&config, database.NewMemoryDBManager())
+	gov.nodeAddress.Store(common.HexToAddress("0x1000"))
+
+	// The votes for the same key at different activation blocks are kept together
+	assert.True(t, gov.AddVote("governance.unitprice", uint64(10)))
+	assert.True(t, gov.AddScheduledVote("governance.unitprice", uint64(30), 30, false))
+	assert.True(t, gov.AddScheduledVote("governance.unitprice", uint64(40), 40, false))
+	assert.Equal(t, 3, gov.voteMap.Size())
+
+	// Only the vote of the same activation block is replaced
+	assert.True(t, gov.AddScheduledVote("governance.unitprice", uint64(40), 40, true))
+	assert.Equal(t, 3, gov.voteMap.Size())
+	assert.True(t, gov.voteMap.GetValue(VoteKey{Key: "governance.unitprice", ActivationBlock: 40}).Cancel)
+
+	// The vote map is restored with the activation blocks
+	b, err := gov.toJSON(0)
+	assert.NoError(t, err)
+	restored := NewGovernance(&config, database.NewMemoryDBManager())
+	assert.NoError(t, restored.UnmarshalJSON(b))
+	assert.Equal(t, gov.voteMap.Size(), restored.voteMap.Size())
+	for key, status := range gov.voteMap.Copy() {
+		assert.Equal(t, status.Cancel, restored.voteMap.GetValue(key).Cancel)
+	}
+
+	// Each vote is encoded and marked as casted once included
+	encoded := make(map[VoteKey]bool)
+	for i := 0; i < 3; i++ {
+		vote := new(GovernanceVote)
+		assert.NoError(t, rlp.DecodeBytes(gov.GetEncodedVote(common.HexToAddress("0x1000"), 5), vote))
+		vote, err = gov.ParseVoteValue(vote)
+		assert.NoError(t, err)
+		encoded[VoteKey{Key: vote.Key, ActivationBlock: vote.ActivationBlock}] = true
+		gov.removeDuplicatedVote(vote, 5)
+	}
+	assert.Equal(t, map[VoteKey]bool{
+		{Key: "governance.unitprice"}:                      true,
+		{Key: "governance.unitprice", ActivationBlock: 30}: true,
+		{Key: "governance.unitprice", ActivationBlock: 40}: true,
+	}, encoded)
+	assert.Nil(t, gov.GetEncodedVote(common.HexToAddress("0x1000"), 5))
+}
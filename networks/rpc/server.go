@@ -407,7 +407,14 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 		if !reply[req.callb.errPos].IsNil() {
 			e := reply[req.callb.errPos].Interface().(error)
 			rpcErrorResponsesCounter.Inc(1)
-			res := codec.CreateErrorResponse(&req.id, &callbackError{e.Error()})
+			var rpcErr Error = &callbackError{e.Error()}
+			if ec, ok := e.(Error); ok {
+				rpcErr = ec
+			}
+			if de, ok := e.(DataError); ok {
+				return codec.CreateErrorResponseWithInfo(&req.id, rpcErr, de.ErrorData()), nil
+			}
+			res := codec.CreateErrorResponse(&req.id, rpcErr)
 			return res, nil
 		}
 	}
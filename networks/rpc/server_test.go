@@ -160,3 +160,40 @@ func TestServerMethodExecution(t *testing.T) {
 func TestServerMethodWithCtx(t *testing.T) {
 	testServerMethodExecution(t, "echoWithCtx")
 }
+
+type dataError struct{}
+
+func (e *dataError) Error() string          { return "data error" }
+func (e *dataError) ErrorCode() int         { return 3 }
+func (e *dataError) ErrorData() interface{} { return "0x01" }
+
+type DataErrorService struct{}
+
+func (s *DataErrorService) Fail() error {
+	return &dataError{}
+}
+
+func TestServerDataError(t *testing.T) {
+	server := NewServer()
+	if err := server.RegisterName("test", new(DataErrorService)); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+
+	go server.ServeCodec(NewJSONCodec(serverConn), OptionMethodInvocation)
+
+	request := map[string]interface{}{"id": 1, "method": "test_fail", "version": "2.0"}
+	if err := json.NewEncoder(clientConn).Encode(request); err != nil {
+		t.Fatal(err)
+	}
+
+	var response jsonErrResponse
+	if err := json.NewDecoder(clientConn).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Error.Code != 3 || response.Error.Message != "data error" || response.Error.Data != "0x01" {
+		t.Errorf("unexpected error response: %+v", response.Error)
+	}
+}
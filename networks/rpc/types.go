@@ -96,6 +96,13 @@ type Error interface {
 	ErrorCode() int // returns the code
 }
 
+// DataError is implemented by errors returned from RPC methods which carry additional
+// information. The information is returned in the data field of the JSON-RPC error.
+type DataError interface {
+	Error() string          // returns the message
+	ErrorData() interface{} // returns the error data
+}
+
 // ServerCodec implements reading, parsing and writing RPC messages for the server side of
 // a RPC session. Implementations must be go-routine safe since the codec can be called in
 // multiple go-routines concurrently.
@@ -312,6 +312,49 @@ func (api *PrivateDebugAPI) GetBadBlocks(ctx context.Context) ([]blockchain.BadB
 	return api.cn.BlockChain().BadBlocks()
 }
 
+// BlockExecutionProfile returns the execution time, computation cost and gas spent
+// by each transaction of a recent block, recorded while the block is built or imported.
+func (api *PrivateDebugAPI) BlockExecutionProfile(blockNr rpc.BlockNumber) (map[string]interface{}, error) {
+	var header *types.Header
+	if blockNr == rpc.LatestBlockNumber {
+		header = api.cn.blockchain.CurrentHeader()
+	} else {
+		header = api.cn.blockchain.GetHeaderByNumber(uint64(blockNr))
+	}
+	if header == nil {
+		return nil, fmt.Errorf("block #%d not found", blockNr)
+	}
+	profile := api.cn.blockchain.GetBlockExecutionProfile(header.Hash())
+	if profile == nil {
+		return nil, fmt.Errorf("execution profile of block #%d not found", header.Number.Uint64())
+	}
+
+	txs := make([]map[string]interface{}, len(profile.Txs))
+	slowTxs := 0
+	for i, tx := range profile.Txs {
+		txs[i] = map[string]interface{}{
+			"hash":            tx.TxHash,
+			"executionTime":   tx.ExecutionTime.String(),
+			"computationCost": hexutil.Uint64(tx.ComputationCost),
+			"gasUsed":         hexutil.Uint64(tx.GasUsed),
+			"slow":            tx.Slow,
+		}
+		if tx.Slow {
+			slowTxs++
+		}
+	}
+	return map[string]interface{}{
+		"number":           hexutil.Uint64(profile.Number),
+		"hash":             profile.Hash,
+		"built":            profile.Built,
+		"executionTime":    profile.ExecutionTime.String(),
+		"timeLimit":        params.TotalTimeLimit.String(),
+		"timeLimitReached": profile.TimeLimitReached,
+		"slowTxs":          slowTxs,
+		"transactions":     txs,
+	}, nil
+}
+
 // StorageRangeResult is the result of a debug_storageRangeAt API call.
 type StorageRangeResult struct {
 	Storage storageMap   `json:"storage"`
@@ -192,6 +192,10 @@ func (b *CNAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction)
 	return b.cn.txPool.AddLocal(signedTx)
 }
 
+func (b *CNAPIBackend) ReplaceTx(ctx context.Context, signedTx *types.Transaction) error {
+	return b.cn.txPool.ReplaceLocal(signedTx)
+}
+
 func (b *CNAPIBackend) GetPoolTransactions() (types.Transactions, error) {
 	pending, err := b.cn.txPool.Pending()
 	if err != nil {
@@ -208,6 +212,14 @@ func (b *CNAPIBackend) GetPoolTransaction(hash common.Hash) *types.Transaction {
 	return b.cn.txPool.Get(hash)
 }
 
+func (b *CNAPIBackend) RemovePoolTransaction(hash common.Hash) *types.Transaction {
+	return b.cn.txPool.Remove(hash)
+}
+
+func (b *CNAPIBackend) RemovePoolTransactionsBySender(addr common.Address) types.Transactions {
+	return b.cn.txPool.RemoveSender(addr)
+}
+
 func (b *CNAPIBackend) GetPoolNonce(ctx context.Context, addr common.Address) uint64 {
 	return b.cn.txPool.GetPendingNonce(addr)
 }
@@ -220,10 +232,34 @@ func (b *CNAPIBackend) TxPoolContent() (map[common.Address]types.Transactions, m
 	return b.cn.TxPool().Content()
 }
 
+func (b *CNAPIBackend) TxPoolPriorityStatus() []blockchain.TxPriorityClassStatus {
+	return b.cn.TxPool().PriorityStatus()
+}
+
+func (b *CNAPIBackend) TxPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions) {
+	return b.cn.TxPool().ContentFrom(addr)
+}
+
+func (b *CNAPIBackend) TxPoolContentByFeePayer(feePayer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	return b.cn.TxPool().ContentByFeePayer(feePayer)
+}
+
+func (b *CNAPIBackend) TxPoolNonExecutableReasons(txs types.Transactions) []string {
+	return b.cn.TxPool().NonExecutableReasons(txs)
+}
+
 func (b *CNAPIBackend) SubscribeNewTxsEvent(ch chan<- blockchain.NewTxsEvent) event.Subscription {
 	return b.cn.TxPool().SubscribeNewTxsEvent(ch)
 }
 
+func (b *CNAPIBackend) SubscribeReplacedTxEvent(ch chan<- blockchain.ReplacedTxEvent) event.Subscription {
+	return b.cn.TxPool().SubscribeReplacedTxEvent(ch)
+}
+
+func (b *CNAPIBackend) SubscribeDroppedTxsEvent(ch chan<- blockchain.DroppedTxsEvent) event.Subscription {
+	return b.cn.TxPool().SubscribeDroppedTxsEvent(ch)
+}
+
 func (b *CNAPIBackend) Progress() klaytn.SyncProgress {
 	return b.cn.Progress()
 }
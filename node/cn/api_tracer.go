@@ -60,6 +60,10 @@ const (
 	// fastCallTracer is the go-version callTracer which is lighter and faster than
 	// Javascript version.
 	fastCallTracer = "fastCallTracer"
+
+	// opcodeProfiler is the go-version tracer which aggregates the gas and the
+	// computation cost per contract, per program counter and per opcode.
+	opcodeProfiler = "opcodeProfiler"
 )
 
 // TraceConfig holds extra parameters to trace functions.
@@ -68,6 +72,10 @@ type TraceConfig struct {
 	Tracer  *string
 	Timeout *string
 	Reexec  *uint64
+	// Workers is the number of goroutines tracing transactions of a single block
+	// concurrently. If it is set, TraceChain also traces the transactions of each
+	// block concurrently instead of one after another.
+	Workers *int
 }
 
 // StdTraceConfig holds extra parameters to standard-json trace functions.
@@ -192,6 +200,13 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 	blocks := int(end.NumberU64() - origin)
 
 	threads := runtime.NumCPU()
+	parallelTxs := config != nil && config.Workers != nil
+	if parallelTxs {
+		// Share the CPUs between block tracers and the transaction tracers of each block
+		if threads = runtime.NumCPU() / txTraceWorkers(config, runtime.NumCPU()); threads < 1 {
+			threads = 1
+		}
+	}
 	if threads > blocks {
 		threads = blocks
 	}
@@ -207,6 +222,20 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 
 			// Fetch and execute the next block trace tasks
 			for task := range tasks {
+				if parallelTxs {
+					// Trace the transactions from the snapshots of their pre-transaction states concurrently
+					traces, err := api.traceBlockTxs(ctx, task.block, task.statedb, config)
+					if err != nil {
+						logger.Warn("Tracing failed", "block", task.block.NumberU64(), "err", err)
+					}
+					copy(task.results, traces)
+					select {
+					case results <- task:
+					case <-notifier.Closed():
+						return
+					}
+					continue
+				}
 				signer := types.MakeSigner(api.config, task.block.Number())
 
 				// Trace all the transactions contained within
@@ -474,20 +503,45 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 		return nil, fmt.Errorf("can not get the state of block %#x: %v", parent.Root(), err)
 	}
 
-	// Execute all the transaction contained within the block concurrently
+	results, err := api.traceBlockTxs(ctx, block, statedb, config)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// txTraceWorkers returns the number of goroutines to trace the given number of
+// transactions of a block concurrently.
+func txTraceWorkers(config *TraceConfig, txs int) int {
+	threads := runtime.NumCPU()
+	if config != nil && config.Workers != nil && *config.Workers > 0 {
+		threads = *config.Workers
+	}
+	if threads > txs {
+		threads = txs
+	}
+	if threads < 1 {
+		threads = 1
+	}
+	return threads
+}
+
+// traceBlockTxs traces all the transactions of the block concurrently on top of the
+// given parent state. The pre-transaction state of each transaction is snapshotted
+// by fast processing the preceding transactions without tracing. If execution fails
+// in between, the results of the transactions processed so far are returned with
+// the error.
+func (api *PrivateDebugAPI) traceBlockTxs(ctx context.Context, block *types.Block, statedb *state.StateDB, config *TraceConfig) ([]*txTraceResult, error) {
 	var (
 		signer = types.MakeSigner(api.config, block.Number())
 
 		txs     = block.Transactions()
 		results = make([]*txTraceResult, len(txs))
 
-		pend = new(sync.WaitGroup)
-		jobs = make(chan *txTraceTask, len(txs))
+		threads = txTraceWorkers(config, len(txs))
+		pend    = new(sync.WaitGroup)
+		jobs    = make(chan *txTraceTask, threads)
 	)
-	threads := runtime.NumCPU()
-	if threads > len(txs) {
-		threads = len(txs)
-	}
 	for th := 0; th < threads; th++ {
 		pend.Add(1)
 		go func() {
@@ -513,7 +567,8 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 			}
 		}()
 	}
-	// Feed the transactions into the tracers and return
+	// Feed the transactions into the tracers and return. The job channel is bounded
+	// by the number of workers to limit the number of state copies held at once.
 	var failed error
 	for i, tx := range txs {
 		// Send the trace task over for execution
@@ -540,11 +595,78 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 	close(jobs)
 	pend.Wait()
 
-	// If execution failed in between, abort
-	if failed != nil {
-		return nil, failed
+	return results, failed
+}
+
+// ProfileBlocks traces all the transactions of the blocks between start and end
+// (both inclusive) with the opcode profiler and returns the merged profile of the
+// gas and the computation cost per contract, per program counter and per opcode.
+func (api *PrivateDebugAPI) ProfileBlocks(ctx context.Context, start, end rpc.BlockNumber, config *TraceConfig) (*vm.OpcodeProfile, error) {
+	var from, to *types.Block
+
+	switch start {
+	case rpc.PendingBlockNumber:
+		from = api.cn.miner.PendingBlock()
+	case rpc.LatestBlockNumber:
+		from = api.cn.blockchain.CurrentBlock()
+	default:
+		from = api.cn.blockchain.GetBlockByNumber(uint64(start))
+	}
+	switch end {
+	case rpc.PendingBlockNumber:
+		to = api.cn.miner.PendingBlock()
+	case rpc.LatestBlockNumber:
+		to = api.cn.blockchain.CurrentBlock()
+	default:
+		to = api.cn.blockchain.GetBlockByNumber(uint64(end))
+	}
+	if from == nil {
+		return nil, fmt.Errorf("start block #%d not found", start)
+	}
+	if to == nil {
+		return nil, fmt.Errorf("end block #%d not found", end)
+	}
+	if from.NumberU64() > to.NumberU64() {
+		return nil, fmt.Errorf("end block #%d needs to come after start block #%d", to.NumberU64(), from.NumberU64())
+	}
+
+	profileConfig := &TraceConfig{}
+	if config != nil {
+		*profileConfig = *config
 	}
-	return results, nil
+	tracer := opcodeProfiler
+	profileConfig.Tracer = &tracer
+
+	profile := vm.NewOpcodeProfile()
+	for number := from.NumberU64(); number <= to.NumberU64(); number++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		block := from
+		if number != from.NumberU64() {
+			if block = api.cn.blockchain.GetBlockByNumber(number); block == nil {
+				return nil, fmt.Errorf("block #%d not found", number)
+			}
+		}
+		if block.Transactions().Len() == 0 {
+			continue
+		}
+		results, err := api.traceBlock(ctx, block, profileConfig)
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range results {
+			if res.Error != "" {
+				return nil, fmt.Errorf("profiling tx %x failed: %v", res.TxHash, res.Error)
+			}
+			if txProfile, ok := res.Result.(*vm.OpcodeProfile); ok {
+				profile.Merge(txProfile)
+			}
+		}
+	}
+	return profile, nil
 }
 
 // standardTraceBlockToFile configures a new tracer which uses standard JSON output,
@@ -734,12 +856,25 @@ func (api *PrivateDebugAPI) TraceTransaction(ctx context.Context, hash common.Ha
 	if config != nil && config.Reexec != nil {
 		reexec = *config.Reexec
 	}
+	// Serve the result from the trace cache if it has been traced before
+	traceCache := api.cn.traceCache
+	if traceCache != nil {
+		if result, ok := traceCache.get(hash, blockHash, config); ok {
+			return result, nil
+		}
+	}
 	msg, vmctx, statedb, err := api.computeTxEnv(blockHash, int(index), reexec)
 	if err != nil {
 		return nil, err
 	}
 	// Trace the transaction and return
-	return api.traceTx(ctx, msg, vmctx, statedb, config)
+	result, err := api.traceTx(ctx, msg, vmctx, statedb, config)
+	if err == nil && traceCache != nil {
+		if block := api.cn.blockchain.GetBlockByHash(blockHash); block != nil {
+			traceCache.put(hash, block, config, result)
+		}
+	}
+	return result, err
 }
 
 // traceTx configures a new tracer according to the provided configuration, and
@@ -761,8 +896,8 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message blockchain.Mess
 			}
 		}
 
-		if *config.Tracer == fastCallTracer {
-			tracer = vm.NewInternalTxTracer()
+		if native, ok := tracers.NewNativeTracer(*config.Tracer); ok {
+			tracer = native
 		} else {
 			// Constuct the JavaScript tracer to execute with
 			if tracer, err = tracers.New(*config.Tracer); err != nil {
@@ -776,7 +911,7 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message blockchain.Mess
 			switch t := tracer.(type) {
 			case *tracers.Tracer:
 				t.Stop(errors.New("execution timeout"))
-			case *vm.InternalTxTracer:
+			case tracers.NativeTracer:
 				t.Stop(errors.New("execution timeout"))
 			default:
 				logger.Warn("unknown tracer type", "type", reflect.TypeOf(t).String())
@@ -809,7 +944,7 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message blockchain.Mess
 
 	case *tracers.Tracer:
 		return tracer.GetResult()
-	case *vm.InternalTxTracer:
+	case tracers.NativeTracer:
 		return tracer.GetResult()
 
 	default:
@@ -18,15 +18,23 @@ package cn
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"github.com/golang/mock/gomock"
 	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/consensus/gxhash"
 	mocks2 "github.com/klaytn/klaytn/consensus/mocks"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/networks/rpc"
 	mocks3 "github.com/klaytn/klaytn/node/cn/mocks"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
 	"github.com/klaytn/klaytn/work/mocks"
 	"github.com/stretchr/testify/assert"
+	"math/big"
 	"testing"
 )
 
@@ -219,3 +227,56 @@ func TestPrivateDebugAPI_computeTxEnv(t *testing.T) {
 		mockCtrl.Finish()
 	}
 }
+
+// TestTraceBlockWithWorkers tests that tracing a block with multiple workers results in
+// the same traces as tracing it with a single worker.
+func TestTraceBlockWithWorkers(t *testing.T) {
+	var (
+		db       = database.NewMemoryDBManager()
+		contract = common.HexToAddress("0xC0DE")
+		// PUSH1 0 SLOAD PUSH1 1 ADD PUSH1 0 SSTORE STOP, which increases the counter at slot 0
+		code  = common.FromHex("0x60005460010160005500")
+		keys  = make([]*ecdsa.PrivateKey, 3)
+		alloc = blockchain.GenesisAlloc{contract: {Balance: common.Big0, Code: code}}
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		alloc[crypto.PubkeyToAddress(keys[i].PublicKey)] = blockchain.GenesisAccount{Balance: big.NewInt(params.KLAY)}
+	}
+	gspec := &blockchain.Genesis{Config: params.TestChainConfig, Alloc: alloc}
+	genesis := gspec.MustCommit(db)
+
+	// Each transaction sees the counter increased by the preceding ones, so the traces
+	// differ if a transaction is traced on a wrong state.
+	signer := types.NewEIP155Signer(gspec.Config.ChainID)
+	blocks, _ := blockchain.GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), db, 1, func(i int, gen *blockchain.BlockGen) {
+		for nonce := uint64(0); nonce < 3; nonce++ {
+			for _, key := range keys {
+				tx, err := types.SignTx(types.NewTransaction(nonce, contract, common.Big0, 100000, common.Big0, nil), signer, key)
+				assert.NoError(t, err)
+				gen.AddTx(tx)
+			}
+		}
+	})
+
+	chain, err := blockchain.NewBlockChain(db, nil, gspec.Config, gxhash.NewFaker(), vm.Config{})
+	assert.NoError(t, err)
+	defer chain.Stop()
+	_, err = chain.InsertChain(blocks)
+	assert.NoError(t, err)
+
+	api := NewPrivateDebugAPI(gspec.Config, &CN{blockchain: chain, engine: gxhash.NewFaker(), chainDB: db})
+	trace := func(workers int) []*txTraceResult {
+		results, err := api.traceBlock(context.Background(), blocks[0], &TraceConfig{Workers: &workers})
+		assert.NoError(t, err)
+		return results
+	}
+
+	expected := trace(1)
+	assert.Equal(t, len(blocks[0].Transactions()), len(expected))
+	for i, result := range expected {
+		assert.Equal(t, blocks[0].Transactions()[i].Hash(), result.TxHash)
+		assert.Empty(t, result.Error)
+	}
+	assert.Equal(t, expected, trace(4))
+}
@@ -132,6 +132,8 @@ type CN struct {
 	components []interface{}
 
 	governance *governance.Governance
+
+	traceCache *traceCache // Persistent cache of trace results, nil if disabled
 }
 
 func (s *CN) AddLesServer(ls LesServer) {
@@ -176,6 +178,37 @@ func senderTxHashIndexer(db database.DBManager, chainEvent <-chan blockchain.Cha
 	}
 }
 
+// revertReasonIndexer subscribes chainEvent and stores the revert payloads of reverted transactions.
+func revertReasonIndexer(db database.DBManager, chainEvent <-chan blockchain.ChainEvent, subscription event.Subscription) {
+	defer subscription.Unsubscribe()
+
+	for {
+		select {
+		case event := <-chainEvent:
+			var err error
+			batch := db.NewRevertReasonBatch()
+			for _, receipt := range event.Receipts {
+				if len(receipt.RevertReason) == 0 {
+					continue
+				}
+
+				if err = db.PutRevertReasonToBatch(batch, receipt.TxHash, receipt.RevertReason); err != nil {
+					logger.Error("Failed to store revert reason to database",
+						"blockNum", event.Block.Number(), "txHash", receipt.TxHash, "err", err)
+					break
+				}
+			}
+
+			if err == nil {
+				batch.Write()
+			}
+
+		case <-subscription.Err():
+			return
+		}
+	}
+}
+
 func checkSyncMode(config *Config) error {
 	if !config.SyncMode.IsValid() {
 		return fmt.Errorf("invalid sync mode %d", config.SyncMode)
@@ -251,7 +284,8 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 		cacheConfig = &blockchain.CacheConfig{StateDBCaching: config.StateDBCaching,
 			ArchiveMode: config.NoPruning, CacheSize: config.TrieCacheSize, BlockInterval: config.TrieBlockInterval,
 			TriesInMemory: config.TriesInMemory, TxPoolStateCache: config.TxPoolStateCache,
-			TrieNodeCacheConfig: config.TrieNodeCacheConfig, SenderTxHashIndexing: config.SenderTxHashIndexing}
+			TrieNodeCacheConfig: config.TrieNodeCacheConfig, SenderTxHashIndexing: config.SenderTxHashIndexing,
+			SlowTxThreshold: config.SlowTxThreshold, ParallelTxExecution: config.ParallelTxExecution}
 	)
 
 	bc, err := blockchain.NewBlockChain(chainDB, cacheConfig, cn.chainConfig, cn.engine, vmConfig)
@@ -260,6 +294,7 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	}
 	cn.blockchain = bc
 	governance.SetBlockchain(cn.blockchain)
+	cn.blockchain.SetGovernance(governance)
 	// Synchronize proposerpolicy & useGiniCoeff
 	if cn.blockchain.Config().Istanbul != nil {
 		cn.blockchain.Config().Istanbul.ProposerPolicy = governance.ProposerPolicy()
@@ -274,6 +309,20 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 		go senderTxHashIndexer(chainDB, ch, chainEventSubscription)
 	}
 
+	if config.RevertReasonIndexing {
+		ch := make(chan blockchain.ChainEvent, 255)
+		chainEventSubscription := cn.blockchain.SubscribeChainEvent(ch)
+		go revertReasonIndexer(chainDB, ch, chainEventSubscription)
+	}
+
+	if config.TraceCache.Enabled {
+		traceCacheDB, err := CreateTraceCacheDB(ctx, "tracecache")
+		if err != nil {
+			return nil, err
+		}
+		cn.traceCache = newTraceCache(config.TraceCache, traceCacheDB, cn.blockchain)
+	}
+
 	// Rewind the chain in case of an incompatible config upgrade.
 	if compat, ok := genesisErr.(*params.ConfigCompatError); ok {
 		logger.Error("Rewinding chain to upgrade configuration", "err", compat)
@@ -285,12 +334,18 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.Snapshot != "" {
+		config.TxPool.Snapshot = ctx.ResolvePath(config.TxPool.Snapshot)
+	}
 	// TODO-Klaytn-ServiceChain: add account creation prevention in the txPool if TxTypeAccountCreation is supported.
 	config.TxPool.NoAccountCreation = config.NoAccountCreation
 	cn.txPool = blockchain.NewTxPool(config.TxPool, cn.chainConfig, bc)
 	governance.SetTxPool(cn.txPool)
 	// Synchronize unitprice
 	cn.txPool.SetGasPrice(big.NewInt(0).SetUint64(governance.UnitPrice()))
+	// Synchronize the admission limits of the txpool
+	cn.txPool.SetMaxTxSize(governance.MaxTxSize())
+	cn.txPool.SetMinimumPrice(new(big.Int).SetUint64(governance.MinimumTxPoolPrice()))
 
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieNodeCacheConfig.FastCacheSizeMB
@@ -324,7 +379,7 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	}
 
 	// TODO-Klaytn improve to handle drop transaction on network traffic in PN and EN
-	cn.miner = work.New(cn, cn.chainConfig, cn.EventMux(), cn.engine, ctx.NodeType(), crypto.PubkeyToAddress(ctx.NodeKey().PublicKey), cn.config.TxResendUseLegacy, config.RestartTimeOutFlag, restartFn)
+	cn.miner = work.New(cn, cn.chainConfig, cn.EventMux(), cn.engine, ctx.NodeType(), crypto.PubkeyToAddress(ctx.NodeKey().PublicKey), cn.config.TxResendUseLegacy, cn.config.TxOrdering, config.RestartTimeOutFlag, restartFn)
 	// istanbul BFT
 	cn.miner.SetExtra(makeExtraData(config.ExtraData))
 
@@ -413,6 +468,20 @@ func CreateDB(ctx *node.ServiceContext, config *Config, name string) database.DB
 	return ctx.OpenDatabase(dbc)
 }
 
+// CreateTraceCacheDB creates the database storing cached trace results.
+// A memory database is returned if the node does not use persistent storage.
+func CreateTraceCacheDB(ctx *node.ServiceContext, name string) (database.Database, error) {
+	path := ctx.ResolvePath(name)
+	if path == "" {
+		return database.NewMemDB(), nil
+	}
+	db, err := database.NewLevelDBWithOption(path, database.GetDefaultLevelDBOption())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the trace cache database: %v", err)
+	}
+	return db, nil
+}
+
 // CreateConsensusEngine creates the required type of consensus engine instance for a Klaytn service
 func CreateConsensusEngine(ctx *node.ServiceContext, config *Config, chainConfig *params.ChainConfig, db database.DBManager, gov *governance.Governance, nodetype common.ConnType) consensus.Engine {
 	// Only istanbul  BFT is allowed in the main net. PoA is supported by service chain
@@ -593,6 +662,10 @@ func (s *CN) Start(srvr p2p.Server) error {
 
 	reward.StakingManagerSubscribe()
 
+	if s.traceCache != nil {
+		s.traceCache.start(NewPrivateDebugAPI(s.chainConfig, s).traceBlock)
+	}
+
 	return nil
 }
 
@@ -611,6 +684,9 @@ func (s *CN) Stop() error {
 	s.txPool.Stop()
 	s.miner.Stop()
 	reward.StakingManagerUnsubscribe()
+	if s.traceCache != nil {
+		s.traceCache.stop()
+	}
 	s.blockchain.Stop()
 	s.chainDB.Close()
 	s.eventMux.Stop()
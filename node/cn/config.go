@@ -38,6 +38,7 @@ import (
 	"github.com/klaytn/klaytn/node/cn/gasprice"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
+	"github.com/klaytn/klaytn/work"
 )
 
 var logger = log.NewModuleLogger(log.NodeCN)
@@ -53,6 +54,7 @@ func GetDefaultConfig() *Config {
 		TrieBlockInterval: blockchain.DefaultBlockInterval,
 		TriesInMemory:     blockchain.DefaultTriesInMemory,
 		GasPrice:          big.NewInt(18 * params.Ston),
+		TxOrdering:        work.DefaultTxOrderingConfig,
 
 		TxPool: blockchain.DefaultTxPoolConfig,
 		GPO: gasprice.Config{
@@ -111,6 +113,7 @@ type Config struct {
 	TrieBlockInterval    uint
 	TriesInMemory        uint64
 	SenderTxHashIndexing bool
+	RevertReasonIndexing bool
 	ParallelDBWrite      bool
 	StateDBCaching       bool
 	TxPoolStateCache     bool
@@ -120,6 +123,7 @@ type Config struct {
 	ServiceChainSigner common.Address `toml:",omitempty"`
 	ExtraData          []byte         `toml:",omitempty"`
 	GasPrice           *big.Int
+	TxOrdering         work.TxOrderingConfig
 
 	// Reward
 	Rewardbase common.Address `toml:",omitempty"`
@@ -134,6 +138,13 @@ type Config struct {
 	EnablePreimageRecording bool
 	// Enables collecting internal transaction data during processing a block
 	EnableInternalTxTracing bool
+	// Execution time over which a transaction is logged as slow (0 = disabled)
+	SlowTxThreshold time.Duration
+	// Enables applying the transactions of an imported block in parallel
+	ParallelTxExecution bool
+
+	// Trace result cache options
+	TraceCache TraceCacheConfig
 	// Istanbul options
 	Istanbul istanbul.Config
 
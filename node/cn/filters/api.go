@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
@@ -169,6 +170,85 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 	return rpcSub, nil
 }
 
+// ReplacedTransaction is the notification of NewReplacedTransactions, which
+// tells the hash of a transaction replaced in the transaction pool and its replacement.
+type ReplacedTransaction struct {
+	Old common.Hash `json:"old"`
+	New common.Hash `json:"new"`
+}
+
+// NewReplacedTransactions creates a subscription that is triggered each time a transaction
+// in the transaction pool is replaced by a new one with the same sender and nonce.
+func (api *PublicFilterAPI) NewReplacedTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		replaced := make(chan blockchain.ReplacedTxEvent, 128)
+		replacedTxSub := api.events.SubscribeReplacedTxs(replaced)
+
+		for {
+			select {
+			case ev := <-replaced:
+				notifier.Notify(rpcSub.ID, &ReplacedTransaction{Old: ev.Old.Hash(), New: ev.New.Hash()})
+			case <-rpcSub.Err():
+				replacedTxSub.Unsubscribe()
+				return
+			case <-notifier.Closed():
+				replacedTxSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// DroppedTransaction is the notification of NewDroppedTransactions, which
+// tells the hash of a transaction dropped from the transaction pool and the reason.
+type DroppedTransaction struct {
+	Hash   common.Hash `json:"hash"`
+	Reason string      `json:"reason"`
+}
+
+// NewDroppedTransactions creates a subscription that is triggered each time a transaction
+// is dropped from the transaction pool without being included in a block, e.g. when it is
+// underpriced in a full pool, expired or removed by request.
+func (api *PublicFilterAPI) NewDroppedTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		dropped := make(chan blockchain.DroppedTxsEvent, 128)
+		droppedTxsSub := api.events.SubscribeDroppedTxs(dropped)
+
+		for {
+			select {
+			case ev := <-dropped:
+				for _, tx := range ev.Txs {
+					notifier.Notify(rpcSub.ID, &DroppedTransaction{Hash: tx.Hash(), Reason: ev.Reason})
+				}
+			case <-rpcSub.Err():
+				droppedTxsSub.Unsubscribe()
+				return
+			case <-notifier.Closed():
+				droppedTxsSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 // NewBlockFilter creates a filter that fetches blocks that are imported into the chain.
 // It is part of the filter package since polling goes with eth_getFilterChanges.
 func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
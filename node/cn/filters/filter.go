@@ -44,6 +44,8 @@ type Backend interface {
 	SubscribeChainEvent(ch chan<- blockchain.ChainEvent) event.Subscription
 	SubscribeRemovedLogsEvent(ch chan<- blockchain.RemovedLogsEvent) event.Subscription
 	SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription
+	SubscribeReplacedTxEvent(ch chan<- blockchain.ReplacedTxEvent) event.Subscription
+	SubscribeDroppedTxsEvent(ch chan<- blockchain.DroppedTxsEvent) event.Subscription
 
 	BloomStatus() (uint64, uint64)
 	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
@@ -53,6 +53,12 @@ const (
 	PendingTransactionsSubscription
 	// BlocksSubscription queries hashes for blocks that are imported
 	BlocksSubscription
+	// ReplacedTransactionsSubscription queries transactions replaced in the
+	// transaction pool by new ones with the same sender and nonce
+	ReplacedTransactionsSubscription
+	// DroppedTransactionsSubscription queries transactions dropped from the
+	// transaction pool without being included in a block
+	DroppedTransactionsSubscription
 	// LastSubscription keeps track of the last index
 	LastIndexSubscription
 )
@@ -68,6 +74,10 @@ const (
 	logsChanSize = 10
 	// chainEvChanSize is the size of channel listening to ChainEvent.
 	chainEvChanSize = 10
+	// replacedTxChanSize is the size of channel listening to ReplacedTxEvent.
+	replacedTxChanSize = 128
+	// droppedTxsChanSize is the size of channel listening to DroppedTxsEvent.
+	droppedTxsChanSize = 128
 )
 
 var (
@@ -83,6 +93,8 @@ type subscription struct {
 	logs      chan []*types.Log
 	hashes    chan []common.Hash
 	headers   chan *types.Header
+	replaced  chan blockchain.ReplacedTxEvent
+	dropped   chan blockchain.DroppedTxsEvent
 	installed chan struct{} // closed when the filter is installed
 	err       chan error    // closed when the filter is uninstalled
 }
@@ -100,15 +112,19 @@ type EventSystem struct {
 	logsSub       event.Subscription         // Subscription for new log event
 	rmLogsSub     event.Subscription         // Subscription for removed log event
 	chainSub      event.Subscription         // Subscription for new chain event
+	replacedSub   event.Subscription         // Subscription for replaced transaction event
+	droppedSub    event.Subscription         // Subscription for dropped transactions event
 	pendingLogSub *event.TypeMuxSubscription // Subscription for pending log event
 
 	// Channels
-	install   chan *subscription               // install filter for event notification
-	uninstall chan *subscription               // remove filter for event notification
-	txsCh     chan blockchain.NewTxsEvent      // Channel to receive new transactions event
-	logsCh    chan []*types.Log                // Channel to receive new log event
-	rmLogsCh  chan blockchain.RemovedLogsEvent // Channel to receive removed log event
-	chainCh   chan blockchain.ChainEvent       // Channel to receive new chain event
+	install    chan *subscription               // install filter for event notification
+	uninstall  chan *subscription               // remove filter for event notification
+	txsCh      chan blockchain.NewTxsEvent      // Channel to receive new transactions event
+	logsCh     chan []*types.Log                // Channel to receive new log event
+	rmLogsCh   chan blockchain.RemovedLogsEvent // Channel to receive removed log event
+	chainCh    chan blockchain.ChainEvent       // Channel to receive new chain event
+	replacedCh chan blockchain.ReplacedTxEvent  // Channel to receive replaced transaction event
+	droppedCh  chan blockchain.DroppedTxsEvent  // Channel to receive dropped transactions event
 }
 
 // NewEventSystem creates a new manager that listens for event on the given mux,
@@ -119,15 +135,17 @@ type EventSystem struct {
 // or by stopping the given mux.
 func NewEventSystem(mux *event.TypeMux, backend Backend, lightMode bool) *EventSystem {
 	m := &EventSystem{
-		mux:       mux,
-		backend:   backend,
-		lightMode: lightMode,
-		install:   make(chan *subscription),
-		uninstall: make(chan *subscription),
-		txsCh:     make(chan blockchain.NewTxsEvent, txChanSize),
-		logsCh:    make(chan []*types.Log, logsChanSize),
-		rmLogsCh:  make(chan blockchain.RemovedLogsEvent, rmLogsChanSize),
-		chainCh:   make(chan blockchain.ChainEvent, chainEvChanSize),
+		mux:        mux,
+		backend:    backend,
+		lightMode:  lightMode,
+		install:    make(chan *subscription),
+		uninstall:  make(chan *subscription),
+		txsCh:      make(chan blockchain.NewTxsEvent, txChanSize),
+		logsCh:     make(chan []*types.Log, logsChanSize),
+		rmLogsCh:   make(chan blockchain.RemovedLogsEvent, rmLogsChanSize),
+		chainCh:    make(chan blockchain.ChainEvent, chainEvChanSize),
+		replacedCh: make(chan blockchain.ReplacedTxEvent, replacedTxChanSize),
+		droppedCh:  make(chan blockchain.DroppedTxsEvent, droppedTxsChanSize),
 	}
 
 	// Subscribe events
@@ -135,12 +153,14 @@ func NewEventSystem(mux *event.TypeMux, backend Backend, lightMode bool) *EventS
 	m.logsSub = m.backend.SubscribeLogsEvent(m.logsCh)
 	m.rmLogsSub = m.backend.SubscribeRemovedLogsEvent(m.rmLogsCh)
 	m.chainSub = m.backend.SubscribeChainEvent(m.chainCh)
+	m.replacedSub = m.backend.SubscribeReplacedTxEvent(m.replacedCh)
+	m.droppedSub = m.backend.SubscribeDroppedTxsEvent(m.droppedCh)
 	// TODO(rjl493456442): use feed to subscribe pending log event
 	m.pendingLogSub = m.mux.Subscribe(blockchain.PendingLogsEvent{})
 
 	// Make sure none of the subscriptions are empty
 	if m.txsSub == nil || m.logsSub == nil || m.rmLogsSub == nil || m.chainSub == nil ||
-		m.pendingLogSub.Closed() {
+		m.replacedSub == nil || m.droppedSub == nil || m.pendingLogSub.Closed() {
 		logger.Crit("Subscribe for event system failed")
 	}
 
@@ -176,6 +196,8 @@ func (sub *Subscription) Unsubscribe() {
 			case <-sub.f.logs:
 			case <-sub.f.hashes:
 			case <-sub.f.headers:
+			case <-sub.f.replaced:
+			case <-sub.f.dropped:
 			}
 		}
 
@@ -243,6 +265,8 @@ func (es *EventSystem) subscribeMinedPendingLogs(crit klaytn.FilterQuery, logs c
 		logs:      logs,
 		hashes:    make(chan []common.Hash),
 		headers:   make(chan *types.Header),
+		replaced:  make(chan blockchain.ReplacedTxEvent),
+		dropped:   make(chan blockchain.DroppedTxsEvent),
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -260,6 +284,8 @@ func (es *EventSystem) subscribeLogs(crit klaytn.FilterQuery, logs chan []*types
 		logs:      logs,
 		hashes:    make(chan []common.Hash),
 		headers:   make(chan *types.Header),
+		replaced:  make(chan blockchain.ReplacedTxEvent),
+		dropped:   make(chan blockchain.DroppedTxsEvent),
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -277,6 +303,8 @@ func (es *EventSystem) subscribePendingLogs(crit klaytn.FilterQuery, logs chan [
 		logs:      logs,
 		hashes:    make(chan []common.Hash),
 		headers:   make(chan *types.Header),
+		replaced:  make(chan blockchain.ReplacedTxEvent),
+		dropped:   make(chan blockchain.DroppedTxsEvent),
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -293,6 +321,8 @@ func (es *EventSystem) SubscribeNewHeads(headers chan *types.Header) *Subscripti
 		logs:      make(chan []*types.Log),
 		hashes:    make(chan []common.Hash),
 		headers:   headers,
+		replaced:  make(chan blockchain.ReplacedTxEvent),
+		dropped:   make(chan blockchain.DroppedTxsEvent),
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -309,6 +339,44 @@ func (es *EventSystem) SubscribePendingTxs(hashes chan []common.Hash) *Subscript
 		logs:      make(chan []*types.Log),
 		hashes:    hashes,
 		headers:   make(chan *types.Header),
+		replaced:  make(chan blockchain.ReplacedTxEvent),
+		dropped:   make(chan blockchain.DroppedTxsEvent),
+		installed: make(chan struct{}),
+		err:       make(chan error),
+	}
+	return es.subscribe(sub)
+}
+
+// SubscribeReplacedTxs creates a subscription that writes the transactions replaced
+// in the transaction pool together with their replacements.
+func (es *EventSystem) SubscribeReplacedTxs(replaced chan blockchain.ReplacedTxEvent) *Subscription {
+	sub := &subscription{
+		id:        rpc.NewID(),
+		typ:       ReplacedTransactionsSubscription,
+		created:   time.Now(),
+		logs:      make(chan []*types.Log),
+		hashes:    make(chan []common.Hash),
+		headers:   make(chan *types.Header),
+		replaced:  replaced,
+		dropped:   make(chan blockchain.DroppedTxsEvent),
+		installed: make(chan struct{}),
+		err:       make(chan error),
+	}
+	return es.subscribe(sub)
+}
+
+// SubscribeDroppedTxs creates a subscription that writes the transactions dropped
+// from the transaction pool without being included in a block.
+func (es *EventSystem) SubscribeDroppedTxs(dropped chan blockchain.DroppedTxsEvent) *Subscription {
+	sub := &subscription{
+		id:        rpc.NewID(),
+		typ:       DroppedTransactionsSubscription,
+		created:   time.Now(),
+		logs:      make(chan []*types.Log),
+		hashes:    make(chan []common.Hash),
+		headers:   make(chan *types.Header),
+		replaced:  make(chan blockchain.ReplacedTxEvent),
+		dropped:   dropped,
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -357,6 +425,16 @@ func (es *EventSystem) broadcast(filters filterIndex, ev interface{}) {
 		for _, f := range filters[PendingTransactionsSubscription] {
 			f.hashes <- hashes
 		}
+	case blockchain.ReplacedTxEvent:
+		for _, f := range filters[ReplacedTransactionsSubscription] {
+			f.replaced <- e
+		}
+	case blockchain.DroppedTxsEvent:
+		if len(e.Txs) > 0 {
+			for _, f := range filters[DroppedTransactionsSubscription] {
+				f.dropped <- e
+			}
+		}
 	case blockchain.ChainEvent:
 		for _, f := range filters[BlocksSubscription] {
 			f.headers <- e.Block.Header()
@@ -452,6 +530,8 @@ func (es *EventSystem) eventLoop() {
 		es.logsSub.Unsubscribe()
 		es.rmLogsSub.Unsubscribe()
 		es.chainSub.Unsubscribe()
+		es.replacedSub.Unsubscribe()
+		es.droppedSub.Unsubscribe()
 	}()
 
 	index := make(filterIndex)
@@ -470,6 +550,10 @@ func (es *EventSystem) eventLoop() {
 			es.broadcast(index, ev)
 		case ev := <-es.chainCh:
 			es.broadcast(index, ev)
+		case ev := <-es.replacedCh:
+			es.broadcast(index, ev)
+		case ev := <-es.droppedCh:
+			es.broadcast(index, ev)
 		case ev, active := <-es.pendingLogSub.Chan():
 			if !active { // system stopped
 				return
@@ -505,6 +589,10 @@ func (es *EventSystem) eventLoop() {
 			return
 		case <-es.chainSub.Err():
 			return
+		case <-es.replacedSub.Err():
+			return
+		case <-es.droppedSub.Err():
+			return
 		}
 	}
 }
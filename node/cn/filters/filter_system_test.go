@@ -49,6 +49,9 @@ type testBackend struct {
 	rmLogsFeed *event.Feed
 	logsFeed   *event.Feed
 	chainFeed  *event.Feed
+
+	replacedFeed *event.Feed
+	droppedFeed  *event.Feed
 }
 
 /*
@@ -131,6 +134,14 @@ func (b *testBackend) SubscribeChainEvent(ch chan<- blockchain.ChainEvent) event
 	return b.chainFeed.Subscribe(ch)
 }
 
+func (b *testBackend) SubscribeReplacedTxEvent(ch chan<- blockchain.ReplacedTxEvent) event.Subscription {
+	return b.replacedFeed.Subscribe(ch)
+}
+
+func (b *testBackend) SubscribeDroppedTxsEvent(ch chan<- blockchain.DroppedTxsEvent) event.Subscription {
+	return b.droppedFeed.Subscribe(ch)
+}
+
 func (b *testBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, b.sections
 }
@@ -177,7 +188,7 @@ func TestBlockSubscription(t *testing.T) {
 		rmLogsFeed  = new(event.Feed)
 		logsFeed    = new(event.Feed)
 		chainFeed   = new(event.Feed)
-		backend     = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed}
+		backend     = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed, new(event.Feed), new(event.Feed)}
 		api         = NewPublicFilterAPI(backend, false)
 		genesis     = new(blockchain.Genesis).MustCommit(db)
 		chain, _    = blockchain.GenerateChain(params.TestChainConfig, genesis, gxhash.NewFaker(), db, 10, func(i int, gen *blockchain.BlockGen) {})
@@ -234,7 +245,7 @@ func TestPendingTxFilter(t *testing.T) {
 		rmLogsFeed = new(event.Feed)
 		logsFeed   = new(event.Feed)
 		chainFeed  = new(event.Feed)
-		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed}
+		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed, new(event.Feed), new(event.Feed)}
 		api        = NewPublicFilterAPI(backend, false)
 
 		transactions = []*types.Transaction{
@@ -284,6 +295,59 @@ func TestPendingTxFilter(t *testing.T) {
 	}
 }
 
+// TestReplacedAndDroppedTxSubscription tests whether the replaced and dropped transactions
+// posted by the transaction pool are delivered to their subscriptions only.
+func TestReplacedAndDroppedTxSubscription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mux          = new(event.TypeMux)
+		db           = database.NewMemoryDBManager()
+		replacedFeed = new(event.Feed)
+		droppedFeed  = new(event.Feed)
+		backend      = &testBackend{mux, db, 0, new(event.Feed), new(event.Feed), new(event.Feed), new(event.Feed), replacedFeed, droppedFeed}
+		es           = NewEventSystem(mux, backend, false)
+
+		to     = common.HexToAddress("0xb794f5ea0ba39494ce83a213fffba74279579268")
+		oldTx  = types.NewTransaction(0, to, new(big.Int), 0, big.NewInt(1), nil)
+		newTx  = types.NewTransaction(0, to, new(big.Int), 0, big.NewInt(2), nil)
+		drops  = types.Transactions{types.NewTransaction(1, to, new(big.Int), 0, big.NewInt(1), nil)}
+		reason = blockchain.TxDropReasonExpired
+	)
+
+	replaced := make(chan blockchain.ReplacedTxEvent)
+	replacedSub := es.SubscribeReplacedTxs(replaced)
+	defer replacedSub.Unsubscribe()
+
+	dropped := make(chan blockchain.DroppedTxsEvent)
+	droppedSub := es.SubscribeDroppedTxs(dropped)
+	defer droppedSub.Unsubscribe()
+
+	replacedFeed.Send(blockchain.ReplacedTxEvent{Old: oldTx, New: newTx})
+	select {
+	case ev := <-replaced:
+		if ev.Old.Hash() != oldTx.Hash() || ev.New.Hash() != newTx.Hash() {
+			t.Errorf("invalid replaced transaction, want %x -> %x, got %x -> %x", oldTx.Hash(), newTx.Hash(), ev.Old.Hash(), ev.New.Hash())
+		}
+	case ev := <-dropped:
+		t.Fatalf("replaced transaction delivered to the dropped transactions subscription: %v", ev)
+	case <-time.After(time.Second):
+		t.Fatal("replaced transaction not delivered")
+	}
+
+	droppedFeed.Send(blockchain.DroppedTxsEvent{Txs: drops, Reason: reason})
+	select {
+	case ev := <-dropped:
+		if len(ev.Txs) != 1 || ev.Txs[0].Hash() != drops[0].Hash() || ev.Reason != reason {
+			t.Errorf("invalid dropped transactions, want %x (%s), got %v (%s)", drops[0].Hash(), reason, ev.Txs, ev.Reason)
+		}
+	case ev := <-replaced:
+		t.Fatalf("dropped transactions delivered to the replaced transaction subscription: %v", ev)
+	case <-time.After(time.Second):
+		t.Fatal("dropped transactions not delivered")
+	}
+}
+
 // TestLogFilterCreation test whether a given filter criteria makes sense.
 // If not it must return an error.
 func TestLogFilterCreation(t *testing.T) {
@@ -294,7 +358,7 @@ func TestLogFilterCreation(t *testing.T) {
 		rmLogsFeed = new(event.Feed)
 		logsFeed   = new(event.Feed)
 		chainFeed  = new(event.Feed)
-		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed}
+		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed, new(event.Feed), new(event.Feed)}
 		api        = NewPublicFilterAPI(backend, false)
 
 		testCases = []struct {
@@ -343,7 +407,7 @@ func TestInvalidLogFilterCreation(t *testing.T) {
 		rmLogsFeed = new(event.Feed)
 		logsFeed   = new(event.Feed)
 		chainFeed  = new(event.Feed)
-		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed}
+		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed, new(event.Feed), new(event.Feed)}
 		api        = NewPublicFilterAPI(backend, false)
 	)
 
@@ -372,7 +436,7 @@ func TestInvalidGetLogsRequest(t *testing.T) {
 		rmLogsFeed = new(event.Feed)
 		logsFeed   = new(event.Feed)
 		chainFeed  = new(event.Feed)
-		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed}
+		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed, new(event.Feed), new(event.Feed)}
 		api        = NewPublicFilterAPI(backend, false)
 		blockHash  = common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111")
 	)
@@ -403,7 +467,7 @@ func TestLogFilter(t *testing.T) {
 		rmLogsFeed = new(event.Feed)
 		logsFeed   = new(event.Feed)
 		chainFeed  = new(event.Feed)
-		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed}
+		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed, new(event.Feed), new(event.Feed)}
 		api        = NewPublicFilterAPI(backend, false)
 
 		firstAddr      = common.HexToAddress("0x1111111111111111111111111111111111111111")
@@ -522,7 +586,7 @@ func TestPendingLogsSubscription(t *testing.T) {
 		rmLogsFeed = new(event.Feed)
 		logsFeed   = new(event.Feed)
 		chainFeed  = new(event.Feed)
-		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed}
+		backend    = &testBackend{mux, db, 0, txFeed, rmLogsFeed, logsFeed, chainFeed, new(event.Feed), new(event.Feed)}
 		api        = NewPublicFilterAPI(backend, false)
 
 		firstAddr      = common.HexToAddress("0x1111111111111111111111111111111111111111")
@@ -153,6 +153,20 @@ func (mr *MockBackendMockRecorder) SubscribeChainEvent(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeChainEvent", reflect.TypeOf((*MockBackend)(nil).SubscribeChainEvent), arg0)
 }
 
+// SubscribeDroppedTxsEvent mocks base method
+func (m *MockBackend) SubscribeDroppedTxsEvent(arg0 chan<- blockchain.DroppedTxsEvent) event.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeDroppedTxsEvent", arg0)
+	ret0, _ := ret[0].(event.Subscription)
+	return ret0
+}
+
+// SubscribeDroppedTxsEvent indicates an expected call of SubscribeDroppedTxsEvent
+func (mr *MockBackendMockRecorder) SubscribeDroppedTxsEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeDroppedTxsEvent", reflect.TypeOf((*MockBackend)(nil).SubscribeDroppedTxsEvent), arg0)
+}
+
 // SubscribeLogsEvent mocks base method
 func (m *MockBackend) SubscribeLogsEvent(arg0 chan<- []*types.Log) event.Subscription {
 	m.ctrl.T.Helper()
@@ -194,3 +208,17 @@ func (mr *MockBackendMockRecorder) SubscribeRemovedLogsEvent(arg0 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeRemovedLogsEvent", reflect.TypeOf((*MockBackend)(nil).SubscribeRemovedLogsEvent), arg0)
 }
+
+// SubscribeReplacedTxEvent mocks base method
+func (m *MockBackend) SubscribeReplacedTxEvent(arg0 chan<- blockchain.ReplacedTxEvent) event.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeReplacedTxEvent", arg0)
+	ret0, _ := ret[0].(event.Subscription)
+	return ret0
+}
+
+// SubscribeReplacedTxEvent indicates an expected call of SubscribeReplacedTxEvent
+func (mr *MockBackendMockRecorder) SubscribeReplacedTxEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeReplacedTxEvent", reflect.TypeOf((*MockBackend)(nil).SubscribeReplacedTxEvent), arg0)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"github.com/klaytn/klaytn/api"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/networks/rpc"
 	"github.com/klaytn/klaytn/params"
 	"math/big"
 	"sync"
@@ -75,6 +76,12 @@ func NewOracle(backend api.Backend, params Config) *Oracle {
 
 // SuggestPrice returns the recommended gas price.
 func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
+	// NOTE-Klaytn After the dynamic fee fork, we suggest twice the base fee of the latest block
+	//         so that the transaction can be included even if the base fee goes up for a while.
+	//         Only the base fee is charged, so the surplus is not paid by the sender.
+	if head, err := gpo.backend.HeaderByNumber(ctx, rpc.LatestBlockNumber); err == nil && head != nil && head.BaseFee != nil {
+		return new(big.Int).Mul(head.BaseFee, common.Big2), nil
+	}
 
 	// NOTE-Klaytn We use invariant ChainConfig.UnitPrice and this value
 	//         will not be changed until ChainConfig.UnitPrice is updated with governance.
@@ -19,6 +19,7 @@ package gasprice
 import (
 	"github.com/golang/mock/gomock"
 	mock_api "github.com/klaytn/klaytn/api/mocks"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/stretchr/testify/assert"
 	"math/big"
 	"testing"
@@ -78,6 +79,7 @@ func TestGasPrice_SuggestPrice(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockBackend := mock_api.NewMockBackend(mockCtrl)
+	mockBackend.EXPECT().HeaderByNumber(gomock.Any(), gomock.Any()).Return(&types.Header{Number: big.NewInt(1)}, nil).AnyTimes()
 	params := Config{}
 	oracle := NewOracle(mockBackend, params)
 
@@ -92,3 +94,16 @@ func TestGasPrice_SuggestPrice(t *testing.T) {
 	assert.Equal(t, big.NewInt(123), price)
 	assert.Nil(t, err)
 }
+
+func TestGasPrice_SuggestPriceWithBaseFee(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockBackend := mock_api.NewMockBackend(mockCtrl)
+	header := &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(25)}
+	mockBackend.EXPECT().HeaderByNumber(gomock.Any(), gomock.Any()).Return(header, nil).AnyTimes()
+	oracle := NewOracle(mockBackend, Config{Default: big.NewInt(123)})
+
+	price, err := oracle.SuggestPrice(nil)
+	assert.Equal(t, big.NewInt(50), price)
+	assert.Nil(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/klaytn/klaytn/node/cn/gasprice"
 	"github.com/klaytn/klaytn/storage/database"
 	"github.com/klaytn/klaytn/storage/statedb"
+	"github.com/klaytn/klaytn/work"
 )
 
 var _ = (*configMarshaling)(nil)
@@ -42,6 +43,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		TrieBlockInterval       uint
 		TriesInMemory           uint64
 		SenderTxHashIndexing    bool
+		RevertReasonIndexing    bool
 		ParallelDBWrite         bool
 		StateDBCaching          bool
 		TxPoolStateCache        bool
@@ -49,11 +51,15 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		ServiceChainSigner      common.Address `toml:",omitempty"`
 		ExtraData               hexutil.Bytes  `toml:",omitempty"`
 		GasPrice                *big.Int
+		TxOrdering              work.TxOrderingConfig
 		Rewardbase              common.Address `toml:",omitempty"`
 		TxPool                  blockchain.TxPoolConfig
 		GPO                     gasprice.Config
 		EnablePreimageRecording bool
 		EnableInternalTxTracing bool
+		SlowTxThreshold         time.Duration
+		ParallelTxExecution     bool
+		TraceCache              TraceCacheConfig
 		Istanbul                istanbul.Config
 		DocRoot                 string `toml:"-"`
 		WsEndpoint              string `toml:",omitempty"`
@@ -88,6 +94,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.TrieBlockInterval = c.TrieBlockInterval
 	enc.TriesInMemory = c.TriesInMemory
 	enc.SenderTxHashIndexing = c.SenderTxHashIndexing
+	enc.RevertReasonIndexing = c.RevertReasonIndexing
 	enc.ParallelDBWrite = c.ParallelDBWrite
 	enc.StateDBCaching = c.StateDBCaching
 	enc.TxPoolStateCache = c.TxPoolStateCache
@@ -95,11 +102,15 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.ServiceChainSigner = c.ServiceChainSigner
 	enc.ExtraData = c.ExtraData
 	enc.GasPrice = c.GasPrice
+	enc.TxOrdering = c.TxOrdering
 	enc.Rewardbase = c.Rewardbase
 	enc.TxPool = c.TxPool
 	enc.GPO = c.GPO
 	enc.EnablePreimageRecording = c.EnablePreimageRecording
 	enc.EnableInternalTxTracing = c.EnableInternalTxTracing
+	enc.SlowTxThreshold = c.SlowTxThreshold
+	enc.ParallelTxExecution = c.ParallelTxExecution
+	enc.TraceCache = c.TraceCache
 	enc.Istanbul = c.Istanbul
 	enc.DocRoot = c.DocRoot
 	enc.WsEndpoint = c.WsEndpoint
@@ -138,6 +149,7 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		TrieBlockInterval       *uint
 		TriesInMemory           *uint64
 		SenderTxHashIndexing    *bool
+		RevertReasonIndexing    *bool
 		ParallelDBWrite         *bool
 		StateDBCaching          *bool
 		TxPoolStateCache        *bool
@@ -145,11 +157,15 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		ServiceChainSigner      *common.Address `toml:",omitempty"`
 		ExtraData               *hexutil.Bytes  `toml:",omitempty"`
 		GasPrice                *big.Int
+		TxOrdering              *work.TxOrderingConfig
 		Rewardbase              *common.Address `toml:",omitempty"`
 		TxPool                  *blockchain.TxPoolConfig
 		GPO                     *gasprice.Config
 		EnablePreimageRecording *bool
 		EnableInternalTxTracing *bool
+		SlowTxThreshold         *time.Duration
+		ParallelTxExecution     *bool
+		TraceCache              *TraceCacheConfig
 		Istanbul                *istanbul.Config
 		DocRoot                 *string `toml:"-"`
 		WsEndpoint              *string `toml:",omitempty"`
@@ -229,6 +245,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.SenderTxHashIndexing != nil {
 		c.SenderTxHashIndexing = *dec.SenderTxHashIndexing
 	}
+	if dec.RevertReasonIndexing != nil {
+		c.RevertReasonIndexing = *dec.RevertReasonIndexing
+	}
 	if dec.ParallelDBWrite != nil {
 		c.ParallelDBWrite = *dec.ParallelDBWrite
 	}
@@ -250,6 +269,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.GasPrice != nil {
 		c.GasPrice = dec.GasPrice
 	}
+	if dec.TxOrdering != nil {
+		c.TxOrdering = *dec.TxOrdering
+	}
 	if dec.Rewardbase != nil {
 		c.Rewardbase = *dec.Rewardbase
 	}
@@ -265,6 +287,15 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.EnableInternalTxTracing != nil {
 		c.EnableInternalTxTracing = *dec.EnableInternalTxTracing
 	}
+	if dec.SlowTxThreshold != nil {
+		c.SlowTxThreshold = *dec.SlowTxThreshold
+	}
+	if dec.ParallelTxExecution != nil {
+		c.ParallelTxExecution = *dec.ParallelTxExecution
+	}
+	if dec.TraceCache != nil {
+		c.TraceCache = *dec.TraceCache
+	}
 	if dec.Istanbul != nil {
 		c.Istanbul = *dec.Istanbul
 	}
@@ -72,6 +72,9 @@ var (
 	propConsensusIstanbulInTrafficMeter  = metrics.NewRegisteredMeter("klay/prop/consensus/istanbul/in/traffic", nil)
 	propConsensusIstanbulOutPacketsMeter = metrics.NewRegisteredMeter("klay/prop/consensus/istanbul/out/packets", nil)
 	propConsensusIstanbulOutTrafficMeter = metrics.NewRegisteredMeter("klay/prop/consensus/istanbul/out/traffic", nil)
+	traceCacheHitMeter                   = metrics.NewRegisteredMeter("klay/tracecache/hit", nil)
+	traceCacheMissMeter                  = metrics.NewRegisteredMeter("klay/tracecache/miss", nil)
+	traceCacheSizeGauge                  = metrics.NewRegisteredGauge("klay/tracecache/size", nil)
 )
 
 // meteredMsgReadWriter is a wrapper around a p2p.MsgReadWriter, capable of
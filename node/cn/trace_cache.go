@@ -0,0 +1,408 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package cn
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+)
+
+const (
+	// traceCacheChainEventChanSize is the size of channels listening to chain events.
+	traceCacheChainEventChanSize = 64
+
+	// defaultTraceCachePrefetchTracer is the tracer used by the background worker
+	// when TraceCacheConfig.PrefetchTracer is not given.
+	defaultTraceCachePrefetchTracer = fastCallTracer
+)
+
+var (
+	traceCacheResultPrefix = []byte("t") // traceCacheResultPrefix + txHash + keyHash -> traceCacheEntry
+	traceCacheBlockPrefix  = []byte("b") // traceCacheBlockPrefix + num (uint64 big endian) + txHash + keyHash -> entry size
+)
+
+// TraceCacheConfig holds the options of the persistent trace result cache.
+type TraceCacheConfig struct {
+	Enabled        bool   // Cache trace results of canonical blocks on disk
+	SizeMB         int    // Maximum size of cached trace results in MB, 0 means unlimited
+	MaxBlockAge    uint64 // Number of blocks behind the head to keep results, 0 means unlimited
+	Prefetch       bool   // Trace every new canonical block in the background
+	PrefetchTracer string `toml:",omitempty"` // Tracer used for prefetching
+}
+
+// traceCacheEntry is the stored form of a single cached trace result.
+type traceCacheEntry struct {
+	BlockNumber uint64
+	BlockHash   common.Hash
+	Result      []byte // JSON encoded trace result
+}
+
+// traceCache is an on-disk cache of trace results of transactions in canonical blocks.
+// Results are keyed by the transaction hash and the hash of the tracer name with its
+// configuration. Entries are dropped when their block is reorganised out of the chain,
+// when they get older than MaxBlockAge or when the cache exceeds SizeMB.
+type traceCache struct {
+	config TraceCacheConfig
+	db     database.Database
+	chain  traceCacheBlockChain
+
+	size uint64 // Total size of cached entries in bytes
+	lock sync.Mutex
+
+	chainEventCh     chan blockchain.ChainEvent
+	chainSideEventCh chan blockchain.ChainSideEvent
+	prefetchCh       chan *types.Block
+	subs             []event.Subscription
+	quit             chan struct{}
+	wg               sync.WaitGroup
+}
+
+// traceCacheBlockChain is the subset of blockchain methods used by the trace cache.
+type traceCacheBlockChain interface {
+	SubscribeChainEvent(ch chan<- blockchain.ChainEvent) event.Subscription
+	SubscribeChainSideEvent(ch chan<- blockchain.ChainSideEvent) event.Subscription
+}
+
+// traceBlockFn traces all transactions of the given block with the given configuration.
+type traceBlockFn func(ctx context.Context, block *types.Block, config *TraceConfig) ([]*txTraceResult, error)
+
+// newTraceCache creates a trace cache on top of the given database and calculates
+// the size of entries which are already stored.
+func newTraceCache(config TraceCacheConfig, db database.Database, chain traceCacheBlockChain) *traceCache {
+	tc := &traceCache{
+		config: config,
+		db:     db,
+		chain:  chain,
+		quit:   make(chan struct{}),
+	}
+
+	it := db.NewIteratorWithPrefix(traceCacheBlockPrefix)
+	for it.Next() {
+		tc.size += binary.BigEndian.Uint64(it.Value())
+	}
+	it.Release()
+
+	logger.Info("Initialised trace cache", "sizeMB", config.SizeMB, "maxBlockAge", config.MaxBlockAge,
+		"prefetch", config.Prefetch, "storedBytes", tc.size)
+	return tc
+}
+
+// traceCacheKeyHash returns the hash identifying the tracer and its configuration.
+// Timeout and Reexec are excluded since they do not change the result of a trace.
+func traceCacheKeyHash(config *TraceConfig) (common.Hash, error) {
+	var (
+		tracer    string
+		logConfig []byte
+		err       error
+	)
+	if config != nil {
+		if config.Tracer != nil {
+			tracer = *config.Tracer
+		}
+		if config.LogConfig != nil {
+			if logConfig, err = json.Marshal(config.LogConfig); err != nil {
+				return common.Hash{}, err
+			}
+		}
+	}
+	return crypto.Keccak256Hash([]byte(tracer), []byte{0}, logConfig), nil
+}
+
+func traceCacheResultKey(txHash, keyHash common.Hash) []byte {
+	return append(append(append([]byte{}, traceCacheResultPrefix...), txHash.Bytes()...), keyHash.Bytes()...)
+}
+
+func traceCacheBlockKey(number uint64, txHash, keyHash common.Hash) []byte {
+	key := make([]byte, len(traceCacheBlockPrefix)+8+2*common.HashLength)
+	copy(key, traceCacheBlockPrefix)
+	binary.BigEndian.PutUint64(key[len(traceCacheBlockPrefix):], number)
+	copy(key[len(traceCacheBlockPrefix)+8:], txHash.Bytes())
+	copy(key[len(traceCacheBlockPrefix)+8+common.HashLength:], keyHash.Bytes())
+	return key
+}
+
+func traceCacheBlockPrefixOf(number uint64) []byte {
+	key := make([]byte, len(traceCacheBlockPrefix)+8)
+	copy(key, traceCacheBlockPrefix)
+	binary.BigEndian.PutUint64(key[len(traceCacheBlockPrefix):], number)
+	return key
+}
+
+// get returns the cached trace result of the given transaction. A result is returned
+// only if it has been produced from the given block, which is the canonical block
+// the transaction is currently included in.
+func (tc *traceCache) get(txHash, blockHash common.Hash, config *TraceConfig) (json.RawMessage, bool) {
+	keyHash, err := traceCacheKeyHash(config)
+	if err != nil {
+		return nil, false
+	}
+	data, err := tc.db.Get(traceCacheResultKey(txHash, keyHash))
+	if err != nil || len(data) == 0 {
+		traceCacheMissMeter.Mark(1)
+		return nil, false
+	}
+	entry := new(traceCacheEntry)
+	if err := rlp.DecodeBytes(data, entry); err != nil {
+		logger.Warn("Failed to decode a trace cache entry", "txHash", txHash, "err", err)
+		traceCacheMissMeter.Mark(1)
+		return nil, false
+	}
+	if entry.BlockHash != blockHash {
+		// The transaction has been reorganised into another block.
+		tc.lock.Lock()
+		tc.deleteLocked(entry.BlockNumber, txHash, keyHash)
+		tc.lock.Unlock()
+		traceCacheMissMeter.Mark(1)
+		return nil, false
+	}
+	traceCacheHitMeter.Mark(1)
+	return entry.Result, true
+}
+
+// put stores the trace result of the given transaction included in the given block.
+func (tc *traceCache) put(txHash common.Hash, block *types.Block, config *TraceConfig, result interface{}) {
+	keyHash, err := traceCacheKeyHash(config)
+	if err != nil {
+		return
+	}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		logger.Warn("Failed to encode a trace result", "txHash", txHash, "err", err)
+		return
+	}
+	data, err := rlp.EncodeToBytes(&traceCacheEntry{BlockNumber: block.NumberU64(), BlockHash: block.Hash(), Result: encoded})
+	if err != nil {
+		logger.Warn("Failed to encode a trace cache entry", "txHash", txHash, "err", err)
+		return
+	}
+
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	resultKey := traceCacheResultKey(txHash, keyHash)
+	if old, err := tc.db.Get(resultKey); err == nil && len(old) > 0 {
+		oldEntry := new(traceCacheEntry)
+		if err := rlp.DecodeBytes(old, oldEntry); err == nil {
+			tc.deleteLocked(oldEntry.BlockNumber, txHash, keyHash)
+		}
+	}
+
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(data)))
+
+	batch := tc.db.NewBatch()
+	batch.Put(resultKey, data)
+	batch.Put(traceCacheBlockKey(block.NumberU64(), txHash, keyHash), size)
+	if err := batch.Write(); err != nil {
+		logger.Warn("Failed to write a trace cache entry", "txHash", txHash, "err", err)
+		return
+	}
+	tc.size += uint64(len(data))
+	traceCacheSizeGauge.Update(int64(tc.size))
+
+	tc.evictBySizeLocked()
+}
+
+// deleteLocked removes a single entry. The caller must hold tc.lock.
+func (tc *traceCache) deleteLocked(number uint64, txHash, keyHash common.Hash) {
+	blockKey := traceCacheBlockKey(number, txHash, keyHash)
+	if size, err := tc.db.Get(blockKey); err == nil && len(size) == 8 {
+		tc.size -= binary.BigEndian.Uint64(size)
+	}
+	tc.db.Delete(traceCacheResultKey(txHash, keyHash))
+	tc.db.Delete(blockKey)
+	traceCacheSizeGauge.Update(int64(tc.size))
+}
+
+// evictLocked removes entries starting from the oldest block as long as shouldEvict
+// returns true for the block number of the entry and the size of the remaining cache.
+// The caller must hold tc.lock.
+func (tc *traceCache) evictLocked(shouldEvict func(number, remaining uint64) bool) {
+	type entryKey struct {
+		number          uint64
+		txHash, keyHash common.Hash
+	}
+	var (
+		deletes   []entryKey
+		remaining = tc.size
+	)
+	it := tc.db.NewIteratorWithPrefix(traceCacheBlockPrefix)
+	for it.Next() {
+		key := it.Key()[len(traceCacheBlockPrefix):]
+		number := binary.BigEndian.Uint64(key[:8])
+		if !shouldEvict(number, remaining) {
+			break
+		}
+		deletes = append(deletes, entryKey{
+			number:  number,
+			txHash:  common.BytesToHash(key[8 : 8+common.HashLength]),
+			keyHash: common.BytesToHash(key[8+common.HashLength:]),
+		})
+		remaining -= binary.BigEndian.Uint64(it.Value())
+	}
+	it.Release()
+
+	for _, e := range deletes {
+		tc.deleteLocked(e.number, e.txHash, e.keyHash)
+	}
+}
+
+// evictBySizeLocked removes the oldest entries until the cache fits in SizeMB.
+// The caller must hold tc.lock.
+func (tc *traceCache) evictBySizeLocked() {
+	if tc.config.SizeMB <= 0 {
+		return
+	}
+	limit := uint64(tc.config.SizeMB) * 1024 * 1024
+	tc.evictLocked(func(number, remaining uint64) bool { return remaining > limit })
+}
+
+// evictByAge removes entries of blocks older than MaxBlockAge from the given head.
+func (tc *traceCache) evictByAge(head uint64) {
+	if tc.config.MaxBlockAge == 0 || head <= tc.config.MaxBlockAge {
+		return
+	}
+	oldest := head - tc.config.MaxBlockAge
+
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+	tc.evictLocked(func(number, remaining uint64) bool { return number < oldest })
+}
+
+// invalidateBlock removes all entries of the given block number. It is called when
+// a block has been reorganised out of the canonical chain.
+func (tc *traceCache) invalidateBlock(number uint64) {
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	var deletes [][2]common.Hash
+	prefix := traceCacheBlockPrefixOf(number)
+	it := tc.db.NewIteratorWithPrefix(prefix)
+	for it.Next() {
+		key := it.Key()[len(prefix):]
+		deletes = append(deletes, [2]common.Hash{common.BytesToHash(key[:common.HashLength]), common.BytesToHash(key[common.HashLength:])})
+	}
+	it.Release()
+
+	for _, d := range deletes {
+		tc.deleteLocked(number, d[0], d[1])
+	}
+}
+
+// start subscribes chain events to invalidate reorganised results and evict old ones.
+// If prefetching is enabled, new canonical blocks are traced with traceFn as well.
+func (tc *traceCache) start(traceFn traceBlockFn) {
+	tc.chainEventCh = make(chan blockchain.ChainEvent, traceCacheChainEventChanSize)
+	tc.chainSideEventCh = make(chan blockchain.ChainSideEvent, traceCacheChainEventChanSize)
+	tc.subs = append(tc.subs,
+		tc.chain.SubscribeChainEvent(tc.chainEventCh),
+		tc.chain.SubscribeChainSideEvent(tc.chainSideEventCh))
+
+	if tc.config.Prefetch {
+		tc.prefetchCh = make(chan *types.Block, traceCacheChainEventChanSize)
+		tc.wg.Add(1)
+		go tc.prefetchLoop(traceFn)
+	}
+	tc.wg.Add(1)
+	go tc.loop()
+}
+
+func (tc *traceCache) loop() {
+	defer tc.wg.Done()
+
+	for {
+		select {
+		case ev := <-tc.chainEventCh:
+			tc.evictByAge(ev.Block.NumberU64())
+			if tc.prefetchCh != nil {
+				select {
+				case tc.prefetchCh <- ev.Block:
+				default:
+					// Skip prefetching while the node is catching up with the chain.
+					logger.Trace("Skip prefetching traces of a block", "number", ev.Block.NumberU64())
+				}
+			}
+
+		case ev := <-tc.chainSideEventCh:
+			tc.invalidateBlock(ev.Block.NumberU64())
+
+		case <-tc.quit:
+			return
+		}
+	}
+}
+
+func (tc *traceCache) prefetchLoop(traceFn traceBlockFn) {
+	defer tc.wg.Done()
+
+	tracer := tc.config.PrefetchTracer
+	if tracer == "" {
+		tracer = defaultTraceCachePrefetchTracer
+	}
+	config := &TraceConfig{Tracer: &tracer}
+
+	for {
+		select {
+		case block := <-tc.prefetchCh:
+			if block.Transactions().Len() == 0 {
+				continue
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				select {
+				case <-tc.quit:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			results, err := traceFn(ctx, block, config)
+			cancel()
+			if err != nil {
+				logger.Debug("Failed to prefetch traces of a block", "number", block.NumberU64(), "err", err)
+				continue
+			}
+			for _, res := range results {
+				if res.Error == "" {
+					tc.put(res.TxHash, block, config, res.Result)
+				}
+			}
+
+		case <-tc.quit:
+			return
+		}
+	}
+}
+
+// stop terminates the background goroutines and closes the database.
+func (tc *traceCache) stop() {
+	for _, sub := range tc.subs {
+		sub.Unsubscribe()
+	}
+	close(tc.quit)
+	tc.wg.Wait()
+	tc.db.Close()
+}
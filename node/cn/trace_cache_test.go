@@ -0,0 +1,119 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package cn
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTraceCache(config TraceCacheConfig) *traceCache {
+	config.Enabled = true
+	return newTraceCache(config, database.NewMemDB(), nil)
+}
+
+func TestTraceCache_PutGet(t *testing.T) {
+	tc := newTestTraceCache(TraceCacheConfig{})
+	block := newBlock(10)
+	tracer := fastCallTracer
+	config := &TraceConfig{Tracer: &tracer}
+
+	_, ok := tc.get(hashes[0], block.Hash(), config)
+	assert.False(t, ok)
+
+	tc.put(hashes[0], block, config, map[string]string{"type": "CALL"})
+
+	result, ok := tc.get(hashes[0], block.Hash(), config)
+	assert.True(t, ok)
+	assert.Equal(t, json.RawMessage(`{"type":"CALL"}`), result)
+
+	// A different tracer configuration does not hit the cache.
+	_, ok = tc.get(hashes[0], block.Hash(), &TraceConfig{LogConfig: &vm.LogConfig{DisableStorage: true}})
+	assert.False(t, ok)
+
+	// A result of a transaction moved into another block is dropped.
+	_, ok = tc.get(hashes[0], newBlock(11).Hash(), config)
+	assert.False(t, ok)
+	_, ok = tc.get(hashes[0], block.Hash(), config)
+	assert.False(t, ok)
+	assert.Equal(t, uint64(0), tc.size)
+}
+
+func TestTraceCache_InvalidateBlock(t *testing.T) {
+	tc := newTestTraceCache(TraceCacheConfig{})
+	block10, block11 := newBlock(10), newBlock(11)
+
+	tc.put(hashes[0], block10, nil, "a")
+	tc.put(hashes[1], block10, nil, "b")
+	tc.put(hashes[2], block11, nil, "c")
+
+	tc.invalidateBlock(10)
+
+	_, ok := tc.get(hashes[0], block10.Hash(), nil)
+	assert.False(t, ok)
+	_, ok = tc.get(hashes[1], block10.Hash(), nil)
+	assert.False(t, ok)
+	_, ok = tc.get(hashes[2], block11.Hash(), nil)
+	assert.True(t, ok)
+}
+
+func TestTraceCache_EvictByAge(t *testing.T) {
+	tc := newTestTraceCache(TraceCacheConfig{MaxBlockAge: 5})
+	block10, block20 := newBlock(10), newBlock(20)
+
+	tc.put(hashes[0], block10, nil, "a")
+	tc.put(hashes[1], block20, nil, "b")
+
+	tc.evictByAge(15)
+	_, ok := tc.get(hashes[0], block10.Hash(), nil)
+	assert.True(t, ok)
+
+	tc.evictByAge(16)
+	_, ok = tc.get(hashes[0], block10.Hash(), nil)
+	assert.False(t, ok)
+	_, ok = tc.get(hashes[1], block20.Hash(), nil)
+	assert.True(t, ok)
+}
+
+func TestTraceCache_EvictBySize(t *testing.T) {
+	tc := newTestTraceCache(TraceCacheConfig{SizeMB: 1})
+	block10, block11 := newBlock(10), newBlock(11)
+	large := make([]byte, 600*1024)
+
+	tc.put(hashes[0], block10, nil, large)
+	tc.put(hashes[1], block11, nil, large)
+
+	// The oldest entry is evicted to keep the cache under the limit.
+	_, ok := tc.get(hashes[0], block10.Hash(), nil)
+	assert.False(t, ok)
+	_, ok = tc.get(hashes[1], block11.Hash(), nil)
+	assert.True(t, ok)
+	assert.True(t, tc.size <= 1024*1024)
+}
+
+func TestTraceCache_Reopen(t *testing.T) {
+	db := database.NewMemDB()
+	tc := newTraceCache(TraceCacheConfig{Enabled: true}, db, nil)
+	tc.put(hashes[0], newBlock(10), nil, "a")
+
+	reopened := newTraceCache(TraceCacheConfig{Enabled: true}, db, nil)
+	assert.Equal(t, tc.size, reopened.size)
+}
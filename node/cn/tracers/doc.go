@@ -16,10 +16,26 @@
 
 /*
 Package tracers provides implementation of Tracer that evaluates a Javascript
-function for each VM execution step.
+function for each VM execution step, and a registry of tracers implemented in Go.
+
+Native Tracers
+
+A Go tracer implements the NativeTracer interface and is registered by name with
+Register, usually from the init function of its package:
+
+	func init() {
+		tracers.Register("tokenFlowTracer", func() tracers.NativeTracer {
+			return newTokenFlowTracer()
+		})
+	}
+
+The package is compiled into a node by importing it for its side effects, e.g.
+`import _ "example.com/mytracers"` in the main package, and the tracer is then
+selected by setting TraceConfig.Tracer of the tracing APIs to its name.
 
 Source Files
 
+  - native.go  : registry of tracers implemented in Go and the NativeTracer interface
   - tracer.go  : implementation of Tracer
   - tracers.go : provides managing functions of tracers
 */
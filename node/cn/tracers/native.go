@@ -0,0 +1,132 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/klaytn/klaytn/blockchain/vm"
+)
+
+// NativeTracer is the interface of transaction tracers implemented in Go.
+// A new instance is created for every traced transaction, so an implementation
+// does not need to be safe for concurrent use.
+//
+// The interface is kept stable so that tracers maintained out of this repository
+// keep working with new releases.
+type NativeTracer interface {
+	vm.Tracer
+
+	// GetResult returns the result of the trace, which is encoded to JSON and
+	// returned to the RPC caller.
+	GetResult() (interface{}, error)
+
+	// Stop terminates execution of the tracer at the first opportune moment.
+	// It is called from another goroutine when the trace times out, and the
+	// given error should be returned by GetResult.
+	Stop(err error)
+}
+
+// NativeTracerConstructor creates a new instance of a native tracer.
+type NativeTracerConstructor func() NativeTracer
+
+var (
+	nativeTracers     = make(map[string]NativeTracerConstructor)
+	nativeTracersLock sync.RWMutex
+)
+
+// Register makes a native tracer available by the given name, which can be
+// selected by TraceConfig.Tracer of the tracing APIs. It is intended to be called
+// from the init function of the package implementing the tracer, which is then
+// compiled in by importing it for its side effects.
+//
+// Register panics if the name is empty, if the constructor is nil or if a tracer
+// with the same name has already been registered.
+func Register(name string, ctor NativeTracerConstructor) {
+	if name == "" {
+		panic("tracers: Register with an empty name")
+	}
+	if ctor == nil {
+		panic("tracers: Register constructor is nil for " + name)
+	}
+	if _, ok := tracer(name); ok {
+		panic("tracers: Register called with the name of a JavaScript tracer " + name)
+	}
+
+	nativeTracersLock.Lock()
+	defer nativeTracersLock.Unlock()
+
+	if _, dup := nativeTracers[name]; dup {
+		panic("tracers: Register called twice for " + name)
+	}
+	nativeTracers[name] = ctor
+}
+
+// NewNativeTracer creates a new instance of the native tracer registered by the
+// given name. It returns false if no such tracer is registered.
+func NewNativeTracer(name string) (NativeTracer, bool) {
+	nativeTracersLock.RLock()
+	ctor, ok := nativeTracers[name]
+	nativeTracersLock.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+	return ctor(), true
+}
+
+// NativeTracers returns the sorted names of the registered native tracers.
+func NativeTracers() []string {
+	nativeTracersLock.RLock()
+	defer nativeTracersLock.RUnlock()
+
+	names := make([]string, 0, len(nativeTracers))
+	for name := range nativeTracers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// internalTxTracer adapts vm.InternalTxTracer to NativeTracer.
+type internalTxTracer struct {
+	*vm.InternalTxTracer
+}
+
+func (t *internalTxTracer) GetResult() (interface{}, error) {
+	return t.InternalTxTracer.GetResult()
+}
+
+// opcodeProfiler adapts vm.OpcodeProfiler to NativeTracer.
+type opcodeProfiler struct {
+	*vm.OpcodeProfiler
+}
+
+func (t *opcodeProfiler) GetResult() (interface{}, error) {
+	return t.OpcodeProfiler.GetResult()
+}
+
+// registerBuiltinNativeTracers registers the native tracers included in Klaytn.
+func registerBuiltinNativeTracers() {
+	Register("fastCallTracer", func() NativeTracer {
+		return &internalTxTracer{vm.NewInternalTxTracer()}
+	})
+	Register("opcodeProfiler", func() NativeTracer {
+		return &opcodeProfiler{vm.NewOpcodeProfiler()}
+	})
+}
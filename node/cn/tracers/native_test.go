@@ -0,0 +1,104 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/params"
+	"github.com/stretchr/testify/assert"
+)
+
+// opCountTracer is a minimal native tracer counting executed opcodes.
+type opCountTracer struct {
+	ops    map[string]int
+	reason error
+}
+
+func (t *opCountTracer) CaptureStart(from common.Address, to common.Address, call bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+func (t *opCountTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	t.ops[op.String()]++
+	return nil
+}
+
+func (t *opCountTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+func (t *opCountTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+func (t *opCountTracer) GetResult() (interface{}, error) { return t.ops, t.reason }
+
+func (t *opCountTracer) Stop(err error) { t.reason = err }
+
+func TestRegister(t *testing.T) {
+	Register("opCountTracer", func() NativeTracer { return &opCountTracer{ops: map[string]int{}} })
+	defer func() {
+		nativeTracersLock.Lock()
+		delete(nativeTracers, "opCountTracer")
+		nativeTracersLock.Unlock()
+	}()
+
+	assert.Contains(t, NativeTracers(), "opCountTracer")
+
+	tracer, ok := NewNativeTracer("opCountTracer")
+	assert.True(t, ok)
+
+	env := vm.NewEVM(vm.Context{BlockNumber: big.NewInt(1)}, &dummyStatedb{}, params.TestChainConfig, &vm.Config{Debug: true, Tracer: tracer})
+	contract := vm.NewContract(account{}, account{}, big.NewInt(0), 10000)
+	contract.Code = []byte{byte(vm.PUSH1), 0x1, byte(vm.PUSH1), 0x1, 0x0}
+	_, err := env.Interpreter().Run(contract, []byte{})
+	assert.NoError(t, err)
+
+	result, err := tracer.GetResult()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"PUSH1": 2, "STOP": 1}, result)
+
+	// Every call creates a new instance.
+	another, _ := NewNativeTracer("opCountTracer")
+	another.Stop(errors.New("execution timeout"))
+	_, err = another.GetResult()
+	assert.Error(t, err)
+	_, err = tracer.GetResult()
+	assert.NoError(t, err)
+}
+
+func TestRegister_Invalid(t *testing.T) {
+	ctor := func() NativeTracer { return &opCountTracer{} }
+
+	assert.Panics(t, func() { Register("", ctor) })
+	assert.Panics(t, func() { Register("nilTracer", nil) })
+	assert.Panics(t, func() { Register("callTracer", ctor) })
+	assert.Panics(t, func() { Register("fastCallTracer", ctor) })
+
+	_, ok := NewNativeTracer("unknownTracer")
+	assert.False(t, ok)
+}
+
+func TestBuiltinNativeTracers(t *testing.T) {
+	assert.Equal(t, []string{"fastCallTracer", "opcodeProfiler"}, NativeTracers())
+}
@@ -39,12 +39,14 @@ func camel(str string) string {
 	return strings.Join(pieces, "")
 }
 
-// init retrieves the JavaScript transaction tracers included in Klaytn.
+// init retrieves the JavaScript transaction tracers included in Klaytn and
+// registers the native ones.
 func init() {
 	for _, file := range tracers.AssetNames() {
 		name := camel(strings.TrimSuffix(file, ".js"))
 		all[name] = string(tracers.MustAsset(file))
 	}
+	registerBuiltinNativeTracers()
 }
 
 // tracer retrieves a specific JavaScript tracer by name.
@@ -310,6 +310,14 @@ func (fb *filterLocalBackend) SubscribeNewTxsEvent(ch chan<- blockchain.NewTxsEv
 	return fb.subbridge.txPool.SubscribeNewTxsEvent(ch)
 }
 
+func (fb *filterLocalBackend) SubscribeReplacedTxEvent(ch chan<- blockchain.ReplacedTxEvent) event.Subscription {
+	return fb.subbridge.txPool.SubscribeReplacedTxEvent(ch)
+}
+
+func (fb *filterLocalBackend) SubscribeDroppedTxsEvent(ch chan<- blockchain.DroppedTxsEvent) event.Subscription {
+	return fb.subbridge.txPool.SubscribeDroppedTxsEvent(ch)
+}
+
 func (fb *filterLocalBackend) SubscribeChainEvent(ch chan<- blockchain.ChainEvent) event.Subscription {
 	return fb.subbridge.blockchain.SubscribeChainEvent(ch)
 }
@@ -130,6 +130,8 @@ const (
 	CallCodeComputationCost       = 4000
 	ReturnComputationCost         = 0
 	SelfDestructComputationCost   = 0
+	ChainIDComputationCost        = 120
+	SelfBalanceComputationCost    = 374
 
 	// Computation cost for precompiled contracts
 	EcrecoverComputationCost            = 113150
@@ -150,4 +152,6 @@ const (
 	FeePayerComputationCost             = 10
 	ValidateSenderPerSigComputationCost = 180000
 	ValidateSenderBaseComputationCost   = 10000
+	Blake2bFBaseComputationCost         = 1000
+	Blake2bFPerRoundComputationCost     = 10
 )
@@ -118,6 +118,14 @@ const (
 type ChainConfig struct {
 	ChainID *big.Int `json:"chainId"` // chainId identifies the current chain and is used for replay protection
 
+	// Hard forks of the protocol. A fork is activated at the given block number,
+	// and nil means the fork is not scheduled.
+	IstanbulCompatibleBlock            *big.Int `json:"istanbulCompatibleBlock,omitempty"`            // IstanbulCompatibleBlock switch block (nil = no fork, 0 = already on istanbul)
+	DynamicFeeCompatibleBlock          *big.Int `json:"dynamicFeeCompatibleBlock,omitempty"`          // DynamicFeeCompatibleBlock switch block (nil = no fork, 0 = already on dynamic fee)
+	StakingRewardCompatibleBlock       *big.Int `json:"stakingRewardCompatibleBlock,omitempty"`       // StakingRewardCompatibleBlock switch block (nil = no fork, 0 = already on staking reward)
+	BLSCompatibleBlock                 *big.Int `json:"blsCompatibleBlock,omitempty"`                 // BLSCompatibleBlock switch block (nil = no fork, 0 = already on BLS committed seals)
+	ScheduledGovernanceCompatibleBlock *big.Int `json:"scheduledGovernanceCompatibleBlock,omitempty"` // ScheduledGovernanceCompatibleBlock switch block (nil = no fork, 0 = already on scheduled governance votes)
+
 	// Various consensus engines
 	Gxhash   *GxhashConfig   `json:"gxhash,omitempty"`
 	Clique   *CliqueConfig   `json:"clique,omitempty"`
@@ -130,9 +138,11 @@ type ChainConfig struct {
 
 // GovernanceConfig stores governance information for a network
 type GovernanceConfig struct {
-	GoverningNode  common.Address `json:"governingNode"`
-	GovernanceMode string         `json:"governanceMode"`
-	Reward         *RewardConfig  `json:"reward,omitempty"`
+	GoverningNode    common.Address    `json:"governingNode"`
+	GovernanceMode   string            `json:"governanceMode"`
+	GovParamContract common.Address    `json:"govParamContract,omitempty"` // Governance contract read in the contract governance mode
+	Reward           *RewardConfig     `json:"reward,omitempty"`
+	DynamicFee       *DynamicFeeConfig `json:"dynamicFee,omitempty"`
 }
 
 func (g *GovernanceConfig) DeferredTxFee() bool {
@@ -148,6 +158,30 @@ type RewardConfig struct {
 	StakingUpdateInterval  uint64   `json:"stakingUpdateInterval"`  // Interval when staking information is updated
 	ProposerUpdateInterval uint64   `json:"proposerUpdateInterval"` // Interval when proposer information is updated
 	MinimumStake           *big.Int `json:"minimumStake"`           // Minimum amount of peb to join CCO
+	StakingReward          bool     `json:"stakingReward"`          // Decide if the CN reward is distributed to the council in proportion to the stakes
+}
+
+// DynamicFeeConfig stores the parameters of the dynamic fee mode, which is enabled
+// by ChainConfig.DynamicFeeCompatibleBlock.
+//
+// The base fee of a block is raised when the parent block used more gas than GasTarget
+// and lowered when it used less, by at most 1/BaseFeeDenominator of the parent's base
+// fee. It is kept between LowerBoundBaseFee and UpperBoundBaseFee.
+type DynamicFeeConfig struct {
+	LowerBoundBaseFee  uint64 `json:"lowerBoundBaseFee"`  // Minimum base fee in peb
+	UpperBoundBaseFee  uint64 `json:"upperBoundBaseFee"`  // Maximum base fee in peb
+	GasTarget          uint64 `json:"gasTarget"`          // Gas used by a block keeping the base fee unchanged
+	BaseFeeDenominator uint64 `json:"baseFeeDenominator"` // Bounds the amount the base fee can change between blocks
+	BurnRatio          uint64 `json:"burnRatio"`          // Percentage of the transaction fee burned instead of being rewarded
+}
+
+// CheckBaseFeeBounds checks that the lower bound of the base fee is not higher than the upper bound.
+func (c *DynamicFeeConfig) CheckBaseFeeBounds() error {
+	if c.LowerBoundBaseFee > c.UpperBoundBaseFee {
+		return fmt.Errorf("invalid base fee bounds: lowerBoundBaseFee %v is higher than upperBoundBaseFee %v",
+			c.LowerBoundBaseFee, c.UpperBoundBaseFee)
+	}
+	return nil
 }
 
 // IstanbulConfig is the consensus engine configs for Istanbul based sealing.
@@ -181,6 +215,86 @@ func (c *IstanbulConfig) String() string {
 	return "istanbul"
 }
 
+// fork is a hard fork scheduled in ChainConfig.
+// An optional fork enables a feature independent of the other forks, so it is not checked by CheckConfigForkOrder.
+type fork struct {
+	name     string
+	block    *big.Int
+	optional bool
+}
+
+// forks returns the hard forks of the chain config in the order they have to be activated, except the optional ones.
+// A new fork should be appended to the list as well as to Rules.
+func (c *ChainConfig) forks() []fork {
+	return []fork{
+		{"istanbulCompatibleBlock", c.IstanbulCompatibleBlock, false},
+		{"dynamicFeeCompatibleBlock", c.DynamicFeeCompatibleBlock, false},
+		{"stakingRewardCompatibleBlock", c.StakingRewardCompatibleBlock, true},
+		{"blsCompatibleBlock", c.BLSCompatibleBlock, true},
+		{"scheduledGovernanceCompatibleBlock", c.ScheduledGovernanceCompatibleBlock, true},
+	}
+}
+
+// IsIstanbulForkEnabled returns whether num is either equal to the istanbul block or greater.
+func (c *ChainConfig) IsIstanbulForkEnabled(num *big.Int) bool {
+	return isForked(c.IstanbulCompatibleBlock, num)
+}
+
+// IsDynamicFeeForkEnabled returns whether num is either equal to the dynamic fee block or greater.
+func (c *ChainConfig) IsDynamicFeeForkEnabled(num *big.Int) bool {
+	return isForked(c.DynamicFeeCompatibleBlock, num)
+}
+
+// IsStakingRewardForkEnabled returns whether num is either equal to the staking reward block or greater.
+func (c *ChainConfig) IsStakingRewardForkEnabled(num *big.Int) bool {
+	return isForked(c.StakingRewardCompatibleBlock, num)
+}
+
+// IsBLSForkEnabled returns whether num is either equal to the BLS block or greater.
+func (c *ChainConfig) IsBLSForkEnabled(num *big.Int) bool {
+	return isForked(c.BLSCompatibleBlock, num)
+}
+
+// IsScheduledGovernanceForkEnabled returns whether num is either equal to the scheduled governance block or greater.
+func (c *ChainConfig) IsScheduledGovernanceForkEnabled(num *big.Int) bool {
+	return isForked(c.ScheduledGovernanceCompatibleBlock, num)
+}
+
+// CheckConfigForkOrder checks that the forks are scheduled in order. A fork can
+// not be scheduled before a previous fork or while a previous fork is not scheduled.
+// Optional forks can be scheduled at any block regardless of the other forks.
+func (c *ChainConfig) CheckConfigForkOrder() error {
+	var last fork
+	for _, cur := range c.forks() {
+		if cur.optional {
+			continue
+		}
+		if last.name != "" && cur.block != nil {
+			if last.block == nil {
+				return fmt.Errorf("unsupported fork ordering: %v not enabled, but %v enabled at %v",
+					last.name, cur.name, cur.block)
+			}
+			if last.block.Cmp(cur.block) > 0 {
+				return fmt.Errorf("unsupported fork ordering: %v enabled at %v, but %v enabled at %v",
+					last.name, last.block, cur.name, cur.block)
+			}
+		}
+		last = cur
+	}
+	return nil
+}
+
+// forksString returns the scheduled forks in the form of "name: block".
+func (c *ChainConfig) forksString() string {
+	var s string
+	for _, f := range c.forks() {
+		if f.block != nil {
+			s += fmt.Sprintf(" %v: %v", f.name, f.block)
+		}
+	}
+	return s
+}
+
 // String implements the fmt.Stringer interface.
 func (c *ChainConfig) String() string {
 	var engine interface{}
@@ -195,19 +309,21 @@ func (c *ChainConfig) String() string {
 		engine = "unknown"
 	}
 	if c.Istanbul != nil {
-		return fmt.Sprintf("{ChainID: %v Engine: %v SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d}",
+		return fmt.Sprintf("{ChainID: %v Engine: %v SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d%s}",
 			c.ChainID,
 			engine,
 			c.Istanbul.SubGroupSize,
 			c.UnitPrice,
 			c.DeriveShaImpl,
+			c.forksString(),
 		)
 	} else {
-		return fmt.Sprintf("{ChainID: %v Engine: %v UnitPrice: %d DeriveShaImpl: %d}",
+		return fmt.Sprintf("{ChainID: %v Engine: %v UnitPrice: %d DeriveShaImpl: %d%s}",
 			c.ChainID,
 			engine,
 			c.UnitPrice,
 			c.DeriveShaImpl,
+			c.forksString(),
 		)
 	}
 }
@@ -216,6 +332,9 @@ func (c *ChainConfig) String() string {
 //
 // The returned GasTable's fields shouldn't, under any circumstances, be changed.
 func (c *ChainConfig) GasTable(num *big.Int) GasTable {
+	if c.IsIstanbulForkEnabled(num) {
+		return GasTableIstanbul
+	}
 	return GasTableCypress
 }
 
@@ -238,6 +357,12 @@ func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *Confi
 }
 
 func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *ConfigCompatError {
+	storedForks, newForks := c.forks(), newcfg.forks()
+	for i := range storedForks {
+		if isForkIncompatible(storedForks[i].block, newForks[i].block, head) {
+			return newCompatError(storedForks[i].name, storedForks[i].block, newForks[i].block)
+		}
+	}
 	return nil
 }
 
@@ -302,7 +427,12 @@ func (err *ConfigCompatError) Error() string {
 // Rules is a one time interface meaning that it shouldn't be used in between transition
 // phases.
 type Rules struct {
-	ChainID *big.Int
+	ChainID               *big.Int
+	IsIstanbul            bool
+	IsDynamicFee          bool
+	IsStakingReward       bool
+	IsBLS                 bool
+	IsScheduledGovernance bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -311,7 +441,14 @@ func (c *ChainConfig) Rules(num *big.Int) Rules {
 	if chainID == nil {
 		chainID = new(big.Int)
 	}
-	return Rules{ChainID: new(big.Int).Set(chainID)}
+	return Rules{
+		ChainID:               new(big.Int).Set(chainID),
+		IsIstanbul:            c.IsIstanbulForkEnabled(num),
+		IsDynamicFee:          c.IsDynamicFeeForkEnabled(num),
+		IsStakingReward:       c.IsStakingRewardForkEnabled(num),
+		IsBLS:                 c.IsBLSForkEnabled(num),
+		IsScheduledGovernance: c.IsScheduledGovernanceForkEnabled(num),
+	}
 }
 
 // Copy copies self to a new governance config and return it
@@ -324,7 +461,13 @@ func (g *GovernanceConfig) Copy() *GovernanceConfig {
 	newConfig.Reward.Ratio = g.Reward.Ratio
 	newConfig.Reward.UseGiniCoeff = g.Reward.UseGiniCoeff
 	newConfig.Reward.DeferredTxFee = g.Reward.DeferredTxFee
+	newConfig.Reward.StakingReward = g.Reward.StakingReward
 	newConfig.GoverningNode = g.GoverningNode
+	newConfig.GovParamContract = g.GovParamContract
+	if g.DynamicFee != nil {
+		dynamicFee := *g.DynamicFee
+		newConfig.DynamicFee = &dynamicFee
+	}
 
 	return newConfig
 }
@@ -0,0 +1,120 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package params
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsForkEnabled(t *testing.T) {
+	config := &ChainConfig{IstanbulCompatibleBlock: big.NewInt(10)}
+
+	assert.False(t, config.IsIstanbulForkEnabled(big.NewInt(9)))
+	assert.True(t, config.IsIstanbulForkEnabled(big.NewInt(10)))
+	assert.True(t, config.Rules(big.NewInt(11)).IsIstanbul)
+
+	assert.False(t, (&ChainConfig{}).IsIstanbulForkEnabled(big.NewInt(100)))
+
+	config.DynamicFeeCompatibleBlock = big.NewInt(20)
+	assert.False(t, config.IsDynamicFeeForkEnabled(big.NewInt(19)))
+	assert.True(t, config.IsDynamicFeeForkEnabled(big.NewInt(20)))
+	assert.True(t, config.Rules(big.NewInt(21)).IsDynamicFee)
+	assert.False(t, config.Rules(big.NewInt(11)).IsDynamicFee)
+}
+
+func TestCheckCompatible(t *testing.T) {
+	type test struct {
+		stored, new *ChainConfig
+		head        uint64
+		wantErr     *ConfigCompatError
+	}
+	tests := []test{
+		{stored: &ChainConfig{}, new: &ChainConfig{}, head: 0, wantErr: nil},
+		{stored: &ChainConfig{}, new: &ChainConfig{}, head: 100, wantErr: nil},
+		{
+			stored:  &ChainConfig{IstanbulCompatibleBlock: big.NewInt(10)},
+			new:     &ChainConfig{IstanbulCompatibleBlock: big.NewInt(20)},
+			head:    9,
+			wantErr: nil,
+		},
+		{
+			stored:  &ChainConfig{},
+			new:     &ChainConfig{IstanbulCompatibleBlock: big.NewInt(20)},
+			head:    10,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{IstanbulCompatibleBlock: big.NewInt(10)},
+			new:    &ChainConfig{IstanbulCompatibleBlock: big.NewInt(20)},
+			head:   25,
+			wantErr: &ConfigCompatError{
+				What:         "istanbulCompatibleBlock",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    big.NewInt(20),
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{IstanbulCompatibleBlock: big.NewInt(10)},
+			new:    &ChainConfig{},
+			head:   10,
+			wantErr: &ConfigCompatError{
+				What:         "istanbulCompatibleBlock",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    nil,
+				RewindTo:     9,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		err := test.stored.CheckCompatible(test.new, test.head)
+		if !reflect.DeepEqual(err, test.wantErr) {
+			t.Errorf("error mismatch:\nstored: %v\nnew: %v\nhead: %v\nerr: %v\nwant: %v", test.stored, test.new, test.head, err, test.wantErr)
+		}
+	}
+}
+
+func TestCheckConfigForkOrder(t *testing.T) {
+	assert.NoError(t, (&ChainConfig{}).CheckConfigForkOrder())
+	assert.NoError(t, (&ChainConfig{IstanbulCompatibleBlock: big.NewInt(0)}).CheckConfigForkOrder())
+	assert.NoError(t, (&ChainConfig{IstanbulCompatibleBlock: big.NewInt(0), DynamicFeeCompatibleBlock: big.NewInt(0)}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{DynamicFeeCompatibleBlock: big.NewInt(0)}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{IstanbulCompatibleBlock: big.NewInt(10), DynamicFeeCompatibleBlock: big.NewInt(5)}).CheckConfigForkOrder())
+
+	// Optional forks can be scheduled alone or before the other forks
+	assert.NoError(t, (&ChainConfig{ScheduledGovernanceCompatibleBlock: big.NewInt(0)}).CheckConfigForkOrder())
+	assert.NoError(t, (&ChainConfig{BLSCompatibleBlock: big.NewInt(0)}).CheckConfigForkOrder())
+	assert.NoError(t, (&ChainConfig{StakingRewardCompatibleBlock: big.NewInt(0)}).CheckConfigForkOrder())
+	assert.NoError(t, (&ChainConfig{IstanbulCompatibleBlock: big.NewInt(10), ScheduledGovernanceCompatibleBlock: big.NewInt(5)}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{DynamicFeeCompatibleBlock: big.NewInt(0), ScheduledGovernanceCompatibleBlock: big.NewInt(0)}).CheckConfigForkOrder())
+}
+
+func TestDynamicFeeConfig_CheckBaseFeeBounds(t *testing.T) {
+	config := DefaultDynamicFeeConfig()
+	assert.NoError(t, config.CheckBaseFeeBounds())
+
+	config.LowerBoundBaseFee = config.UpperBoundBaseFee
+	assert.NoError(t, config.CheckBaseFeeBounds())
+
+	config.LowerBoundBaseFee = config.UpperBoundBaseFee + 1
+	assert.Error(t, config.CheckBaseFeeBounds())
+}
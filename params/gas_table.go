@@ -56,4 +56,18 @@ var (
 
 		CreateBySuicide: 25000, // G_newaccount
 	}
+
+	// GasTableIstanbul contains the gas prices repriced by EIP-1884 after the istanbul fork.
+	GasTableIstanbul = GasTable{
+		ExtcodeSize: 700,
+		ExtcodeCopy: 700,
+		ExtcodeHash: 700,
+		Balance:     700,
+		SLoad:       800,
+		Calls:       700,
+		Suicide:     5000,
+		ExpByte:     50,
+
+		CreateBySuicide: 25000,
+	}
 )
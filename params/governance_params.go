@@ -88,12 +88,26 @@ const (
 	ConstTxGasHumanReadable
 	CliqueEpoch
 	Timeout
+	LowerBoundBaseFee
+	UpperBoundBaseFee
+	GasTarget
+	BaseFeeDenominator
+	BurnRatio
+	GovParamContract
+	BlockGasLimit
+	ComputationCostLimit
+	BlockPeriod
+	MaxTxSize
+	MinimumTxPoolPrice
+	StakingReward
+	DemotionThreshold
 )
 
 const (
 	GovernanceMode_None = iota
 	GovernanceMode_Single
 	GovernanceMode_Ballot
+	GovernanceMode_Contract
 )
 
 const (
@@ -118,8 +132,30 @@ const (
 	DefaultDefferedTxFee  = false
 	DefaultUnitPrice      = uint64(250000000000)
 	DefaultPeriod         = 1
+
+	DefaultLowerBoundBaseFee  = uint64(25000000000)
+	DefaultUpperBoundBaseFee  = uint64(750000000000)
+	DefaultGasTarget          = uint64(30000000)
+	DefaultBaseFeeDenominator = uint64(20)
+	DefaultBurnRatio          = uint64(50)
+
+	DefaultBlockGasLimit        = uint64(0) // No limit
+	DefaultComputationCostLimit = uint64(100000000)
+	DefaultMaxTxSize            = uint64(32 * 1024)
+	DefaultMinimumTxPoolPrice   = uint64(0)
 )
 
+// DefaultDynamicFeeConfig returns the default parameters of the dynamic fee mode.
+func DefaultDynamicFeeConfig() *DynamicFeeConfig {
+	return &DynamicFeeConfig{
+		LowerBoundBaseFee:  DefaultLowerBoundBaseFee,
+		UpperBoundBaseFee:  DefaultUpperBoundBaseFee,
+		GasTarget:          DefaultGasTarget,
+		BaseFeeDenominator: DefaultBaseFeeDenominator,
+		BurnRatio:          DefaultBurnRatio,
+	}
+}
+
 func IsStakingUpdateInterval(blockNum uint64) bool {
 	return (blockNum % StakingUpdateInterval()) == 0
 }
@@ -58,6 +58,16 @@ const (
 	LogTopicGas           uint64 = 375   // Multiplied by the * of the LOG*, per LOG transaction. e.g. LOG0 incurs 0 * c_txLogTopicGas, LOG4 incurs 4 * c_txLogTopicGas.   // G_logtopic
 	TxDataNonZeroGas      uint64 = 68    // Per byte of data attached to a transaction that is not equal to zero. NOTE: Not payable on data of calls between transactions. // G_txdatanonzero
 
+	SstoreSentryGasEIP2200   uint64 = 2300  // Minimum gas required to be present for an SSTORE call, not consumed
+	SstoreNoopGasEIP2200     uint64 = 800   // Once per SSTORE operation if the value doesn't change.
+	SstoreDirtyGasEIP2200    uint64 = 800   // Once per SSTORE operation if a dirty value is changed.
+	SstoreInitGasEIP2200     uint64 = 20000 // Once per SSTORE operation from clean zero to non-zero
+	SstoreInitRefundEIP2200  uint64 = 19200 // Once per SSTORE operation for resetting to the original zero value
+	SstoreCleanGasEIP2200    uint64 = 5000  // Once per SSTORE operation from clean non-zero to something else
+	SstoreCleanRefundEIP2200 uint64 = 4200  // Once per SSTORE operation for resetting to the original non-zero value
+	SstoreClearRefundEIP2200 uint64 = 15000 // Once per SSTORE operation for clearing an originally existing storage slot
+	SelfBalanceGas           uint64 = 5     // Once per SELFBALANCE operation.
+
 	// Fee for Service Chain
 	// TODO-Klaytn-ServiceChain The following parameters should be fixed.
 	// TODO-Klaytn-Governance The following parameters should be able to be modified by governance.
@@ -82,6 +92,7 @@ const (
 	VMLogPerByteGas         uint64 = 20     // Per-byte price for a VMLOG operation
 	FeePayerGas             uint64 = 300    // Gas needed for calculating the fee payer of the transaction in a smart contract.
 	ValidateSenderGas       uint64 = 5000   // Gas needed for validating the signature of a message.
+	Blake2bFRoundGas        uint64 = 1      // Per-round price for a BLAKE2b F compression operation
 
 	GasLimitBoundDivisor uint64 = 1024    // The bound divisor of the gas limit, used in update calculations.
 	MinGasLimit          uint64 = 5000    // Minimum the gas limit may ever be.
@@ -137,8 +148,8 @@ var (
 // Parameters for execution time limit
 var (
 	// TODO-Klaytn Determine more practical values through actual running experience
-	TotalTimeLimit             = 250 * time.Millisecond // Execution time limit for all txs in a block
-	OpcodeComputationCostLimit = uint64(100000000)      // Computation cost limit for a tx. For now, it is approximately 100 ms.
+	TotalTimeLimit             = 250 * time.Millisecond      // Execution time limit for all txs in a block
+	OpcodeComputationCostLimit = DefaultComputationCostLimit // Computation cost limit for a tx unless it is set by the governance. For now, it is approximately 100 ms.
 )
 
 // istanbul BFT
@@ -196,7 +196,9 @@ func (ac *addressBookConnector) getStakingInfoFromAddressBook(blockNum uint64) (
 		} else {
 			logger.Error("Fail while parsing a result from the addressBook. Use empty staking info", "err", err)
 		}
-		return newEmptyStakingInfo(blockNum), nil
+		stakingInfo := newEmptyStakingInfo(blockNum)
+		stakingInfo.BlockHash = intervalBlock.Hash()
+		return stakingInfo, nil
 	}
 
 	return newStakingInfo(ac.bc, ac.gh, blockNum, nodeAddrs, stakingAddrs, rewardAddrs, KIRAddr, PoCAddr)
@@ -42,11 +42,15 @@ StakingInfo is made every 86400 blocks (stakingInterval) and used in a next inte
 		UseGini               bool             // configure whether Gini is used or not
 		Gini                  float64          // Gini coefficient
 		CouncilStakingAmounts []uint64         // StakingAmounts of Council. They are derived from Staking addresses of council
+		BlockHash             common.Hash      // Hash of the block where the stakingInfo is made
 	}
 
 StakingInfo is managed by a StakingManager which has a cache for saving StakingInfos.
 The StakingManager calculates block number with interval to find a stakingInfo for current block
 and returns correct stakingInfo to use.
+StakingInfos are also stored in the database. A stakingInfo which is broken or made from a block
+no longer in the canonical chain is not used, and it is made again from the state of the block.
+governance_getStakingInfo returns the stakingInfo of a block with the Gini coefficient and the voting weights of the council.
 
 
  related struct
@@ -74,9 +78,16 @@ A block reward is calculated by following steps.
 First, calculate totalReward by adding mintingAmount and totalTxFee (unitPrice * gasUsed).
 Second, divide totalReward by ratio (default 34/54/12 - proposer/PoC/KIR).
 Last, distribute reward to each address (proposer, PoC, KIR).
+If the staking reward is enabled by governance after the staking reward fork, the CN reward is divided among the council members
+in proportion to their staking amounts instead of being given to the proposer. Members staking less than the minimum stake are excluded,
+and the Gini coefficient is reflected to the staking amounts if it is used.
+
+The reward of a block is calculated as a RewardSpec before it is distributed.
+The same RewardSpec is returned by klay_getRewards, so that the reported reward never differs from the distributed one.
 
  related struct
  - RewardDistributor
+ - RewardSpec
  - rewardConfigCache
 */
 package reward
@@ -43,6 +43,8 @@ type rewardConfig struct {
 	kirRatio      *big.Int
 	totalRatio    *big.Int
 	unitPrice     *big.Int
+	burnRatio     *big.Int
+	minimumStake  *big.Int
 }
 
 // Cache for parsed reward parameters from governance
@@ -126,6 +128,17 @@ func (rewardConfigCache *rewardConfigCache) newRewardConfig(blockNumber uint64)
 	}
 	unitPrice.SetUint64(result.(uint64))
 
+	// A network started before the dynamic fee mode may not have the burn ratio in governance.
+	burnRatio := big.NewInt(0).SetUint64(params.DefaultBurnRatio)
+	if result, err = rewardConfigCache.governanceHelper.GetItemAtNumberByIntKey(blockNumber, params.BurnRatio); err == nil {
+		burnRatio.SetUint64(result.(uint64))
+	}
+
+	minimumStake := big.NewInt(0)
+	if result, err = rewardConfigCache.governanceHelper.GetItemAtNumberByIntKey(blockNumber, params.MinimumStake); err == nil {
+		minimumStake.SetString(result.(string), 10)
+	}
+
 	rewardConfig := &rewardConfig{
 		blockNum:      blockNumber,
 		mintingAmount: mintingAmount,
@@ -134,6 +147,8 @@ func (rewardConfigCache *rewardConfigCache) newRewardConfig(blockNumber uint64)
 		kirRatio:      kirRatio,
 		totalRatio:    totalRatio,
 		unitPrice:     unitPrice,
+		burnRatio:     burnRatio,
+		minimumStake:  minimumStake,
 	}
 	return rewardConfig, nil
 }
@@ -33,6 +33,8 @@ type testGovernance struct {
 	policy          uint64
 	stakingInterval uint64
 	deferredTxFee   bool
+	minimumStake    string
+	stakingReward   bool
 }
 
 func newDefaultTestGovernance() *testGovernance {
@@ -74,6 +76,11 @@ func (governance *testGovernance) GetItemAtNumberByIntKey(num uint64, key int) (
 		return governance.unitPrice, nil
 	case params.Epoch:
 		return governance.epoch, nil
+	case params.MinimumStake:
+		if governance.minimumStake == "" {
+			return nil, errors.New("Unhandled key on testGovernance")
+		}
+		return governance.minimumStake, nil
 	default:
 		return nil, errors.New("Unhandled key on testGovernance")
 	}
@@ -91,6 +98,10 @@ func (governance *testGovernance) StakingUpdateInterval() uint64 {
 	return governance.stakingInterval
 }
 
+func (governance *testGovernance) StakingReward(num uint64) bool {
+	return governance.stakingReward
+}
+
 func (governance *testGovernance) setTestGovernance(epoch uint64, mintingAmount string, ratio string, unitprice uint64, useGiniCoeff bool, deferredTxFee bool) {
 	governance.epoch = epoch
 	governance.mintingAmount = mintingAmount
@@ -17,9 +17,11 @@
 package reward
 
 import (
+	"fmt"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/log"
+	"math"
 	"math/big"
 )
 
@@ -35,6 +37,7 @@ type governanceHelper interface {
 	DeferredTxFee() bool
 	ProposerPolicy() uint64
 	StakingUpdateInterval() uint64
+	StakingReward(num uint64) bool
 }
 
 func isEmptyAddress(addr common.Address) bool {
@@ -54,45 +57,187 @@ func NewRewardDistributor(gh governanceHelper) *RewardDistributor {
 }
 
 // getTotalTxFee returns the total transaction gas fee of the block.
+// After the dynamic fee fork, transactions are charged at the base fee of the block instead of the unit price.
 func (rd *RewardDistributor) getTotalTxFee(header *types.Header, rewardConfig *rewardConfig) *big.Int {
+	gasPrice := rewardConfig.unitPrice
+	if header.BaseFee != nil {
+		gasPrice = header.BaseFee
+	}
 	totalGasUsed := big.NewInt(0).SetUint64(header.GasUsed)
-	totalTxFee := totalGasUsed.Mul(totalGasUsed, rewardConfig.unitPrice)
+	totalTxFee := totalGasUsed.Mul(totalGasUsed, gasPrice)
 	return totalTxFee
 }
 
+// getRewardedTxFee returns the part of the total transaction gas fee given as reward.
+// After the dynamic fee fork, the part of the fee decided by the burn ratio is burned.
+func (rd *RewardDistributor) getRewardedTxFee(header *types.Header, rewardConfig *rewardConfig) *big.Int {
+	totalTxFee := rd.getTotalTxFee(header, rewardConfig)
+	if header.BaseFee == nil {
+		return totalTxFee
+	}
+	burnedTxFee := big.NewInt(0).Mul(totalTxFee, rewardConfig.burnRatio)
+	burnedTxFee.Div(burnedTxFee, big.NewInt(100))
+
+	logger.Debug("Burn tx fee", "blockNumber", header.Number.Uint64(), "total tx fee", totalTxFee, "burned tx fee", burnedTxFee)
+	return totalTxFee.Sub(totalTxFee, burnedTxFee)
+}
+
+// RewardSpec is the breakdown of the block reward of a block.
+type RewardSpec struct {
+	Minted   *big.Int                    `json:"minted"`   // The amount newly minted
+	TotalFee *big.Int                    `json:"totalFee"` // The total tx fee spent in the block
+	BurntFee *big.Int                    `json:"burntFee"` // The amount of the tx fee burnt
+	Proposer *big.Int                    `json:"proposer"` // The amount given to the proposer
+	Stakers  *big.Int                    `json:"stakers"`  // The amount given to the staked council members
+	PoC      *big.Int                    `json:"poc"`      // The amount given to the PoC
+	KIR      *big.Int                    `json:"kir"`      // The amount given to the KIR
+	Rewards  map[common.Address]*big.Int `json:"rewards"`  // The amount given to each recipient
+
+	RewardConfig *RewardConfig `json:"rewardConfig"`
+}
+
+// RewardConfig is the reward parameters used to calculate the block reward of a block.
+type RewardConfig struct {
+	BlockNum      uint64   `json:"blockNum"` // The block where the parameters are read
+	MintingAmount *big.Int `json:"mintingAmount"`
+	Ratio         string   `json:"ratio"`
+	UnitPrice     *big.Int `json:"unitPrice"`
+	BurnRatio     *big.Int `json:"burnRatio"`
+	DeferredTxFee bool     `json:"deferredTxFee"`
+}
+
+// NewEmptyRewardSpec returns a reward spec to accumulate the rewards of blocks.
+func NewEmptyRewardSpec() *RewardSpec {
+	return &RewardSpec{
+		Minted:   big.NewInt(0),
+		TotalFee: big.NewInt(0),
+		BurntFee: big.NewInt(0),
+		Proposer: big.NewInt(0),
+		Stakers:  big.NewInt(0),
+		PoC:      big.NewInt(0),
+		KIR:      big.NewInt(0),
+		Rewards:  make(map[common.Address]*big.Int),
+	}
+}
+
+func newRewardSpec(rewardConfig *rewardConfig, deferredTxFee bool) *RewardSpec {
+	spec := NewEmptyRewardSpec()
+	spec.RewardConfig = &RewardConfig{
+		BlockNum:      rewardConfig.blockNum,
+		MintingAmount: new(big.Int).Set(rewardConfig.mintingAmount),
+		Ratio:         fmt.Sprintf("%v/%v/%v", rewardConfig.cnRatio, rewardConfig.pocRatio, rewardConfig.kirRatio),
+		UnitPrice:     new(big.Int).Set(rewardConfig.unitPrice),
+		BurnRatio:     new(big.Int).Set(rewardConfig.burnRatio),
+		DeferredTxFee: deferredTxFee,
+	}
+	return spec
+}
+
+// addReward adds the amount to the reward of the recipient.
+func (spec *RewardSpec) addReward(addr common.Address, amount *big.Int) {
+	if reward, ok := spec.Rewards[addr]; ok {
+		spec.Rewards[addr] = new(big.Int).Add(reward, amount)
+	} else {
+		spec.Rewards[addr] = new(big.Int).Set(amount)
+	}
+}
+
+// Add accumulates the rewards of another block. The reward config is not accumulated.
+func (spec *RewardSpec) Add(other *RewardSpec) {
+	spec.Minted.Add(spec.Minted, other.Minted)
+	spec.TotalFee.Add(spec.TotalFee, other.TotalFee)
+	spec.BurntFee.Add(spec.BurntFee, other.BurntFee)
+	spec.Proposer.Add(spec.Proposer, other.Proposer)
+	spec.Stakers.Add(spec.Stakers, other.Stakers)
+	spec.PoC.Add(spec.PoC, other.PoC)
+	spec.KIR.Add(spec.KIR, other.KIR)
+	for addr, amount := range other.Rewards {
+		spec.addReward(addr, amount)
+	}
+}
+
+// Distribute gives the rewards to the recipients.
+func (spec *RewardSpec) Distribute(b BalanceAdder) {
+	for addr, amount := range spec.Rewards {
+		b.AddBalance(addr, amount)
+	}
+}
+
 // MintKLAY mints KLAY and gives the KLAY and the total transaction gas fee to the block proposer.
 func (rd *RewardDistributor) MintKLAY(b BalanceAdder, header *types.Header) error {
-	rewardConfig, err := rd.rcc.get(header.Number.Uint64())
+	spec, err := rd.CalcMintKLAY(header)
 	if err != nil {
 		return err
 	}
+	spec.Distribute(b)
+	return nil
+}
+
+// CalcMintKLAY returns the block reward given by MintKLAY.
+func (rd *RewardDistributor) CalcMintKLAY(header *types.Header) (*RewardSpec, error) {
+	rewardConfig, err := rd.rcc.get(header.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	spec := newRewardSpec(rewardConfig, rd.gh.DeferredTxFee())
+	spec.TotalFee = rd.getTotalTxFee(header, rewardConfig)
+	totalTxFee := rd.getRewardedTxFee(header, rewardConfig)
+	spec.BurntFee = new(big.Int).Sub(spec.TotalFee, totalTxFee)
 
-	totalTxFee := rd.getTotalTxFee(header, rewardConfig)
 	blockReward := totalTxFee.Add(rewardConfig.mintingAmount, totalTxFee)
 
-	b.AddBalance(header.Rewardbase, blockReward)
+	spec.Minted = new(big.Int).Set(rewardConfig.mintingAmount)
+	spec.Proposer = blockReward
+	spec.addReward(header.Rewardbase, blockReward)
+	return spec, nil
+}
+
+// DistributeBlockReward distributes block reward to proposer, KIR and PoC of the staking information.
+// If the staking reward is enabled, the CN reward is distributed to the staked council members instead of the proposer.
+func (rd *RewardDistributor) DistributeBlockReward(b BalanceAdder, header *types.Header, stakingInfo *StakingInfo) error {
+	spec, err := rd.CalcBlockReward(header, stakingInfo)
+	if err != nil {
+		return err
+	}
+	spec.Distribute(b)
 	return nil
 }
 
-// DistributeBlockReward distributes block reward to proposer, kirAddr and pocAddr.
-func (rd *RewardDistributor) DistributeBlockReward(b BalanceAdder, header *types.Header, pocAddr common.Address, kirAddr common.Address) error {
+// CalcBlockReward returns the block reward distributed by DistributeBlockReward.
+func (rd *RewardDistributor) CalcBlockReward(header *types.Header, stakingInfo *StakingInfo) (*RewardSpec, error) {
 	rewardConfig, err := rd.rcc.get(header.Number.Uint64())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Calculate total tx fee
+	spec := newRewardSpec(rewardConfig, rd.gh.DeferredTxFee())
+	spec.TotalFee = rd.getTotalTxFee(header, rewardConfig)
+
+	// Calculate total tx fee. After the dynamic fee fork, tx fee is always distributed here.
+	// Otherwise, the tx fee is given to the proposer when each transaction is executed, if it is not deferred.
 	totalTxFee := common.Big0
-	if rd.gh.DeferredTxFee() {
-		totalTxFee = rd.getTotalTxFee(header, rewardConfig)
+	if rd.gh.DeferredTxFee() || header.BaseFee != nil {
+		totalTxFee = rd.getRewardedTxFee(header, rewardConfig)
+		spec.BurntFee = new(big.Int).Sub(spec.TotalFee, totalTxFee)
 	}
 
-	rd.distributeBlockReward(b, header, totalTxFee, rewardConfig, pocAddr, kirAddr)
-	return nil
+	pocAddr := common.Address{}
+	kirAddr := common.Address{}
+	if stakingInfo != nil {
+		pocAddr = stakingInfo.PoCAddr
+		kirAddr = stakingInfo.KIRAddr
+	}
+	rd.distributeBlockReward(spec, header, totalTxFee, rewardConfig, pocAddr, kirAddr)
+
+	if stakingInfo != nil && rd.gh.StakingReward(header.Number.Uint64()) {
+		rd.distributeStakingReward(spec, header, rewardConfig, stakingInfo)
+	}
+	return spec, nil
 }
 
 // distributeBlockReward mints KLAY and distributes newly minted KLAY and transaction fee to proposer, kirAddr and pocAddr.
-func (rd *RewardDistributor) distributeBlockReward(b BalanceAdder, header *types.Header, totalTxFee *big.Int, rewardConfig *rewardConfig, pocAddr common.Address, kirAddr common.Address) {
+func (rd *RewardDistributor) distributeBlockReward(spec *RewardSpec, header *types.Header, totalTxFee *big.Int, rewardConfig *rewardConfig, pocAddr common.Address, kirAddr common.Address) {
 	proposer := header.Rewardbase
 	// Block reward
 	blockReward := big.NewInt(0).Add(rewardConfig.mintingAmount, totalTxFee)
@@ -113,24 +258,108 @@ func (rd *RewardDistributor) distributeBlockReward(b BalanceAdder, header *types
 	remaining = tmpInt.Sub(remaining, kirIncentive)
 	pocIncentive = pocIncentive.Add(pocIncentive, remaining)
 
+	spec.Minted = new(big.Int).Set(rewardConfig.mintingAmount)
+	spec.Proposer = cnReward
+	spec.PoC = pocIncentive
+	spec.KIR = kirIncentive
+
 	// CN reward
-	b.AddBalance(proposer, cnReward)
+	spec.addReward(proposer, cnReward)
 
 	// Proposer gets PoC incentive and KIR incentive, if there is no PoC/KIR address.
 	// PoC
 	if isEmptyAddress(pocAddr) {
 		pocAddr = proposer
 	}
-	b.AddBalance(pocAddr, pocIncentive)
+	spec.addReward(pocAddr, pocIncentive)
 
 	// KIR
 	if isEmptyAddress(kirAddr) {
 		kirAddr = proposer
 	}
-	b.AddBalance(kirAddr, kirIncentive)
+	spec.addReward(kirAddr, kirIncentive)
 
 	logger.Debug("Block reward", "blockNumber", header.Number.Uint64(),
 		"Reward address of a proposer", proposer, "CN reward amount", cnReward,
 		"PoC address", pocAddr, "Poc incentive", pocIncentive,
 		"KIR address", kirAddr, "KIR incentive", kirIncentive)
 }
+
+// distributeStakingReward redistributes the CN reward given to the proposer to the staked council members
+// in proportion to their staking amounts. The remainder of the division stays with the proposer.
+func (rd *RewardDistributor) distributeStakingReward(spec *RewardSpec, header *types.Header, rewardConfig *rewardConfig, stakingInfo *StakingInfo) {
+	rewardAddrs, weights := calcStakingWeights(stakingInfo, rewardConfig.minimumStake)
+
+	totalWeight := big.NewInt(0)
+	for _, weight := range weights {
+		totalWeight.Add(totalWeight, weight)
+	}
+	if totalWeight.Sign() == 0 {
+		logger.Debug("No staked council member to get the CN reward", "blockNumber", header.Number.Uint64())
+		return
+	}
+
+	proposer := header.Rewardbase
+	cnReward := spec.Proposer
+	remaining := new(big.Int).Set(cnReward)
+	for i, addr := range rewardAddrs {
+		stakerReward := new(big.Int).Mul(cnReward, weights[i])
+		stakerReward.Div(stakerReward, totalWeight)
+		if stakerReward.Sign() == 0 {
+			continue
+		}
+		spec.addReward(addr, stakerReward)
+		remaining.Sub(remaining, stakerReward)
+	}
+
+	// The CN reward has already been added to the proposer's reward, so only the remainder is left with it.
+	spec.Rewards[proposer].Sub(spec.Rewards[proposer], new(big.Int).Sub(cnReward, remaining))
+	if spec.Rewards[proposer].Sign() == 0 {
+		delete(spec.Rewards, proposer)
+	}
+	spec.Stakers = new(big.Int).Sub(cnReward, remaining)
+	spec.Proposer = remaining
+
+	logger.Debug("Staking reward", "blockNumber", header.Number.Uint64(), "CN reward amount", cnReward,
+		"stakers", len(rewardAddrs), "stakers reward", spec.Stakers, "proposer reward", spec.Proposer)
+}
+
+// calcStakingWeights returns the reward addresses of the council members eligible for the staking reward and their weights.
+// The staking amounts of the same reward address are summed up, and the sums less than the minimum stake or zero are excluded.
+// If UseGini is set, the gini coefficient is reflected to the weights as in the proposer selection.
+func calcStakingWeights(stakingInfo *StakingInfo, minimumStake *big.Int) ([]common.Address, []*big.Int) {
+	var addrs []common.Address
+	amounts := make(map[common.Address]uint64)
+	for i, addr := range stakingInfo.CouncilRewardAddrs {
+		if i >= len(stakingInfo.CouncilStakingAmounts) {
+			break
+		}
+		if _, ok := amounts[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		amounts[addr] += stakingInfo.CouncilStakingAmounts[i]
+	}
+
+	var rewardAddrs []common.Address
+	var stakingAmounts []float64
+	for _, addr := range addrs {
+		if isEmptyAddress(addr) || amounts[addr] == 0 || new(big.Int).SetUint64(amounts[addr]).Cmp(minimumStake) < 0 {
+			continue
+		}
+		rewardAddrs = append(rewardAddrs, addr)
+		stakingAmounts = append(stakingAmounts, float64(amounts[addr]))
+	}
+
+	if stakingInfo.UseGini && len(stakingAmounts) > 0 {
+		gini := CalcGiniCoefficient(append(float64Slice{}, stakingAmounts...))
+		for i := range stakingAmounts {
+			stakingAmounts[i] = math.Round(math.Pow(stakingAmounts[i], 1.0/(1+gini)))
+		}
+	}
+
+	weights := make([]*big.Int, len(stakingAmounts))
+	for i, amount := range stakingAmounts {
+		weights[i] = new(big.Int).SetUint64(uint64(amount))
+	}
+	return rewardAddrs, weights
+}
@@ -107,6 +107,106 @@ func TestRewardDistributor_getTotalTxFee(t *testing.T) {
 	}
 }
 
+func TestRewardDistributor_getRewardedTxFee(t *testing.T) {
+	testCases := []struct {
+		gasUsed          uint64
+		baseFee          *big.Int
+		burnRatio        uint64
+		expectedRewarded *big.Int
+	}{
+		{200000, nil, 50, big.NewInt(5000000000000000)}, // unit price is used before the dynamic fee fork
+		{200000, big.NewInt(50000000000), 0, big.NewInt(10000000000000000)},
+		{200000, big.NewInt(50000000000), 50, big.NewInt(5000000000000000)},
+		{200000, big.NewInt(50000000000), 30, big.NewInt(7000000000000000)},
+		{200000, big.NewInt(50000000000), 100, big.NewInt(0)},
+		{3, big.NewInt(1), 50, big.NewInt(2)},
+	}
+	rewardDistributor := NewRewardDistributor(newDefaultTestGovernance())
+
+	for _, testCase := range testCases {
+		header := &types.Header{Number: big.NewInt(1), GasUsed: testCase.gasUsed, BaseFee: testCase.baseFee}
+		rewardConfig := &rewardConfig{
+			unitPrice: big.NewInt(25000000000),
+			burnRatio: big.NewInt(0).SetUint64(testCase.burnRatio),
+		}
+
+		result := rewardDistributor.getRewardedTxFee(header, rewardConfig)
+
+		assert.Equal(t, testCase.expectedRewarded.String(), result.String())
+	}
+}
+
+func TestRewardDistributor_DistributeBlockRewardWithBaseFee(t *testing.T) {
+	BalanceAdder := newTestBalanceAdder()
+	header := &types.Header{
+		Number:     big.NewInt(1),
+		GasUsed:    200000,
+		BaseFee:    big.NewInt(50000000000),
+		Rewardbase: common.StringToAddress("0x1552F52D459B713E0C4558e66C8c773a75615FA8"),
+	}
+	governance := newDefaultTestGovernance()
+	governance.deferredTxFee = false
+	governance.mintingAmount = "0"
+	governance.ratio = "100/0/0"
+	rewardDistributor := NewRewardDistributor(governance)
+
+	// The tx fee is distributed even if it is not deferred, and half of it is burned by default.
+	err := rewardDistributor.DistributeBlockReward(BalanceAdder, header, nil)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "5000000000000000", BalanceAdder.GetBalance(header.Rewardbase).String())
+}
+
+func TestRewardDistributor_CalcBlockReward(t *testing.T) {
+	header := &types.Header{
+		Number:     big.NewInt(1),
+		GasUsed:    200000,
+		BaseFee:    big.NewInt(50000000000),
+		Rewardbase: common.StringToAddress("0x1552F52D459B713E0C4558e66C8c773a75615FA8"),
+	}
+	kirAddress := common.StringToAddress("0xd38A08AD21B44681f5e75D0a3CA4793f3E6c03e7")
+	governance := newDefaultTestGovernance()
+	governance.mintingAmount = "9600000000000000000"
+	governance.ratio = "34/54/12"
+	rewardDistributor := NewRewardDistributor(governance)
+
+	spec, err := rewardDistributor.CalcBlockReward(header, &StakingInfo{KIRAddr: kirAddress})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "9600000000000000000", spec.Minted.String())
+	assert.Equal(t, "10000000000000000", spec.TotalFee.String())
+	assert.Equal(t, "5000000000000000", spec.BurntFee.String())
+	assert.Equal(t, "34/54/12", spec.RewardConfig.Ratio)
+
+	// The rewards are the minted amount and the tx fee not burnt
+	total := new(big.Int).Add(spec.Proposer, spec.PoC)
+	total.Add(total, spec.KIR)
+	assert.Equal(t, new(big.Int).Sub(new(big.Int).Add(spec.Minted, spec.TotalFee), spec.BurntFee), total)
+
+	// The proposer gets the PoC incentive if there is no PoC address
+	assert.Equal(t, new(big.Int).Add(spec.Proposer, spec.PoC), spec.Rewards[header.Rewardbase])
+	assert.Equal(t, spec.KIR, spec.Rewards[kirAddress])
+	assert.Equal(t, 2, len(spec.Rewards))
+
+	// The rewards are the same as the distributed ones
+	BalanceAdder := newTestBalanceAdder()
+	assert.NoError(t, rewardDistributor.DistributeBlockReward(BalanceAdder, header, &StakingInfo{KIRAddr: kirAddress}))
+	for addr, amount := range spec.Rewards {
+		assert.Equal(t, amount, BalanceAdder.GetBalance(addr))
+	}
+
+	// The rewards of blocks are accumulated without changing the ones of each block
+	kir := new(big.Int).Set(spec.KIR)
+	accumulated := NewEmptyRewardSpec()
+	accumulated.Add(spec)
+	accumulated.Add(spec)
+	assert.Equal(t, new(big.Int).Mul(spec.Minted, big.NewInt(2)), accumulated.Minted)
+	assert.Equal(t, new(big.Int).Mul(spec.KIR, big.NewInt(2)), accumulated.Rewards[kirAddress])
+	assert.Equal(t, kir, spec.Rewards[kirAddress])
+}
+
 func TestRewardDistributor_MintKLAY(t *testing.T) {
 	BalanceAdder := newTestBalanceAdder()
 	header := &types.Header{}
@@ -174,7 +274,9 @@ func TestRewardDistributor_distributeBlockReward(t *testing.T) {
 	for _, testCase := range testCases {
 		BalanceAdder := newTestBalanceAdder()
 		rewardDistributor := NewRewardDistributor(governance)
-		rewardDistributor.distributeBlockReward(BalanceAdder, header, testCase.totalTxFee, testCase.rewardConfig, pocAddress, kirAddress)
+		spec := NewEmptyRewardSpec()
+		rewardDistributor.distributeBlockReward(spec, header, testCase.totalTxFee, testCase.rewardConfig, pocAddress, kirAddress)
+		spec.Distribute(BalanceAdder)
 
 		assert.Equal(t, testCase.expectedCnBalance.Uint64(), BalanceAdder.GetBalance(header.Rewardbase).Uint64())
 		assert.Equal(t, testCase.expectedPocBalance.Uint64(), BalanceAdder.GetBalance(pocAddress).Uint64())
@@ -246,7 +348,7 @@ func TestRewardDistributor_DistributeBlockReward(t *testing.T) {
 		header.GasUsed = testCase.gasUsed
 		rewardDistributor := NewRewardDistributor(governance)
 
-		err := rewardDistributor.DistributeBlockReward(BalanceAdder, header, pocAddress, kirAddress)
+		err := rewardDistributor.DistributeBlockReward(BalanceAdder, header, &StakingInfo{PoCAddr: pocAddress, KIRAddr: kirAddress})
 		if !assert.NoError(t, err) {
 			t.FailNow()
 		}
@@ -257,3 +359,215 @@ func TestRewardDistributor_DistributeBlockReward(t *testing.T) {
 		assert.Equal(t, testCase.expectedKirBalance.Uint64(), BalanceAdder.GetBalance(kirAddress).Uint64())
 	}
 }
+
+func TestRewardDistributor_StakingReward(t *testing.T) {
+	proposer := common.StringToAddress("0x1552F52D459B713E0C4558e66C8c773a75615FA8")
+	rewardAddrs := []common.Address{
+		common.StringToAddress("0x1"),
+		common.StringToAddress("0x2"),
+		common.StringToAddress("0x3"),
+	}
+	header := &types.Header{
+		Number:     big.NewInt(1),
+		Rewardbase: proposer,
+	}
+	governance := newDefaultTestGovernance()
+	governance.mintingAmount = "1000000"
+	governance.ratio = "100/0/0"
+	governance.minimumStake = "5000000"
+	governance.stakingReward = true
+
+	testCases := []struct {
+		amounts          []uint64
+		useGini          bool
+		expectedProposer uint64
+		expectedStakers  []uint64
+	}{
+		// Proportional to the staking amounts
+		{[]uint64{5000000, 15000000, 20000000}, false, 0, []uint64{125000, 375000, 500000}},
+		// The remainder of the division is given to the proposer
+		{[]uint64{10000000, 10000000, 10000000}, false, 1, []uint64{333333, 333333, 333333}},
+		// A member staking less than the minimum stake is excluded
+		{[]uint64{4999999, 10000000, 30000000}, false, 0, []uint64{0, 250000, 750000}},
+		// The gini coefficient narrows the gap between the members
+		{[]uint64{5000000, 15000000, 20000000}, true, 2, []uint64{155287, 373967, 470744}},
+		// The proposer gets the CN reward if no member is eligible
+		{[]uint64{1, 2, 3}, false, 1000000, []uint64{0, 0, 0}},
+	}
+
+	for i, testCase := range testCases {
+		stakingInfo := &StakingInfo{
+			CouncilRewardAddrs:    rewardAddrs,
+			CouncilStakingAmounts: testCase.amounts,
+			UseGini:               testCase.useGini,
+		}
+		spec, err := NewRewardDistributor(governance).CalcBlockReward(header, stakingInfo)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		assert.Equal(t, testCase.expectedProposer, spec.Proposer.Uint64(), "test case %d", i)
+		assert.Equal(t, big.NewInt(1000000), new(big.Int).Add(spec.Proposer, spec.Stakers), "test case %d", i)
+		for j, addr := range rewardAddrs {
+			stakerReward := uint64(0)
+			if amount, ok := spec.Rewards[addr]; ok {
+				stakerReward = amount.Uint64()
+			}
+			assert.Equal(t, testCase.expectedStakers[j], stakerReward, "test case %d, staker %d", i, j)
+		}
+	}
+
+	// The staking amounts of the same reward address are summed up
+	stakingInfo := &StakingInfo{
+		CouncilRewardAddrs:    []common.Address{rewardAddrs[0], rewardAddrs[1], rewardAddrs[0]},
+		CouncilStakingAmounts: []uint64{3000000, 5000000, 2000000},
+	}
+	spec, err := NewRewardDistributor(governance).CalcBlockReward(header, stakingInfo)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, uint64(500000), spec.Rewards[rewardAddrs[0]].Uint64())
+	assert.Equal(t, uint64(500000), spec.Rewards[rewardAddrs[1]].Uint64())
+	assert.Nil(t, spec.Rewards[proposer])
+
+	// The proposer gets the whole CN reward if the staking reward is disabled
+	governance.stakingReward = false
+	spec, err = NewRewardDistributor(governance).CalcBlockReward(header, stakingInfo)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, uint64(1000000), spec.Rewards[proposer].Uint64())
+	assert.Equal(t, uint64(0), spec.Stakers.Uint64())
+	assert.Equal(t, 1, len(spec.Rewards))
+}
+
+func TestRewardDistributor_calcStakingWeights(t *testing.T) {
+	addr1 := common.StringToAddress("0x1")
+	addr2 := common.StringToAddress("0x2")
+	addr3 := common.StringToAddress("0x3")
+	minimumStake := big.NewInt(5000000)
+
+	testCases := []struct {
+		addrs           []common.Address
+		amounts         []uint64
+		useGini         bool
+		expectedAddrs   []common.Address
+		expectedWeights []uint64
+	}{
+		// The staking amounts are the weights without the gini coefficient
+		{[]common.Address{addr1, addr2, addr3}, []uint64{5000000, 15000000, 20000000}, false, []common.Address{addr1, addr2, addr3}, []uint64{5000000, 15000000, 20000000}},
+		// The gini coefficient (0.25) is reflected to the weights
+		{[]common.Address{addr1, addr2, addr3}, []uint64{5000000, 15000000, 20000000}, true, []common.Address{addr1, addr2, addr3}, []uint64{228653, 550647, 693145}},
+		// A sum equal to the minimum stake is eligible, and a sum less than it is not
+		{[]common.Address{addr1, addr2, addr3}, []uint64{4999999, 5000000, 20000000}, false, []common.Address{addr2, addr3}, []uint64{5000000, 20000000}},
+		// The gini coefficient is calculated only with the eligible amounts
+		{[]common.Address{addr1, addr2, addr3}, []uint64{4999999, 5000000, 20000000}, true, []common.Address{addr2, addr3}, []uint64{142251, 413216}},
+		// The amounts of the same reward address are summed up before comparing with the minimum stake
+		{[]common.Address{addr1, addr2, addr1}, []uint64{2000000, 4999999, 3000000}, false, []common.Address{addr1}, []uint64{5000000}},
+		// An empty reward address and a zero amount are excluded
+		{[]common.Address{{}, addr2, addr3}, []uint64{10000000, 10000000, 0}, false, []common.Address{addr2}, []uint64{10000000}},
+		// Nothing is eligible
+		{[]common.Address{addr1, addr2}, []uint64{1, 2}, true, nil, []uint64{}},
+	}
+
+	for i, testCase := range testCases {
+		stakingInfo := &StakingInfo{
+			CouncilRewardAddrs:    testCase.addrs,
+			CouncilStakingAmounts: testCase.amounts,
+			UseGini:               testCase.useGini,
+		}
+		addrs, weights := calcStakingWeights(stakingInfo, minimumStake)
+
+		assert.Equal(t, testCase.expectedAddrs, addrs, "test case %d", i)
+		actualWeights := make([]uint64, len(weights))
+		for j, weight := range weights {
+			actualWeights[j] = weight.Uint64()
+		}
+		assert.Equal(t, testCase.expectedWeights, actualWeights, "test case %d", i)
+	}
+}
+
+func TestRewardDistributor_distributeStakingReward(t *testing.T) {
+	proposer := common.StringToAddress("0x1552F52D459B713E0C4558e66C8c773a75615FA8")
+	addr1 := common.StringToAddress("0x1")
+	addr2 := common.StringToAddress("0x2")
+	addr3 := common.StringToAddress("0x3")
+	header := &types.Header{
+		Number:     big.NewInt(1),
+		Rewardbase: proposer,
+	}
+	governance := newDefaultTestGovernance()
+	governance.mintingAmount = "1000000"
+	governance.ratio = "100/0/0"
+	governance.minimumStake = "5000000"
+	governance.stakingReward = true
+
+	testCases := []struct {
+		addrs            []common.Address
+		amounts          []uint64
+		useGini          bool
+		expectedProposer uint64
+		expectedRewards  map[common.Address]uint64
+	}{
+		// Gini off: the remainder of the division is left with the proposer
+		{
+			[]common.Address{addr1, addr2, addr3}, []uint64{10000000, 10000000, 10000000}, false, 1,
+			map[common.Address]uint64{addr1: 333333, addr2: 333333, addr3: 333333, proposer: 1},
+		},
+		// Gini on: the weights are narrowed by the gini coefficient
+		{
+			[]common.Address{addr1, addr2, addr3}, []uint64{5000000, 15000000, 20000000}, true, 2,
+			map[common.Address]uint64{addr1: 155287, addr2: 373967, addr3: 470744, proposer: 2},
+		},
+		// Gini off: exactly the minimum stake is eligible and one peb less is not
+		{
+			[]common.Address{addr1, addr2, addr3}, []uint64{4999999, 5000000, 20000000}, false, 0,
+			map[common.Address]uint64{addr2: 200000, addr3: 800000},
+		},
+		// Gini on: the ineligible member is not counted in the gini coefficient
+		{
+			[]common.Address{addr1, addr2, addr3}, []uint64{4999999, 5000000, 20000000}, true, 1,
+			map[common.Address]uint64{addr2: 256092, addr3: 743907, proposer: 1},
+		},
+		// Gini off: the proposer staking with its own reward address gets its share and the remainder
+		{
+			[]common.Address{proposer, addr2, addr3}, []uint64{10000000, 10000000, 10000000}, false, 1,
+			map[common.Address]uint64{proposer: 333334, addr2: 333333, addr3: 333333},
+		},
+		// Gini on: the proposer staking with its own reward address gets its share and the remainder
+		{
+			[]common.Address{proposer, addr2, addr3}, []uint64{5000000, 15000000, 20000000}, true, 2,
+			map[common.Address]uint64{proposer: 155289, addr2: 373967, addr3: 470744},
+		},
+		// The proposer staking less than the minimum stake gets only the remainder
+		{
+			[]common.Address{proposer, addr2, addr3}, []uint64{4999999, 10000000, 20000000}, false, 1,
+			map[common.Address]uint64{addr2: 333333, addr3: 666666, proposer: 1},
+		},
+		// The proposer gets the whole CN reward if no member is eligible
+		{
+			[]common.Address{addr1, addr2, addr3}, []uint64{4999999, 4999999, 0}, true, 1000000,
+			map[common.Address]uint64{proposer: 1000000},
+		},
+	}
+
+	for i, testCase := range testCases {
+		stakingInfo := &StakingInfo{
+			CouncilRewardAddrs:    testCase.addrs,
+			CouncilStakingAmounts: testCase.amounts,
+			UseGini:               testCase.useGini,
+		}
+		spec, err := NewRewardDistributor(governance).CalcBlockReward(header, stakingInfo)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		assert.Equal(t, testCase.expectedProposer, spec.Proposer.Uint64(), "test case %d", i)
+		assert.Equal(t, 1000000-testCase.expectedProposer, spec.Stakers.Uint64(), "test case %d", i)
+		rewards := make(map[common.Address]uint64)
+		for addr, amount := range spec.Rewards {
+			rewards[addr] = amount.Uint64()
+		}
+		assert.Equal(t, testCase.expectedRewards, rewards, "test case %d", i)
+	}
+}
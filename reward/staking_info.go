@@ -37,6 +37,9 @@ var (
 	maxStakingLimitBigInt = big.NewInt(0).SetUint64(maxStakingLimit)
 
 	ErrAddrNotInStakingInfo = errors.New("Address is not in stakingInfo")
+
+	errStakingInfoBlockNumMismatch = errors.New("block number of stakingInfo is different from the requested one")
+	errStakingInfoLengthMismatch   = errors.New("lengths of council information in stakingInfo are different")
 )
 
 // StakingInfo contains staking information.
@@ -55,6 +58,8 @@ type StakingInfo struct {
 
 	// Derived from CouncilStakingAddrs
 	CouncilStakingAmounts []uint64 // Staking amounts of Council
+
+	BlockHash common.Hash // Hash of the block where staking information of Council is fetched
 }
 
 func newEmptyStakingInfo(blockNum uint64) *StakingInfo {
@@ -105,6 +110,7 @@ func newStakingInfo(bc blockChain, helper governanceHelper, blockNum uint64, nod
 
 	stakingInfo := &StakingInfo{
 		BlockNum:              blockNum,
+		BlockHash:             intervalBlock.Hash(),
 		CouncilNodeAddrs:      nodeAddrs,
 		CouncilStakingAddrs:   stakingAddrs,
 		CouncilRewardAddrs:    rewardAddrs,
@@ -117,6 +123,18 @@ func newStakingInfo(bc blockChain, helper governanceHelper, blockNum uint64, nod
 	return stakingInfo, nil
 }
 
+// validate checks the integrity of the staking info fetched at blockNum.
+func (s *StakingInfo) validate(blockNum uint64) error {
+	if s.BlockNum != blockNum {
+		return errStakingInfoBlockNumMismatch
+	}
+	numNodes := len(s.CouncilNodeAddrs)
+	if len(s.CouncilStakingAddrs) != numNodes || len(s.CouncilRewardAddrs) != numNodes || len(s.CouncilStakingAmounts) != numNodes {
+		return errStakingInfoLengthMismatch
+	}
+	return nil
+}
+
 func (s *StakingInfo) GetIndexByNodeAddress(nodeAddress common.Address) (int, error) {
 	for i, addr := range s.CouncilNodeAddrs {
 		if addr == nodeAddress {
@@ -134,6 +152,56 @@ func (s *StakingInfo) GetStakingAmountByNodeId(nodeAddress common.Address) (uint
 	return s.CouncilStakingAmounts[i], nil
 }
 
+// StakingInfoResponse is the staking information with the gini coefficient and the voting weights
+// of the council nodes. They are an estimate from the staking info only, and may differ from
+// the weights of the proposer selection, which are calculated over the validators.
+type StakingInfoResponse struct {
+	StakingInfo
+	EffectiveStakingAmounts []uint64 // Staking amounts of Council where the gini coefficient is reflected, if it is used
+	VotingWeights           []uint64 // Weights of Council in percent
+}
+
+// NewStakingInfoResponse derives the gini coefficient and the voting weights from the staking info.
+// Each council node is weighted by its own staking amount, and the gini coefficient is calculated
+// over the council nodes with non-zero staking amounts. Unlike the proposer selection, the staking
+// amounts sharing a reward address are not merged, and no minimum weight is applied.
+func NewStakingInfoResponse(s *StakingInfo) *StakingInfoResponse {
+	res := &StakingInfoResponse{
+		StakingInfo:             *s,
+		EffectiveStakingAmounts: make([]uint64, len(s.CouncilStakingAmounts)),
+		VotingWeights:           make([]uint64, len(s.CouncilStakingAmounts)),
+	}
+
+	var stakingAmounts float64Slice
+	for _, amount := range s.CouncilStakingAmounts {
+		if amount > 0 {
+			stakingAmounts = append(stakingAmounts, float64(amount))
+		}
+	}
+	res.Gini = DefaultGiniCoefficient
+	if len(stakingAmounts) > 0 {
+		res.Gini = CalcGiniCoefficient(stakingAmounts)
+	}
+
+	totalAmount := float64(0)
+	effectiveAmounts := make([]float64, len(s.CouncilStakingAmounts))
+	for i, amount := range s.CouncilStakingAmounts {
+		effectiveAmounts[i] = float64(amount)
+		if s.UseGini && res.Gini != DefaultGiniCoefficient {
+			effectiveAmounts[i] = math.Round(math.Pow(effectiveAmounts[i], 1.0/(1+res.Gini)))
+		}
+		res.EffectiveStakingAmounts[i] = uint64(effectiveAmounts[i])
+		totalAmount += effectiveAmounts[i]
+	}
+
+	if totalAmount > 0 {
+		for i, amount := range effectiveAmounts {
+			res.VotingWeights[i] = uint64(math.Round(amount * 100 / totalAmount))
+		}
+	}
+	return res
+}
+
 func (s *StakingInfo) String() string {
 	j, err := json.Marshal(s)
 	if err != nil {
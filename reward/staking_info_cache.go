@@ -50,7 +50,11 @@ func (sc *stakingInfoCache) add(stakingInfo *StakingInfo) {
 
 	// Assumption: stakingInfo is not nil.
 
-	if _, ok := sc.cells[stakingInfo.BlockNum]; ok {
+	// The staking info fetched from a block which is no longer canonical is replaced.
+	if s, ok := sc.cells[stakingInfo.BlockNum]; ok {
+		if s.BlockHash != stakingInfo.BlockHash {
+			sc.cells[stakingInfo.BlockNum] = stakingInfo
+		}
 		return
 	}
 
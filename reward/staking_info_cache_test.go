@@ -17,6 +17,7 @@
 package reward
 
 import (
+	"github.com/klaytn/klaytn/common"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -47,6 +48,21 @@ func TestStakingInfoCache_Add_SameNumber(t *testing.T) {
 	assert.Equal(t, 1, len(stakingInfoCache.cells), "StakingInfo with Same block number is saved to the stakingCache")
 }
 
+func TestStakingInfoCache_Add_DifferentHash(t *testing.T) {
+	stakingInfoCache := newStakingInfoCache()
+
+	testStakingInfo1 := newEmptyStakingInfo(uint64(1))
+	testStakingInfo1.BlockHash = common.HexToHash("0x1")
+	testStakingInfo2 := newEmptyStakingInfo(uint64(1))
+	testStakingInfo2.BlockHash = common.HexToHash("0x2")
+
+	stakingInfoCache.add(testStakingInfo1)
+	stakingInfoCache.add(testStakingInfo2)
+
+	assert.Equal(t, 1, len(stakingInfoCache.cells))
+	assert.Equal(t, testStakingInfo2, stakingInfoCache.get(1), "StakingInfo from a different block should replace the cached one")
+}
+
 func TestStakingInfoCache_Add_SmallNumber(t *testing.T) {
 	stakingInfoCache := newStakingInfoCache()
 
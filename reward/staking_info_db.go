@@ -44,6 +44,10 @@ func getStakingInfoFromDB(blockNum uint64) (*StakingInfo, error) {
 		return nil, err
 	}
 
+	if err := stakingInfo.validate(blockNum); err != nil {
+		return nil, err
+	}
+
 	return stakingInfo, nil
 }
 
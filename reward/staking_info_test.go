@@ -121,6 +121,7 @@ func TestStakingInfo_String(t *testing.T) {
 			false,
 			0.0,
 			[]uint64{5000000, 5000000, 5000000, 5000000},
+			common.Hash{},
 		},
 		{
 			86400,
@@ -147,6 +148,7 @@ func TestStakingInfo_String(t *testing.T) {
 			true,
 			0.5,
 			[]uint64{10000000, 20000000, 30000000, 40000000},
+			common.Hash{},
 		},
 	}
 
@@ -271,3 +273,42 @@ func TestStakingInfoJSON(t *testing.T) {
 		t.Fatal(errors.New("problem while marshaling or unmarshaling"))
 	}
 }
+
+func TestStakingInfo_validate(t *testing.T) {
+	stakingInfo := newEmptyStakingInfo(86400)
+	stakingInfo.CouncilNodeAddrs = []common.Address{common.StringToAddress("0x1"), common.StringToAddress("0x2")}
+	stakingInfo.CouncilStakingAddrs = []common.Address{common.StringToAddress("0x3"), common.StringToAddress("0x4")}
+	stakingInfo.CouncilRewardAddrs = []common.Address{common.StringToAddress("0x5"), common.StringToAddress("0x6")}
+	stakingInfo.CouncilStakingAmounts = []uint64{5000000, 10000000}
+
+	assert.NoError(t, stakingInfo.validate(86400))
+	assert.Equal(t, errStakingInfoBlockNumMismatch, stakingInfo.validate(172800))
+
+	stakingInfo.CouncilStakingAmounts = []uint64{5000000}
+	assert.Equal(t, errStakingInfoLengthMismatch, stakingInfo.validate(86400))
+}
+
+func TestNewStakingInfoResponse(t *testing.T) {
+	stakingInfo := newEmptyStakingInfo(86400)
+	stakingInfo.CouncilStakingAmounts = []uint64{5000000, 15000000, 20000000}
+
+	// The voting weights are proportional to the staking amounts without the gini coefficient
+	res := NewStakingInfoResponse(stakingInfo)
+	assert.Equal(t, 0.25, res.Gini)
+	assert.Equal(t, []uint64{5000000, 15000000, 20000000}, res.EffectiveStakingAmounts)
+	assert.Equal(t, []uint64{13, 38, 50}, res.VotingWeights)
+
+	// The gini coefficient narrows the gap between the weights
+	stakingInfo.UseGini = true
+	res = NewStakingInfoResponse(stakingInfo)
+	assert.Equal(t, 0.25, res.Gini)
+	assert.Equal(t, []uint64{16, 37, 47}, res.VotingWeights)
+
+	// The staking info is not changed
+	assert.Equal(t, DefaultGiniCoefficient, stakingInfo.Gini)
+
+	// There is no weight without staking
+	res = NewStakingInfoResponse(newEmptyStakingInfo(86400))
+	assert.Equal(t, DefaultGiniCoefficient, res.Gini)
+	assert.Equal(t, 0, len(res.VotingWeights))
+}
@@ -35,6 +35,7 @@ const (
 type blockChain interface {
 	SubscribeChainHeadEvent(ch chan<- blockchain.ChainHeadEvent) event.Subscription
 	GetBlockByNumber(number uint64) *types.Block
+	GetCanonicalHash(number uint64) common.Hash
 	StateAt(root common.Hash) (*state.StateDB, error)
 	Config() *params.ChainConfig
 
@@ -112,13 +113,13 @@ func GetStakingInfo(blockNum uint64) *StakingInfo {
 	stakingBlockNumber := params.CalcStakingBlockNumber(blockNum)
 
 	// Get staking info from cache
-	if cachedStakingInfo := stakingManager.stakingInfoCache.get(stakingBlockNumber); cachedStakingInfo != nil {
+	if cachedStakingInfo := stakingManager.stakingInfoCache.get(stakingBlockNumber); cachedStakingInfo != nil && isCanonicalStakingInfo(cachedStakingInfo) {
 		logger.Debug("StakingInfoCache hit.", "blockNum", blockNum, "staking block number", stakingBlockNumber, "stakingInfo", cachedStakingInfo)
 		return cachedStakingInfo
 	}
 
 	// Get staking info from DB
-	if storedStakingInfo, err := getStakingInfoFromDB(stakingBlockNumber); storedStakingInfo != nil && err == nil {
+	if storedStakingInfo, err := getStakingInfoFromDB(stakingBlockNumber); storedStakingInfo != nil && err == nil && isCanonicalStakingInfo(storedStakingInfo) {
 		logger.Debug("StakingInfoDB hit.", "blockNum", blockNum, "staking block number", stakingBlockNumber, "stakingInfo", storedStakingInfo)
 		stakingManager.stakingInfoCache.add(storedStakingInfo)
 		return storedStakingInfo
@@ -137,6 +138,17 @@ func GetStakingInfo(blockNum uint64) *StakingInfo {
 	return calcStakingInfo
 }
 
+// isCanonicalStakingInfo returns false if the staking info is fetched from a block which is no longer canonical.
+// The staking info stored without a block hash, or fetched from a block which is not in the chain yet,
+// is regarded as canonical.
+func isCanonicalStakingInfo(stakingInfo *StakingInfo) bool {
+	if common.EmptyHash(stakingInfo.BlockHash) {
+		return true
+	}
+	hash := stakingManager.blockchain.GetCanonicalHash(stakingInfo.BlockNum)
+	return common.EmptyHash(hash) || hash == stakingInfo.BlockHash
+}
+
 // updateStakingInfo updates staking info in cache and db created from given block number.
 func updateStakingInfo(blockNum uint64) (*StakingInfo, error) {
 	if stakingManager == nil {
@@ -169,7 +181,7 @@ func CheckStakingInfoStored(blockNum uint64) error {
 	stakingBlockNumber := params.CalcStakingBlockNumber(blockNum)
 
 	// skip checking if staking info is stored in DB
-	if _, err := getStakingInfoFromDB(stakingBlockNumber); err == nil {
+	if stakingInfo, err := getStakingInfoFromDB(stakingBlockNumber); err == nil && isCanonicalStakingInfo(stakingInfo) {
 		return nil
 	}
 
@@ -17,6 +17,10 @@
 package reward
 
 import (
+	"encoding/json"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/storage/database"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -79,3 +83,66 @@ func TestStakingManager_getStakingInfoFromStakingCache(t *testing.T) {
 		assert.Equal(t, testCases[i].expectedNumber, resultStakingInfo.BlockNum)
 	}
 }
+
+// checking the stakingInfo stored in DB is returned only if it is intact
+func TestStakingManager_getStakingInfoFromDB(t *testing.T) {
+	stakingManager := NewStakingManager(newTestBlockChain(), newDefaultTestGovernance(), nil)
+	stakingManager.stakingInfoDB = database.NewMemoryDBManager()
+	defer func() { stakingManager.stakingInfoDB = nil }()
+
+	stakingInfo := newEmptyStakingInfo(stakingInterval)
+	stakingInfo.CouncilNodeAddrs = []common.Address{common.StringToAddress("0x1")}
+	stakingInfo.CouncilStakingAddrs = []common.Address{common.StringToAddress("0x2")}
+	stakingInfo.CouncilRewardAddrs = []common.Address{common.StringToAddress("0x3")}
+	stakingInfo.CouncilStakingAmounts = []uint64{5000000}
+	assert.NoError(t, addStakingInfoToDB(stakingInfo))
+
+	stored, err := getStakingInfoFromDB(stakingInterval)
+	assert.NoError(t, err)
+	assert.Equal(t, stakingInfo, stored)
+
+	// The stakingInfo stored with a wrong block number is rejected
+	data, _ := json.Marshal(stakingInfo)
+	assert.NoError(t, stakingManager.stakingInfoDB.WriteStakingInfo(2*stakingInterval, data))
+	_, err = getStakingInfoFromDB(2 * stakingInterval)
+	assert.Equal(t, errStakingInfoBlockNumMismatch, err)
+
+	// The broken stakingInfo is rejected
+	stakingInfo.CouncilStakingAmounts = nil
+	assert.NoError(t, addStakingInfoToDB(stakingInfo))
+	_, err = getStakingInfoFromDB(stakingInterval)
+	assert.Equal(t, errStakingInfoLengthMismatch, err)
+}
+
+// testCanonicalChain is a blockChain which only knows the canonical hashes of blocks.
+type testCanonicalChain struct {
+	*blockchain.BlockChain
+	hashes map[uint64]common.Hash
+}
+
+func (bc *testCanonicalChain) GetCanonicalHash(number uint64) common.Hash {
+	return bc.hashes[number]
+}
+
+// checking the stakingInfo fetched from a block which is no longer canonical is rejected
+func TestStakingManager_isCanonicalStakingInfo(t *testing.T) {
+	stakingManager := NewStakingManager(newTestBlockChain(), newDefaultTestGovernance(), nil)
+	oldChain := stakingManager.blockchain
+	defer func() { stakingManager.blockchain = oldChain }()
+	stakingManager.blockchain = &testCanonicalChain{hashes: map[uint64]common.Hash{
+		stakingInterval: common.HexToHash("0x1"),
+	}}
+
+	stakingInfo := newEmptyStakingInfo(stakingInterval)
+	assert.True(t, isCanonicalStakingInfo(stakingInfo)) // stored without a block hash
+
+	stakingInfo.BlockHash = common.HexToHash("0x1")
+	assert.True(t, isCanonicalStakingInfo(stakingInfo))
+
+	stakingInfo.BlockHash = common.HexToHash("0x2")
+	assert.False(t, isCanonicalStakingInfo(stakingInfo)) // fetched from a side chain
+
+	stakingInfo = newEmptyStakingInfo(2 * stakingInterval)
+	stakingInfo.BlockHash = common.HexToHash("0x3")
+	assert.True(t, isCanonicalStakingInfo(stakingInfo)) // the block is not in the chain yet
+}
@@ -73,12 +73,18 @@ type Decoder interface {
 // error if there are too few or too many elements.
 //
 // The decoding of struct fields honours certain struct tags, "tail",
-// "nil" and "-".
+// "optional", "nil" and "-".
 //
 // The "-" tag ignores fields.
 //
 // For an explanation of "tail", see the example.
 //
+// The "optional" tag allows trailing fields to be missing in the input list,
+// in which case they are set to zero values. Once a field is optional, all
+// subsequent fields must also be optional. When encoding, trailing optional
+// fields holding zero values are omitted. This is used to add a field to a
+// type without changing the encoding of the existing values.
+//
 // The "nil" tag applies to pointer-typed fields and changes the decoding
 // rules for the field such that input values of size zero decode as a nil
 // pointer. This tag can be useful when decoding recursive types.
@@ -438,9 +444,18 @@ func makeStructDecoder(typ reflect.Type) (decoder, error) {
 		if _, err := s.List(); err != nil {
 			return wrapStreamError(err, typ)
 		}
-		for _, f := range fields {
+		for i, f := range fields {
 			err := f.info.decoder(s, val.Field(f.index))
 			if err == EOL {
+				if f.optional {
+					// The remaining optional fields are missing in the input,
+					// so they are set to zero values.
+					for _, rest := range fields[i:] {
+						v := val.Field(rest.index)
+						v.Set(reflect.Zero(v.Type()))
+					}
+					break
+				}
 				return &decodeError{msg: "too few elements", typ: typ}
 			} else if err != nil {
 				return addErrorContext(err, "."+typ.Field(f.index).Name)
@@ -113,6 +113,8 @@ type DBManager interface {
 
 	ReadIstanbulSnapshot(hash common.Hash) ([]byte, error)
 	WriteIstanbulSnapshot(hash common.Hash, blob []byte) error
+	ReadIstanbulParticipation(hash common.Hash) ([]byte, error)
+	WriteIstanbulParticipation(hash common.Hash, blob []byte) error
 
 	WriteMerkleProof(key, value []byte)
 
@@ -152,6 +154,10 @@ type DBManager interface {
 	PutSenderTxHashToTxHashToBatch(batch Batch, senderTxHash, txHash common.Hash) error
 	ReadTxHashFromSenderTxHash(senderTxHash common.Hash) common.Hash
 
+	NewRevertReasonBatch() Batch
+	PutRevertReasonToBatch(batch Batch, txHash common.Hash, revertReason []byte) error
+	ReadRevertReason(txHash common.Hash) []byte
+
 	ReadBloomBits(bloomBitsKey []byte) ([]byte, error)
 	WriteBloomBits(bloomBitsKey []byte, bits []byte) error
 
@@ -210,6 +216,8 @@ type DBManager interface {
 	ReadGovernanceAtNumber(num uint64, epoch uint64) (uint64, map[string]interface{}, error)
 	WriteGovernanceState(b []byte) error
 	ReadGovernanceState() ([]byte, error)
+	WriteGovernanceChanges(num uint64, b []byte) error
+	ReadGovernanceChanges(num uint64) ([]byte, error)
 
 	// StakingInfo related functions
 	ReadStakingInfo(blockNum uint64) ([]byte, error)
@@ -1368,6 +1376,18 @@ func (dbm *databaseManager) WriteIstanbulSnapshot(hash common.Hash, blob []byte)
 	return db.Put(snapshotKey(hash), blob)
 }
 
+// ReadIstanbulParticipation retrieves the participation of the validators in the block of the given hash.
+func (dbm *databaseManager) ReadIstanbulParticipation(hash common.Hash) ([]byte, error) {
+	db := dbm.getDatabase(MiscDB)
+	return db.Get(istanbulParticipationKey(hash))
+}
+
+// WriteIstanbulParticipation stores the participation of the validators in the block of the given hash.
+func (dbm *databaseManager) WriteIstanbulParticipation(hash common.Hash, blob []byte) error {
+	db := dbm.getDatabase(MiscDB)
+	return db.Put(istanbulParticipationKey(hash), blob)
+}
+
 // Merkle Proof operation.
 func (dbm *databaseManager) WriteMerkleProof(key, value []byte) {
 	db := dbm.getDatabase(MiscDB)
@@ -1642,6 +1662,35 @@ func (dbm *databaseManager) ReadTxHashFromSenderTxHash(senderTxHash common.Hash)
 	return txHash
 }
 
+// NewRevertReasonBatch returns a batch to write revert payloads of reverted transactions.
+func (dbm *databaseManager) NewRevertReasonBatch() Batch {
+	return dbm.NewBatch(MiscDB)
+}
+
+// PutRevertReasonToBatch puts the revert payload of the given transaction to the given batch.
+func (dbm *databaseManager) PutRevertReasonToBatch(batch Batch, txHash common.Hash, revertReason []byte) error {
+	if err := batch.Put(revertReasonKey(txHash), revertReason); err != nil {
+		return err
+	}
+
+	if batch.ValueSize() > IdealBatchSize {
+		batch.Write()
+		batch.Reset()
+	}
+
+	return nil
+}
+
+// ReadRevertReason retrieves the revert payload of the given transaction.
+// It returns nil if the payload has not been stored.
+func (dbm *databaseManager) ReadRevertReason(txHash common.Hash) []byte {
+	data, _ := dbm.getDatabase(MiscDB).Get(revertReasonKey(txHash))
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
+
 // BloomBits operations.
 // ReadBloomBits retrieves the compressed bloom bit vector belonging to the given
 // section and bit index from the.
@@ -2028,3 +2077,16 @@ func (dbm *databaseManager) ReadGovernanceState() ([]byte, error) {
 	db := dbm.getDatabase(MiscDB)
 	return db.Get(governanceStateKey)
 }
+
+// WriteGovernanceChanges stores the governance changes written at the block of the given
+// number with the votes which led to them.
+func (dbm *databaseManager) WriteGovernanceChanges(num uint64, b []byte) error {
+	db := dbm.getDatabase(MiscDB)
+	return db.Put(makeKey(governanceChangesPrefix, num), b)
+}
+
+// ReadGovernanceChanges returns the governance changes written at the block of the given number.
+func (dbm *databaseManager) ReadGovernanceChanges(num uint64) ([]byte, error) {
+	db := dbm.getDatabase(MiscDB)
+	return db.Get(makeKey(governanceChangesPrefix, num))
+}
@@ -354,6 +354,18 @@ func TestDBManager_IstanbulSnapshot(t *testing.T) {
 	}
 }
 
+// TestDBManager_IstanbulParticipation tests read and write operations of istanbul participations.
+func TestDBManager_IstanbulParticipation(t *testing.T) {
+	for _, dbm := range dbManagers {
+		participation, _ := dbm.ReadIstanbulParticipation(hash3)
+		assert.Nil(t, participation)
+
+		dbm.WriteIstanbulParticipation(hash3, hash2[:])
+		participation, _ = dbm.ReadIstanbulParticipation(hash3)
+		assert.Equal(t, hash2[:], participation)
+	}
+}
+
 // TestDBManager_TrieNode tests read and write operations of state trie nodes.
 func TestDBManager_TrieNode(t *testing.T) {
 	for _, dbm := range dbManagers {
@@ -486,6 +498,27 @@ func TestDBManager_TxLookupEntry(t *testing.T) {
 	}
 }
 
+// TestDBManager_RevertReason tests read and write operations of revert reasons.
+func TestDBManager_RevertReason(t *testing.T) {
+	for _, dbm := range dbManagers {
+		txHash := common.HexToHash("123456")
+		reason := []byte{0x08, 0xc3, 0x79, 0xa0}
+
+		assert.Nil(t, dbm.ReadRevertReason(txHash))
+
+		batch := dbm.NewRevertReasonBatch()
+		if err := dbm.PutRevertReasonToBatch(batch, txHash, reason); err != nil {
+			t.Fatal("Failed while calling PutRevertReasonToBatch", "err", err)
+		}
+
+		if err := batch.Write(); err != nil {
+			t.Fatal("Failed writing RevertReasonBatch", "err", err)
+		}
+
+		assert.Equal(t, reason, dbm.ReadRevertReason(txHash))
+	}
+}
+
 // TestDBManager_BloomBits tests read, write and delete operations of bloom bits
 func TestDBManager_BloomBits(t *testing.T) {
 	for _, dbm := range dbManagers {
@@ -49,6 +49,8 @@ var (
 
 	snapshotKeyPrefix = []byte("snapshot")
 
+	istanbulParticipationPrefix = []byte("istanbulParticipation") // istanbulParticipationPrefix + hash -> participation of the block
+
 	// Data item prefixes (use single byte to avoid mixing data types, avoid `i`, used for indexes).
 	headerPrefix       = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
 	headerTDSuffix     = []byte("t") // headerPrefix + num (uint64 big endian) + hash + headerTDSuffix -> td
@@ -84,10 +86,14 @@ var (
 
 	senderTxHashToTxHashPrefix = []byte("SenderTxHash")
 
+	revertReasonPrefix = []byte("revertReason") // revertReasonPrefix + txHash -> revert payload
+
 	governancePrefix     = []byte("governance")
 	governanceHistoryKey = []byte("governanceIdxHistory")
 	governanceStateKey   = []byte("governanceState")
 
+	governanceChangesPrefix = []byte("governanceChanges") // governanceChangesPrefix + num -> governance changes written at the block
+
 	databaseDirPrefix  = []byte("databaseDirectory")
 	migrationStatusKey = []byte("migrationStatus")
 
@@ -141,6 +147,11 @@ func SenderTxHashToTxHashKey(senderTxHash common.Hash) []byte {
 	return append(senderTxHashToTxHashPrefix, senderTxHash.Bytes()...)
 }
 
+// revertReasonKey = revertReasonPrefix + txHash
+func revertReasonKey(txHash common.Hash) []byte {
+	return append(revertReasonPrefix, txHash.Bytes()...)
+}
+
 // preimageKey = preimagePrefix + hash
 func preimageKey(hash common.Hash) []byte {
 	return append(preimagePrefix, hash.Bytes()...)
@@ -159,6 +170,10 @@ func snapshotKey(hash common.Hash) []byte {
 	return append(snapshotKeyPrefix, hash[:]...)
 }
 
+func istanbulParticipationKey(hash common.Hash) []byte {
+	return append(istanbulParticipationPrefix, hash[:]...)
+}
+
 func childChainTxHashKey(ccBlockHash common.Hash) []byte {
 	return append(append(childChainTxHashPrefix, ccBlockHash.Bytes()...))
 }
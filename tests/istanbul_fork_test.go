@@ -0,0 +1,126 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/vm/runtime"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+// blake2FInput is the input of the test vector 5 of EIP-152, which is the BLAKE2b-512 hash of "abc".
+const blake2FInput = "0x0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000001"
+
+func newForkRuntimeConfig(t *testing.T, fork string, blockNumber int64) *runtime.Config {
+	config, ok := Forks[fork]
+	if !ok {
+		t.Fatal(UnsupportedForkError{fork})
+	}
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+	return &runtime.Config{
+		ChainConfig: config,
+		BlockNumber: big.NewInt(blockNumber),
+		State:       statedb,
+	}
+}
+
+// TestIstanbulOpcodes checks that CHAINID and SELFBALANCE are available only after the istanbul fork.
+func TestIstanbulOpcodes(t *testing.T) {
+	var (
+		// CHAINID PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+		chainIDCode = hexutil.MustDecode("0x4660005260206000f3")
+		// SELFBALANCE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+		selfBalanceCode = hexutil.MustDecode("0x4760005260206000f3")
+		contract        = common.BytesToAddress([]byte("contract"))
+	)
+
+	cfg := newForkRuntimeConfig(t, "Istanbul", 0)
+	ret, _, err := runtime.Execute(chainIDCode, nil, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, common.BigToHash(cfg.ChainConfig.ChainID).Bytes(), ret)
+
+	cfg = newForkRuntimeConfig(t, "Istanbul", 0)
+	cfg.State.AddBalance(contract, big.NewInt(1234))
+	ret, _, err = runtime.Execute(selfBalanceCode, nil, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(1234)).Bytes(), ret)
+
+	for _, code := range [][]byte{chainIDCode, selfBalanceCode} {
+		_, _, err = runtime.Execute(code, nil, newForkRuntimeConfig(t, "Constantinople", 0))
+		assert.Error(t, err)
+	}
+}
+
+// TestIstanbulPrecompiledContracts checks that blake2F is enabled at 0x0c after the istanbul fork
+// while the Klaytn specific precompiled contracts stay at their addresses.
+func TestIstanbulPrecompiledContracts(t *testing.T) {
+	var (
+		blake2FAddress = common.BytesToAddress([]byte{12})
+		vmLogAddress   = common.BytesToAddress([]byte{9})
+		input          = hexutil.MustDecode(blake2FInput)
+		expected       = hexutil.MustDecode("0xba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923")
+	)
+
+	// Before the fork, nothing is deployed at 0x0c.
+	_, _, err := runtime.Call(blake2FAddress, input, newForkRuntimeConfig(t, "Constantinople", 0))
+	assert.Equal(t, kerrors.ErrPrecompiledContractAddress, err)
+	ret, _, err := runtime.Call(vmLogAddress, input, newForkRuntimeConfig(t, "Constantinople", 0))
+	assert.NoError(t, err)
+	assert.Empty(t, ret)
+
+	// After the fork, 0x0c is blake2F and 0x09 is still vmLog.
+	ret, _, err = runtime.Call(blake2FAddress, input, newForkRuntimeConfig(t, "Istanbul", 0))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ret)
+	ret, _, err = runtime.Call(vmLogAddress, input, newForkRuntimeConfig(t, "Istanbul", 0))
+	assert.NoError(t, err)
+	assert.Empty(t, ret)
+}
+
+// TestIstanbulGasTable checks the repricing of EIP-1884 by the fork block.
+func TestIstanbulGasTable(t *testing.T) {
+	config := &params.ChainConfig{ChainID: big.NewInt(1), IstanbulCompatibleBlock: big.NewInt(10)}
+
+	// PUSH1 0 SLOAD STOP
+	code := hexutil.MustDecode("0x60005400")
+	for _, test := range []struct {
+		blockNumber int64
+		gasUsed     uint64
+	}{
+		{9, 3 + params.GasTableCypress.SLoad},
+		{10, 3 + params.GasTableIstanbul.SLoad},
+	} {
+		cfg := newForkRuntimeConfig(t, "Istanbul", test.blockNumber)
+		cfg.ChainConfig = config
+		cfg.GasLimit = 100000
+		contract := common.BytesToAddress([]byte("contract"))
+		cfg.State.CreateSmartContractAccount(contract, params.CodeFormatEVM)
+		cfg.State.SetCode(contract, code)
+
+		_, leftOverGas, err := runtime.Call(contract, nil, cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, test.gasUsed, cfg.GasLimit-leftOverGas)
+	}
+}
@@ -27,6 +27,7 @@ work package is in charge of generating blocks and it contains `miner`, `worker`
 
 Source Files
  - agent.go		: Provides CpuAgent and accompanying functions which works as an agent of a miner. Agent is in charge of creating a block
+ - ordering.go		: Provides TxOrdering and the policies deciding the order of transactions in a new block
  - remote_agent.go	: Provides RemoteAgent working as an another agent for a miner and can be controlled by RPC calls
  - work.go		: Provides Miner struct and interfaces through which the miner communicate with other objects
  - worker.go		: Provides Worker and performs the main part of block creation
@@ -75,6 +75,34 @@ func (mr *MockBlockChainMockRecorder) BadBlocks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BadBlocks", reflect.TypeOf((*MockBlockChain)(nil).BadBlocks))
 }
 
+// BlockGasLimit mocks base method
+func (m *MockBlockChain) BlockGasLimit(arg0 uint64) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockGasLimit", arg0)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// BlockGasLimit indicates an expected call of BlockGasLimit
+func (mr *MockBlockChainMockRecorder) BlockGasLimit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockGasLimit", reflect.TypeOf((*MockBlockChain)(nil).BlockGasLimit), arg0)
+}
+
+// ComputationCostLimit mocks base method
+func (m *MockBlockChain) ComputationCostLimit(arg0 uint64) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputationCostLimit", arg0)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// ComputationCostLimit indicates an expected call of ComputationCostLimit
+func (mr *MockBlockChainMockRecorder) ComputationCostLimit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputationCostLimit", reflect.TypeOf((*MockBlockChain)(nil).ComputationCostLimit), arg0)
+}
+
 // Config mocks base method
 func (m *MockBlockChain) Config() *params.ChainConfig {
 	m.ctrl.T.Helper()
@@ -229,6 +257,20 @@ func (mr *MockBlockChainMockRecorder) GetBlockByNumber(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockBlockChain)(nil).GetBlockByNumber), arg0)
 }
 
+// GetBlockExecutionProfile mocks base method
+func (m *MockBlockChain) GetBlockExecutionProfile(arg0 common.Hash) *blockchain.BlockExecutionProfile {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockExecutionProfile", arg0)
+	ret0, _ := ret[0].(*blockchain.BlockExecutionProfile)
+	return ret0
+}
+
+// GetBlockExecutionProfile indicates an expected call of GetBlockExecutionProfile
+func (mr *MockBlockChainMockRecorder) GetBlockExecutionProfile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockExecutionProfile", reflect.TypeOf((*MockBlockChain)(nil).GetBlockExecutionProfile), arg0)
+}
+
 // GetBlockHashesFromHash mocks base method
 func (m *MockBlockChain) GetBlockHashesFromHash(arg0 common.Hash, arg1 uint64) []common.Hash {
 	m.ctrl.T.Helper()
@@ -271,6 +313,20 @@ func (mr *MockBlockChainMockRecorder) GetBodyRLP(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBodyRLP", reflect.TypeOf((*MockBlockChain)(nil).GetBodyRLP), arg0)
 }
 
+// GetCanonicalHash mocks base method
+func (m *MockBlockChain) GetCanonicalHash(arg0 uint64) common.Hash {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCanonicalHash", arg0)
+	ret0, _ := ret[0].(common.Hash)
+	return ret0
+}
+
+// GetCanonicalHash indicates an expected call of GetCanonicalHash
+func (mr *MockBlockChainMockRecorder) GetCanonicalHash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCanonicalHash", reflect.TypeOf((*MockBlockChain)(nil).GetCanonicalHash), arg0)
+}
+
 // GetHeader mocks base method
 func (m *MockBlockChain) GetHeader(arg0 common.Hash, arg1 uint64) *types.Header {
 	m.ctrl.T.Helper()
@@ -569,6 +625,20 @@ func (mr *MockBlockChainMockRecorder) IsSenderTxHashIndexingEnabled() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSenderTxHashIndexingEnabled", reflect.TypeOf((*MockBlockChain)(nil).IsSenderTxHashIndexingEnabled))
 }
 
+// NewBlockExecutionProfile mocks base method
+func (m *MockBlockChain) NewBlockExecutionProfile(arg0 *types.Header, arg1 bool) *blockchain.BlockExecutionProfile {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewBlockExecutionProfile", arg0, arg1)
+	ret0, _ := ret[0].(*blockchain.BlockExecutionProfile)
+	return ret0
+}
+
+// NewBlockExecutionProfile indicates an expected call of NewBlockExecutionProfile
+func (mr *MockBlockChainMockRecorder) NewBlockExecutionProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBlockExecutionProfile", reflect.TypeOf((*MockBlockChain)(nil).NewBlockExecutionProfile), arg0, arg1)
+}
+
 // PostChainEvents mocks base method
 func (m *MockBlockChain) PostChainEvents(arg0 []interface{}, arg1 []*types.Log) {
 	m.ctrl.T.Helper()
@@ -635,6 +705,18 @@ func (mr *MockBlockChainMockRecorder) Rollback(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockBlockChain)(nil).Rollback), arg0)
 }
 
+// SetGovernance mocks base method
+func (m *MockBlockChain) SetGovernance(arg0 blockchain.GovernanceReader) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetGovernance", arg0)
+}
+
+// SetGovernance indicates an expected call of SetGovernance
+func (mr *MockBlockChainMockRecorder) SetGovernance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGovernance", reflect.TypeOf((*MockBlockChain)(nil).SetGovernance), arg0)
+}
+
 // SetHead mocks base method
 func (m *MockBlockChain) SetHead(arg0 uint64) error {
 	m.ctrl.T.Helper()
@@ -949,6 +1031,18 @@ func (mr *MockBlockChainMockRecorder) Validator() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockBlockChain)(nil).Validator))
 }
 
+// WriteBlockExecutionProfile mocks base method
+func (m *MockBlockChain) WriteBlockExecutionProfile(arg0 common.Hash, arg1 *blockchain.BlockExecutionProfile) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WriteBlockExecutionProfile", arg0, arg1)
+}
+
+// WriteBlockExecutionProfile indicates an expected call of WriteBlockExecutionProfile
+func (mr *MockBlockChainMockRecorder) WriteBlockExecutionProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBlockExecutionProfile", reflect.TypeOf((*MockBlockChain)(nil).WriteBlockExecutionProfile), arg0, arg1)
+}
+
 // WriteBlockWithState mocks base method
 func (m *MockBlockChain) WriteBlockWithState(arg0 *types.Block, arg1 []*types.Receipt, arg2 *state.StateDB) (blockchain.WriteStatus, error) {
 	m.ctrl.T.Helper()
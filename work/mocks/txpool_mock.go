@@ -80,6 +80,36 @@ func (mr *MockTxPoolMockRecorder) Content() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Content", reflect.TypeOf((*MockTxPool)(nil).Content))
 }
 
+// ContentByFeePayer mocks base method
+func (m *MockTxPool) ContentByFeePayer(arg0 common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentByFeePayer", arg0)
+	ret0, _ := ret[0].(map[common.Address]types.Transactions)
+	ret1, _ := ret[1].(map[common.Address]types.Transactions)
+	return ret0, ret1
+}
+
+// ContentByFeePayer indicates an expected call of ContentByFeePayer
+func (mr *MockTxPoolMockRecorder) ContentByFeePayer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentByFeePayer", reflect.TypeOf((*MockTxPool)(nil).ContentByFeePayer), arg0)
+}
+
+// ContentFrom mocks base method
+func (m *MockTxPool) ContentFrom(arg0 common.Address) (types.Transactions, types.Transactions) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentFrom", arg0)
+	ret0, _ := ret[0].(types.Transactions)
+	ret1, _ := ret[1].(types.Transactions)
+	return ret0, ret1
+}
+
+// ContentFrom indicates an expected call of ContentFrom
+func (mr *MockTxPoolMockRecorder) ContentFrom(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentFrom", reflect.TypeOf((*MockTxPool)(nil).ContentFrom), arg0)
+}
+
 // GasPrice mocks base method
 func (m *MockTxPool) GasPrice() *big.Int {
 	m.ctrl.T.Helper()
@@ -134,6 +164,20 @@ func (mr *MockTxPoolMockRecorder) HandleTxMsg(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTxMsg", reflect.TypeOf((*MockTxPool)(nil).HandleTxMsg), arg0)
 }
 
+// NonExecutableReasons mocks base method
+func (m *MockTxPool) NonExecutableReasons(arg0 types.Transactions) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonExecutableReasons", arg0)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// NonExecutableReasons indicates an expected call of NonExecutableReasons
+func (mr *MockTxPoolMockRecorder) NonExecutableReasons(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonExecutableReasons", reflect.TypeOf((*MockTxPool)(nil).NonExecutableReasons), arg0)
+}
+
 // Pending mocks base method
 func (m *MockTxPool) Pending() (map[common.Address]types.Transactions, error) {
 	m.ctrl.T.Helper()
@@ -149,6 +193,62 @@ func (mr *MockTxPoolMockRecorder) Pending() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockTxPool)(nil).Pending))
 }
 
+// PriorityStatus mocks base method
+func (m *MockTxPool) PriorityStatus() []blockchain.TxPriorityClassStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriorityStatus")
+	ret0, _ := ret[0].([]blockchain.TxPriorityClassStatus)
+	return ret0
+}
+
+// PriorityStatus indicates an expected call of PriorityStatus
+func (mr *MockTxPoolMockRecorder) PriorityStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriorityStatus", reflect.TypeOf((*MockTxPool)(nil).PriorityStatus))
+}
+
+// Remove mocks base method
+func (m *MockTxPool) Remove(arg0 common.Hash) *types.Transaction {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0)
+	ret0, _ := ret[0].(*types.Transaction)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove
+func (mr *MockTxPoolMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTxPool)(nil).Remove), arg0)
+}
+
+// RemoveSender mocks base method
+func (m *MockTxPool) RemoveSender(arg0 common.Address) types.Transactions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSender", arg0)
+	ret0, _ := ret[0].(types.Transactions)
+	return ret0
+}
+
+// RemoveSender indicates an expected call of RemoveSender
+func (mr *MockTxPoolMockRecorder) RemoveSender(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSender", reflect.TypeOf((*MockTxPool)(nil).RemoveSender), arg0)
+}
+
+// ReplaceLocal mocks base method
+func (m *MockTxPool) ReplaceLocal(arg0 *types.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceLocal", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceLocal indicates an expected call of ReplaceLocal
+func (mr *MockTxPoolMockRecorder) ReplaceLocal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceLocal", reflect.TypeOf((*MockTxPool)(nil).ReplaceLocal), arg0)
+}
+
 // SetGasPrice mocks base method
 func (m *MockTxPool) SetGasPrice(arg0 *big.Int) {
 	m.ctrl.T.Helper()
@@ -161,6 +261,30 @@ func (mr *MockTxPoolMockRecorder) SetGasPrice(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGasPrice", reflect.TypeOf((*MockTxPool)(nil).SetGasPrice), arg0)
 }
 
+// SetMaxTxSize mocks base method
+func (m *MockTxPool) SetMaxTxSize(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxTxSize", arg0)
+}
+
+// SetMaxTxSize indicates an expected call of SetMaxTxSize
+func (mr *MockTxPoolMockRecorder) SetMaxTxSize(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxTxSize", reflect.TypeOf((*MockTxPool)(nil).SetMaxTxSize), arg0)
+}
+
+// SetMinimumPrice mocks base method
+func (m *MockTxPool) SetMinimumPrice(arg0 *big.Int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMinimumPrice", arg0)
+}
+
+// SetMinimumPrice indicates an expected call of SetMinimumPrice
+func (mr *MockTxPoolMockRecorder) SetMinimumPrice(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMinimumPrice", reflect.TypeOf((*MockTxPool)(nil).SetMinimumPrice), arg0)
+}
+
 // Stats mocks base method
 func (m *MockTxPool) Stats() (int, int) {
 	m.ctrl.T.Helper()
@@ -188,6 +312,20 @@ func (mr *MockTxPoolMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockTxPool)(nil).Stop))
 }
 
+// SubscribeDroppedTxsEvent mocks base method
+func (m *MockTxPool) SubscribeDroppedTxsEvent(arg0 chan<- blockchain.DroppedTxsEvent) event.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeDroppedTxsEvent", arg0)
+	ret0, _ := ret[0].(event.Subscription)
+	return ret0
+}
+
+// SubscribeDroppedTxsEvent indicates an expected call of SubscribeDroppedTxsEvent
+func (mr *MockTxPoolMockRecorder) SubscribeDroppedTxsEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeDroppedTxsEvent", reflect.TypeOf((*MockTxPool)(nil).SubscribeDroppedTxsEvent), arg0)
+}
+
 // SubscribeNewTxsEvent mocks base method
 func (m *MockTxPool) SubscribeNewTxsEvent(arg0 chan<- blockchain.NewTxsEvent) event.Subscription {
 	m.ctrl.T.Helper()
@@ -201,3 +339,31 @@ func (mr *MockTxPoolMockRecorder) SubscribeNewTxsEvent(arg0 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewTxsEvent", reflect.TypeOf((*MockTxPool)(nil).SubscribeNewTxsEvent), arg0)
 }
+
+// SubscribeReplacedTxEvent mocks base method
+func (m *MockTxPool) SubscribeReplacedTxEvent(arg0 chan<- blockchain.ReplacedTxEvent) event.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeReplacedTxEvent", arg0)
+	ret0, _ := ret[0].(event.Subscription)
+	return ret0
+}
+
+// SubscribeReplacedTxEvent indicates an expected call of SubscribeReplacedTxEvent
+func (mr *MockTxPoolMockRecorder) SubscribeReplacedTxEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeReplacedTxEvent", reflect.TypeOf((*MockTxPool)(nil).SubscribeReplacedTxEvent), arg0)
+}
+
+// TxPriority mocks base method
+func (m *MockTxPool) TxPriority(arg0 *types.Transaction) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPriority", arg0)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// TxPriority indicates an expected call of TxPriority
+func (mr *MockTxPoolMockRecorder) TxPriority(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPriority", reflect.TypeOf((*MockTxPool)(nil).TxPriority), arg0)
+}
@@ -0,0 +1,247 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package work
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+)
+
+// TxOrderingPolicy is the name of a policy deciding the order in which the
+// pending transactions are applied to a new block.
+type TxOrderingPolicy string
+
+const (
+	TxOrderingPriceAndNonce TxOrderingPolicy = "price"      // Transactions with a higher gas price first
+	TxOrderingFIFO          TxOrderingPolicy = "fifo"       // Transactions arrived earlier first
+	TxOrderingRoundRobin    TxOrderingPolicy = "roundrobin" // One transaction of each sender in turn
+)
+
+// TxOrderingConfig is the configuration of the transaction ordering in a new block.
+type TxOrderingConfig struct {
+	Policy    TxOrderingPolicy
+	SenderCap int // Maximum number of transactions of a sender in a block (0 = unlimited)
+}
+
+// DefaultTxOrderingConfig orders the transactions by price and nonce without a sender cap.
+var DefaultTxOrderingConfig = TxOrderingConfig{
+	Policy: TxOrderingPriceAndNonce,
+}
+
+// Validate checks whether the configuration has a known policy and a valid sender cap.
+func (config TxOrderingConfig) Validate() error {
+	switch config.Policy {
+	case TxOrderingPriceAndNonce, TxOrderingFIFO, TxOrderingRoundRobin:
+	default:
+		return fmt.Errorf("unknown tx ordering policy %q", config.Policy)
+	}
+	if config.SenderCap < 0 {
+		return fmt.Errorf("negative tx ordering sender cap %d", config.SenderCap)
+	}
+	return nil
+}
+
+// TxOrdering is a set of pending transactions which are retrieved in the order of
+// a policy. The transactions of a sender are always retrieved in the nonce order.
+type TxOrdering interface {
+	// Peek returns the next transaction, or nil if no transaction is left.
+	Peek() *types.Transaction
+
+	// Shift replaces the next transaction with the following one from the same sender.
+	Shift()
+
+	// Pop removes the next transaction and all the following ones from the same sender.
+	Pop()
+}
+
+// NewTxOrdering creates a transaction set retrieving the given transactions in the
+// order of the configured policy. The transactions of higher priority senders are
+// retrieved first regardless of the policy.
+//
+// Note, the input map is reowned so the caller should not interact any more with
+// it after providing it to the constructor.
+func NewTxOrdering(config TxOrderingConfig, signer types.Signer, txs map[common.Address]types.Transactions, priority types.TxPriorityFunc) TxOrdering {
+	var ordering TxOrdering
+	switch config.Policy {
+	case TxOrderingFIFO:
+		ordering = newTxsByTimeAndNonce(signer, txs, priority)
+	case TxOrderingRoundRobin:
+		ordering = newTxsBySenderInTurn(signer, txs, priority)
+	default:
+		ordering = types.NewTransactionsByPriceAndNonceWithPriority(signer, txs, priority)
+	}
+	if config.SenderCap > 0 {
+		ordering = &txsWithSenderCap{TxOrdering: ordering, signer: signer, cap: config.SenderCap, counts: make(map[common.Address]int)}
+	}
+	return ordering
+}
+
+// txArrivedEarlier returns true if the transaction a is ordered ahead of b by the
+// priority and the arrival time. The hash breaks ties to keep the order deterministic.
+func txArrivedEarlier(a, b *types.Transaction, priority types.TxPriorityFunc) bool {
+	if priority != nil {
+		if pa, pb := priority(a), priority(b); pa != pb {
+			return pa < pb
+		}
+	}
+	if !a.Time().Equal(b.Time()) {
+		return a.Time().Before(b.Time())
+	}
+	ha, hb := a.Hash(), b.Hash()
+	return bytes.Compare(ha[:], hb[:]) < 0
+}
+
+// txHeadsByTime is a heap of the head transactions of the senders sorted by the
+// priority first and then by the arrival time.
+type txHeadsByTime struct {
+	txs      types.Transactions
+	priority types.TxPriorityFunc
+}
+
+func (s txHeadsByTime) Len() int           { return len(s.txs) }
+func (s txHeadsByTime) Less(i, j int) bool { return txArrivedEarlier(s.txs[i], s.txs[j], s.priority) }
+func (s txHeadsByTime) Swap(i, j int)      { s.txs[i], s.txs[j] = s.txs[j], s.txs[i] }
+
+func (s *txHeadsByTime) Push(x interface{}) {
+	s.txs = append(s.txs, x.(*types.Transaction))
+}
+
+func (s *txHeadsByTime) Pop() interface{} {
+	old := s.txs
+	n := len(old)
+	x := old[n-1]
+	s.txs = old[0 : n-1]
+	return x
+}
+
+// txsByTimeAndNonce retrieves the transactions in the order of arrival while
+// honouring the nonce order of each sender.
+type txsByTimeAndNonce struct {
+	txs    map[common.Address]types.Transactions // Per account nonce-sorted list of transactions
+	heads  txHeadsByTime                         // Next transaction for each unique account (arrival heap)
+	signer types.Signer                          // Signer for the set of transactions
+}
+
+func newTxsByTimeAndNonce(signer types.Signer, txs map[common.Address]types.Transactions, priority types.TxPriorityFunc) *txsByTimeAndNonce {
+	heads := txHeadsByTime{txs: make(types.Transactions, 0, len(txs)), priority: priority}
+	for _, accTxs := range txs {
+		heads.txs = append(heads.txs, accTxs[0])
+		// Ensure the sender address is from the signer
+		acc, _ := types.Sender(signer, accTxs[0])
+		txs[acc] = accTxs[1:]
+	}
+	heap.Init(&heads)
+
+	return &txsByTimeAndNonce{
+		txs:    txs,
+		heads:  heads,
+		signer: signer,
+	}
+}
+
+func (t *txsByTimeAndNonce) Peek() *types.Transaction {
+	if t.heads.Len() == 0 {
+		return nil
+	}
+	return t.heads.txs[0]
+}
+
+func (t *txsByTimeAndNonce) Shift() {
+	acc, _ := types.Sender(t.signer, t.heads.txs[0])
+	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
+		t.heads.txs[0], t.txs[acc] = txs[0], txs[1:]
+		heap.Fix(&t.heads, 0)
+	} else {
+		heap.Pop(&t.heads)
+	}
+}
+
+func (t *txsByTimeAndNonce) Pop() {
+	heap.Pop(&t.heads)
+}
+
+// txsBySenderInTurn retrieves one transaction of each sender in turn. The turn of
+// the senders is decided by the priority and the arrival of their first transactions.
+type txsBySenderInTurn struct {
+	txs     map[common.Address]types.Transactions // Per account nonce-sorted list of transactions
+	senders []common.Address                      // Senders having transactions, the first one is in turn
+}
+
+func newTxsBySenderInTurn(signer types.Signer, txs map[common.Address]types.Transactions, priority types.TxPriorityFunc) *txsBySenderInTurn {
+	senders := make([]common.Address, 0, len(txs))
+	sorted := make(map[common.Address]types.Transactions, len(txs))
+	for _, accTxs := range txs {
+		// Ensure the sender address is from the signer
+		acc, _ := types.Sender(signer, accTxs[0])
+		senders = append(senders, acc)
+		sorted[acc] = accTxs
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return txArrivedEarlier(sorted[senders[i]][0], sorted[senders[j]][0], priority)
+	})
+
+	return &txsBySenderInTurn{
+		txs:     sorted,
+		senders: senders,
+	}
+}
+
+func (t *txsBySenderInTurn) Peek() *types.Transaction {
+	if len(t.senders) == 0 {
+		return nil
+	}
+	return t.txs[t.senders[0]][0]
+}
+
+func (t *txsBySenderInTurn) Shift() {
+	acc := t.senders[0]
+	t.senders = t.senders[1:]
+	if txs := t.txs[acc][1:]; len(txs) > 0 {
+		t.txs[acc] = txs
+		t.senders = append(t.senders, acc)
+	} else {
+		delete(t.txs, acc)
+	}
+}
+
+func (t *txsBySenderInTurn) Pop() {
+	delete(t.txs, t.senders[0])
+	t.senders = t.senders[1:]
+}
+
+// txsWithSenderCap limits the number of transactions shifted from each sender.
+// The remaining transactions of a sender are dropped once the cap is reached.
+type txsWithSenderCap struct {
+	TxOrdering
+	signer types.Signer
+	cap    int
+	counts map[common.Address]int
+}
+
+func (t *txsWithSenderCap) Shift() {
+	acc, _ := types.Sender(t.signer, t.Peek())
+	if t.counts[acc]++; t.counts[acc] >= t.cap {
+		t.TxOrdering.Pop()
+	} else {
+		t.TxOrdering.Shift()
+	}
+}
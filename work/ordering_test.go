@@ -0,0 +1,155 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package work
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/klaytn/klaytn/work/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// orderingTestTxs creates the transactions of three senders in the order of
+// A0, B0, A1, C0, B1, A2. The gas prices of A, B and C are 1, 3 and 2.
+func orderingTestTxs(t *testing.T, signer types.Signer) (map[string]*types.Transaction, func() map[common.Address]types.Transactions) {
+	keys := make(map[string]*ecdsa.PrivateKey)
+	prices := map[string]int64{"A": 1, "B": 3, "C": 2}
+	for name := range prices {
+		keys[name], _ = crypto.GenerateKey()
+	}
+
+	txs := make(map[string]*types.Transaction)
+	arrival := time.Now()
+	for _, name := range []string{"A0", "B0", "A1", "C0", "B1", "A2"} {
+		sender, nonce := name[:1], uint64(name[1]-'0')
+		tx, err := types.SignTx(types.NewTransaction(nonce, common.HexToAddress("0xAAAA"), big.NewInt(1), 21000, big.NewInt(prices[sender]), nil), signer, keys[sender])
+		assert.NoError(t, err)
+		arrival = arrival.Add(time.Second)
+		tx.SetTime(arrival)
+		txs[name] = tx
+	}
+
+	// The pending map is reowned by an ordering, so a fresh one is made for each ordering
+	pending := func() map[common.Address]types.Transactions {
+		content := make(map[common.Address]types.Transactions)
+		for _, name := range []string{"A0", "A1", "A2", "B0", "B1", "C0"} {
+			from, _ := types.Sender(signer, txs[name])
+			content[from] = append(content[from], txs[name])
+		}
+		return content
+	}
+	return txs, pending
+}
+
+// applyOrdering applies the transactions in the ordering to a new block, and returns
+// the transactions in the block. The transactions in fail are rejected with the
+// nonce-too-high error.
+func applyOrdering(t *testing.T, signer types.Signer, ordering TxOrdering, fail ...*types.Transaction) types.Transactions {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	bc := mocks.NewMockBlockChain(mockCtrl)
+	bc.EXPECT().NewBlockExecutionProfile(gomock.Any(), true).Return(&blockchain.BlockExecutionProfile{Built: true})
+	bc.EXPECT().BlockGasLimit(gomock.Any()).Return(params.DefaultBlockGasLimit)
+	bc.EXPECT().ComputationCostLimit(gomock.Any()).Return(params.OpcodeComputationCostLimit)
+	bc.EXPECT().ApplyTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ *params.ChainConfig, _ *common.Address, _ *state.StateDB, _ *types.Header, tx *types.Transaction, _ *uint64, _ *vm.Config) (*types.Receipt, uint64, *vm.InternalTxTrace, error) {
+			for _, failed := range fail {
+				if failed == tx {
+					return nil, 0, nil, blockchain.ErrNonceTooHigh
+				}
+			}
+			return &types.Receipt{}, 0, nil, nil
+		}).AnyTimes()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+	task := NewTask(params.TestChainConfig, signer, statedb, &types.Header{Number: big.NewInt(1)})
+	task.ApplyTransactions(ordering, bc, common.Address{})
+
+	// The resources spent by the transactions in the block are recorded
+	assert.Equal(t, len(task.txs), len(task.profile.Txs))
+	for i, tx := range task.txs {
+		assert.Equal(t, tx.Hash(), task.profile.Txs[i].TxHash)
+	}
+	return task.txs
+}
+
+// TestTxOrderingPolicies tests that the transactions in a block are ordered by the
+// configured policy while the nonce order of each sender is honoured.
+func TestTxOrderingPolicies(t *testing.T) {
+	signer := types.NewEIP155Signer(params.TestChainConfig.ChainID)
+	txs, pending := orderingTestTxs(t, signer)
+
+	senderA, _ := types.Sender(signer, txs["A0"])
+	lowPriorityA := func(tx *types.Transaction) int {
+		if from, _ := types.Sender(signer, tx); from == senderA {
+			return 1
+		}
+		return 0
+	}
+
+	testCases := []struct {
+		name     string
+		config   TxOrderingConfig
+		priority types.TxPriorityFunc
+		fail     []string
+		expected []string
+	}{
+		{"price", TxOrderingConfig{Policy: TxOrderingPriceAndNonce}, nil, nil, []string{"B0", "B1", "C0", "A0", "A1", "A2"}},
+		{"fifo", TxOrderingConfig{Policy: TxOrderingFIFO}, nil, nil, []string{"A0", "B0", "A1", "C0", "B1", "A2"}},
+		{"roundrobin", TxOrderingConfig{Policy: TxOrderingRoundRobin}, nil, nil, []string{"A0", "B0", "C0", "A1", "B1", "A2"}},
+		{"price with sender cap", TxOrderingConfig{Policy: TxOrderingPriceAndNonce, SenderCap: 2}, nil, nil, []string{"B0", "B1", "C0", "A0", "A1"}},
+		{"roundrobin with sender cap", TxOrderingConfig{Policy: TxOrderingRoundRobin, SenderCap: 1}, nil, nil, []string{"A0", "B0", "C0"}},
+		{"fifo with priority", TxOrderingConfig{Policy: TxOrderingFIFO}, lowPriorityA, nil, []string{"B0", "C0", "B1", "A0", "A1", "A2"}},
+		{"fifo with failed tx", TxOrderingConfig{Policy: TxOrderingFIFO}, nil, []string{"A1"}, []string{"A0", "B0", "C0", "B1"}},
+		{"roundrobin with failed tx", TxOrderingConfig{Policy: TxOrderingRoundRobin}, nil, []string{"B0"}, []string{"A0", "C0", "A1", "A2"}},
+	}
+
+	for _, tc := range testCases {
+		var fail types.Transactions
+		for _, name := range tc.fail {
+			fail = append(fail, txs[name])
+		}
+		var expected types.Transactions
+		for _, name := range tc.expected {
+			expected = append(expected, txs[name])
+		}
+
+		assert.NoError(t, tc.config.Validate(), tc.name)
+		ordering := NewTxOrdering(tc.config, signer, pending(), tc.priority)
+		assert.Equal(t, expected, applyOrdering(t, signer, ordering, fail...), tc.name)
+	}
+}
+
+// TestTxOrderingConfig_Validate tests that an unknown policy or a negative sender cap is rejected.
+func TestTxOrderingConfig_Validate(t *testing.T) {
+	assert.NoError(t, DefaultTxOrderingConfig.Validate())
+	assert.Error(t, TxOrderingConfig{Policy: "lifo"}.Validate())
+	assert.Error(t, TxOrderingConfig{Policy: TxOrderingFIFO, SenderCap: -1}.Validate())
+}
@@ -59,14 +59,32 @@ type TxPool interface {
 	// NewTxsEvent and send events to the given channel.
 	SubscribeNewTxsEvent(chan<- blockchain.NewTxsEvent) event.Subscription
 
+	// SubscribeReplacedTxEvent and SubscribeDroppedTxsEvent should return event
+	// subscriptions of the transactions replaced or dropped in the pool.
+	SubscribeReplacedTxEvent(chan<- blockchain.ReplacedTxEvent) event.Subscription
+	SubscribeDroppedTxsEvent(chan<- blockchain.DroppedTxsEvent) event.Subscription
+
 	GetPendingNonce(addr common.Address) uint64
 	AddLocal(tx *types.Transaction) error
+	ReplaceLocal(tx *types.Transaction) error
+	Remove(hash common.Hash) *types.Transaction
+	RemoveSender(addr common.Address) types.Transactions
 	GasPrice() *big.Int
 	SetGasPrice(price *big.Int)
+	SetMaxTxSize(size uint64)
+	SetMinimumPrice(price *big.Int)
 	Stop()
 	Get(hash common.Hash) *types.Transaction
 	Stats() (int, int)
 	Content() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	ContentFrom(addr common.Address) (types.Transactions, types.Transactions)
+	ContentByFeePayer(feePayer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	NonExecutableReasons(txs types.Transactions) []string
+
+	// TxPriority should return the priority class of the transaction, and
+	// PriorityStatus should return the occupancy of each priority class.
+	TxPriority(tx *types.Transaction) int
+	PriorityStatus() []blockchain.TxPriorityClassStatus
 }
 
 // Backend wraps all methods required for mining.
@@ -93,12 +111,12 @@ type Miner struct {
 	shouldStart int32 // should start indicates whether we should start after sync
 }
 
-func New(backend Backend, config *params.ChainConfig, mux *event.TypeMux, engine consensus.Engine, nodetype common.ConnType, rewardbase common.Address, TxResendUseLegacy bool, restartTimeOut time.Duration, restartFn func()) *Miner {
+func New(backend Backend, config *params.ChainConfig, mux *event.TypeMux, engine consensus.Engine, nodetype common.ConnType, rewardbase common.Address, TxResendUseLegacy bool, txOrdering TxOrderingConfig, restartTimeOut time.Duration, restartFn func()) *Miner {
 	miner := &Miner{
 		backend:  backend,
 		mux:      mux,
 		engine:   engine,
-		worker:   newWorker(config, engine, rewardbase, backend, mux, nodetype, TxResendUseLegacy, restartTimeOut, restartFn),
+		worker:   newWorker(config, engine, rewardbase, backend, mux, nodetype, TxResendUseLegacy, txOrdering, restartTimeOut, restartFn),
 		canStart: 1,
 	}
 	// TODO-Klaytn drop or missing tx
@@ -228,6 +246,7 @@ type BlockChain interface {
 	GetBlockByHash(hash common.Hash) *types.Block
 	GetBlockByNumber(number uint64) *types.Block
 	GetBlockHashesFromHash(hash common.Hash, max uint64) []common.Hash
+	GetCanonicalHash(number uint64) common.Hash
 
 	CurrentHeader() *types.Header
 	HasHeader(hash common.Hash, number uint64) bool
@@ -266,6 +285,9 @@ type BlockChain interface {
 	// Used in governance pkg
 	SetProposerPolicy(val uint64)
 	SetUseGiniCoeff(val bool)
+	SetGovernance(gov blockchain.GovernanceReader)
+	BlockGasLimit(num uint64) uint64
+	ComputationCostLimit(num uint64) uint64
 
 	Processor() blockchain.Processor
 	BadBlocks() ([]blockchain.BadBlockArgs, error)
@@ -288,6 +310,9 @@ type BlockChain interface {
 	PostChainEvents(events []interface{}, logs []*types.Log)
 	TryGetCachedStateDB(rootHash common.Hash) (*state.StateDB, error)
 	ApplyTransaction(config *params.ChainConfig, author *common.Address, statedb *state.StateDB, header *types.Header, tx *types.Transaction, usedGas *uint64, cfg *vm.Config) (*types.Receipt, uint64, *vm.InternalTxTrace, error)
+	NewBlockExecutionProfile(header *types.Header, built bool) *blockchain.BlockExecutionProfile
+	WriteBlockExecutionProfile(hash common.Hash, profile *blockchain.BlockExecutionProfile)
+	GetBlockExecutionProfile(hash common.Hash) *blockchain.BlockExecutionProfile
 
 	// State Migration
 	PrepareStateMigration() error
@@ -92,6 +92,8 @@ type Task struct {
 	header   *types.Header
 	txs      []*types.Transaction
 	receipts []*types.Receipt
+	profile  *blockchain.BlockExecutionProfile
+	gasLimit uint64 // gas limit of all txs in the block, zero means no limit
 
 	createdAt time.Time
 }
@@ -126,7 +128,8 @@ type worker struct {
 	proc    blockchain.Validator
 	chainDB database.DBManager
 
-	extra []byte
+	extra      []byte
+	txOrdering TxOrderingConfig
 
 	currentMu  sync.Mutex
 	current    *Task
@@ -147,7 +150,7 @@ type worker struct {
 	restartWatchdog *time.Timer
 }
 
-func newWorker(config *params.ChainConfig, engine consensus.Engine, rewardbase common.Address, backend Backend, mux *event.TypeMux, nodetype common.ConnType, TxResendUseLegacy bool, restartTimeOut time.Duration, restartFn func()) *worker {
+func newWorker(config *params.ChainConfig, engine consensus.Engine, rewardbase common.Address, backend Backend, mux *event.TypeMux, nodetype common.ConnType, TxResendUseLegacy bool, txOrdering TxOrderingConfig, restartTimeOut time.Duration, restartFn func()) *worker {
 	worker := &worker{
 		config:         config,
 		engine:         engine,
@@ -163,6 +166,7 @@ func newWorker(config *params.ChainConfig, engine consensus.Engine, rewardbase c
 		agents:         make(map[Agent]struct{}),
 		nodetype:       nodetype,
 		rewardbase:     rewardbase,
+		txOrdering:     txOrdering,
 		restartTimeOut: restartTimeOut,
 		restartFn:      restartFn,
 	}
@@ -405,6 +409,9 @@ func (self *worker) wait(TxResendUseLegacy bool) {
 				}
 				continue
 			}
+			if work.profile != nil {
+				self.chain.WriteBlockExecutionProfile(block.Hash(), work.profile)
+			}
 
 			// TODO-Klaytn-Issue264 If we are using istanbul BFT, then we always have a canonical chain.
 			//         Later we may be able to refine below code.
@@ -535,7 +542,7 @@ func (self *worker) commitNewWork() {
 	// Create the current work task
 	work := self.current
 	if self.nodetype == common.CONSENSUSNODE {
-		txs := types.NewTransactionsByPriceAndNonce(self.current.signer, pending)
+		txs := NewTxOrdering(self.txOrdering, self.current.signer, pending, self.backend.TxPool().TxPriority)
 		work.commitTransactions(self.mux, txs, self.chain, self.rewardbase)
 
 		// Create the new block to seal with the consensus engine
@@ -568,7 +575,7 @@ func (self *worker) updateSnapshot() {
 	self.snapshotState = self.current.state.Copy()
 }
 
-func (env *Task) commitTransactions(mux *event.TypeMux, txs *types.TransactionsByPriceAndNonce, bc BlockChain, rewardbase common.Address) {
+func (env *Task) commitTransactions(mux *event.TypeMux, txs TxOrdering, bc BlockChain, rewardbase common.Address) {
 	coalescedLogs := env.ApplyTransactions(txs, bc, rewardbase)
 
 	if len(coalescedLogs) > 0 || env.tcount > 0 {
@@ -591,7 +598,7 @@ func (env *Task) commitTransactions(mux *event.TypeMux, txs *types.TransactionsB
 	}
 }
 
-func (env *Task) ApplyTransactions(txs *types.TransactionsByPriceAndNonce, bc BlockChain, rewardbase common.Address) []*types.Log {
+func (env *Task) ApplyTransactions(txs TxOrdering, bc BlockChain, rewardbase common.Address) []*types.Log {
 	var coalescedLogs []*types.Log
 
 	// Limit the execution time of all transactions in a block
@@ -632,10 +639,19 @@ func (env *Task) ApplyTransactions(txs *types.TransactionsByPriceAndNonce, bc Bl
 		}
 	}()
 
+	// Record the resources spent by each transaction
+	env.profile = bc.NewBlockExecutionProfile(env.header, true)
+	var computationCost uint64
+
+	// The limits in effect at the block are applied as validators do.
+	env.gasLimit = bc.BlockGasLimit(env.header.Number.Uint64())
+
+	// The jump table is left empty, so that the interpreter picks the one of the block's fork as validators do.
 	vmConfig := &vm.Config{
-		JumpTable:                vm.ConstantinopleInstructionSet,
 		RunningEVM:               chEVM,
 		UseOpcodeComputationCost: true,
+		ComputationCostLimit:     bc.ComputationCostLimit(env.header.Number.Uint64()),
+		ComputationCostHook:      func(cost uint64) { computationCost = cost },
 	}
 
 	var numTxsChecked int64 = 0
@@ -673,6 +689,7 @@ CommitTransactionLoop:
 		// Start executing the transaction
 		env.state.Prepare(tx.Hash(), common.Hash{}, env.tcount)
 
+		start := time.Now()
 		err, logs := env.commitTransaction(tx, bc, rewardbase, vmConfig)
 		switch err {
 		case blockchain.ErrGasLimitReached:
@@ -693,9 +710,15 @@ CommitTransactionLoop:
 			numTxsNonceTooHigh++
 			txs.Pop()
 
+		case blockchain.ErrGasPriceBelowBaseFee:
+			// The transaction may be executable in a later block with a lower base fee, skip account
+			logger.Trace("Skipping account with gas price below base fee", "sender", from, "gasPrice", tx.GasPrice(), "baseFee", env.header.BaseFee)
+			txs.Pop()
+
 		case vm.ErrTotalTimeLimitReached:
 			logger.Warn("Transaction aborted due to time limit", "hash", tx.Hash().String())
 			timeLimitReachedCounter.Inc(1)
+			env.profile.TimeLimitReached = true
 			if env.tcount == 0 {
 				logger.Error("A single transaction exceeds total time limit", "hash", tx.Hash())
 				tooLongTxCounter.Inc(1)
@@ -706,6 +729,7 @@ CommitTransactionLoop:
 		case nil:
 			// Everything ok, collect the logs and shift in the next transaction from the same account
 			coalescedLogs = append(coalescedLogs, logs...)
+			env.profile.AddTx(tx, time.Since(start), computationCost, env.receipts[len(env.receipts)-1].GasUsed)
 			env.tcount++
 			txs.Shift()
 
@@ -718,6 +742,10 @@ CommitTransactionLoop:
 		}
 	}
 
+	if atomic.LoadInt32(&abort) == 1 {
+		env.profile.TimeLimitReached = true
+	}
+
 	// Update the number of transactions checked and dropped during ApplyTransactions.
 	checkedTxsGauge.Update(numTxsChecked)
 	nonceTooLowTxsGauge.Update(numTxsNonceTooLow)
@@ -731,11 +759,16 @@ CommitTransactionLoop:
 }
 
 func (env *Task) commitTransaction(tx *types.Transaction, bc BlockChain, rewardbase common.Address, vmConfig *vm.Config) (error, []*types.Log) {
+	// Stop adding transactions which may exceed the gas limit of the block
+	if env.gasLimit != 0 && env.header.GasUsed+tx.Gas() > env.gasLimit {
+		return blockchain.ErrGasLimitReached, nil
+	}
+
 	snap := env.state.Snapshot()
 
 	receipt, _, _, err := bc.ApplyTransaction(env.config, &rewardbase, env.state, env.header, tx, &env.header.GasUsed, vmConfig)
 	if err != nil {
-		if err != vm.ErrInsufficientBalance && err != vm.ErrTotalTimeLimitReached {
+		if err != vm.ErrInsufficientBalance && err != vm.ErrTotalTimeLimitReached && err != blockchain.ErrGasPriceBelowBaseFee {
 			tx.MarkUnexecutable(true)
 		}
 		env.state.RevertToSnapshot(snap)
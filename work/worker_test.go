@@ -0,0 +1,95 @@
+// Copyright 2020 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package work
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/gxhash"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestApplyTransactions_IstanbulOpcodes tests that a block proposed with the Istanbul opcodes
+// is executed in the same way by the validators inserting the block.
+func TestApplyTransactions_IstanbulOpcodes(t *testing.T) {
+	var (
+		db       = database.NewMemoryDBManager()
+		key, _   = crypto.GenerateKey()
+		sender   = crypto.PubkeyToAddress(key.PublicKey)
+		contract = common.HexToAddress("0xC0DE")
+		// SELFBALANCE PUSH1 0 SSTORE CHAINID PUSH1 1 SSTORE STOP
+		code    = common.FromHex("0x47600055466001550000")
+		balance = big.NewInt(1000)
+	)
+	config := *params.TestChainConfig
+	config.IstanbulCompatibleBlock = big.NewInt(0)
+
+	gspec := &blockchain.Genesis{
+		Config: &config,
+		Alloc: blockchain.GenesisAlloc{
+			sender:   {Balance: big.NewInt(params.KLAY)},
+			contract: {Balance: balance, Code: code},
+		},
+	}
+	genesis := gspec.MustCommit(db)
+
+	engine := gxhash.NewFaker()
+	chain, err := blockchain.NewBlockChain(db, nil, gspec.Config, engine, vm.Config{})
+	assert.NoError(t, err)
+	defer chain.Stop()
+
+	signer := types.NewEIP155Signer(config.ChainID)
+	tx, err := types.SignTx(types.NewTransaction(0, contract, common.Big0, 100000, common.Big1, nil), signer, key)
+	assert.NoError(t, err)
+
+	// Propose a block as the worker does
+	statedb, err := chain.StateAt(genesis.Root())
+	assert.NoError(t, err)
+	header := &types.Header{
+		ParentHash: genesis.Hash(),
+		Number:     big.NewInt(1),
+		Time:       new(big.Int).Add(genesis.Time(), common.Big1),
+	}
+	header.BlockScore = engine.CalcBlockScore(chain, header.Time.Uint64(), genesis.Header())
+	task := NewTask(&config, signer, statedb, header)
+	pending := map[common.Address]types.Transactions{sender: {tx}}
+	// The fee is credited to the author of the gxhash faker, as the validators do
+	task.ApplyTransactions(NewTxOrdering(DefaultTxOrderingConfig, signer, pending, nil), chain, params.AuthorAddressForTesting)
+
+	if assert.Equal(t, 1, len(task.receipts)) {
+		assert.Equal(t, types.ReceiptStatusSuccessful, task.receipts[0].Status)
+	}
+	block, err := engine.Finalize(chain, header, task.state, task.txs, task.receipts)
+	assert.NoError(t, err)
+
+	// Validate the block as the other nodes do
+	_, err = chain.InsertChain(types.Blocks{block})
+	assert.NoError(t, err)
+
+	state, err := chain.State()
+	assert.NoError(t, err)
+	assert.Equal(t, common.BigToHash(balance), state.GetState(contract, common.BigToHash(common.Big0)))
+	assert.Equal(t, common.BigToHash(config.ChainID), state.GetState(contract, common.BigToHash(common.Big1)))
+}